@@ -16,8 +16,12 @@ import (
 	"github.com/ignite-hq/cli/ignite/pkg/cosmoscmd"
 	"github.com/lavanet/lava/app"
 	"github.com/lavanet/lava/relayer"
+	"github.com/lavanet/lava/relayer/chainproxy"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
+	"github.com/lavanet/lava/relayer/txsender"
 	"github.com/lavanet/lava/utils"
 	"github.com/spf13/cobra"
 )
@@ -36,7 +40,7 @@ func main() {
 	cmdServer := &cobra.Command{
 		Use:   "server [listen-ip] [listen-port] [node-url] [node-chain-id] [api-interface]",
 		Short: "server",
-		Long:  `server`,
+		Long:  `server, node-url can be a comma separated list of urls that requests fail over between`,
 		Args:  cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			utils.LavaFormatInfo("Provider process started", &map[string]string{"args": strings.Join(args, ",")})
@@ -67,11 +71,7 @@ func main() {
 
 			listenAddr := fmt.Sprintf("%s:%d", args[0], port)
 			ctx := context.Background()
-			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
-			if err != nil {
-				utils.LavaFormatFatal("failed to read log level flag", err, nil)
-			}
-			utils.LoggingLevel(logLevel)
+			setLogging(cmd)
 			relayer.Server(ctx, clientCtx, txFactory, listenAddr, args[2], chainID, apiInterface, cmd.Flags())
 
 			return nil
@@ -100,11 +100,7 @@ func main() {
 
 			listenAddr := fmt.Sprintf("%s:%d", args[0], port)
 			ctx := context.Background()
-			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
-			if err != nil {
-				utils.LavaFormatFatal("failed to read log level flag", err, nil)
-			}
-			utils.LoggingLevel(logLevel)
+			setLogging(cmd)
 
 			// check if the command includes --pprof-address
 			pprofAddressFlagUsed := cmd.Flags().Lookup("pprof-address").Changed
@@ -159,11 +155,7 @@ func main() {
 				}
 			}
 			ctx := context.Background()
-			logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
-			if err != nil {
-				utils.LavaFormatFatal("failed to read log level flag", err, nil)
-			}
-			utils.LoggingLevel(logLevel)
+			setLogging(cmd)
 
 			networkChainId, err := cmd.Flags().GetString(flags.FlagChainID)
 			if err != nil {
@@ -177,6 +169,49 @@ func main() {
 		},
 	}
 
+	cmdRelaySigner := &cobra.Command{
+		Use:   "relay-signer [listen-address]",
+		Short: "relay signer",
+		Long:  `relay signer, signs the relays and transactions of the --from account for servers and portals started with --remote-signer. listen-address is a unix socket, unix:///path`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			setLogging(cmd)
+			chainIDs, err := cmd.Flags().GetStringSlice(sigs.AllowedChainsFlagName)
+			if err != nil {
+				return err
+			}
+			return relayer.SignerDaemon(context.Background(), clientCtx, args[0], sigs.SignerPolicy{ChainIDs: chainIDs})
+		},
+	}
+
+	cmdTxSender := &cobra.Command{
+		Use:   "tx-sender [listen-address]",
+		Short: "tx sender",
+		Long:  `tx sender, sends the transactions of the --from account for servers started with --tx-sender, so the servers of all its chains share them. listen-address is a unix socket, unix:///path`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			setLogging(cmd)
+			networkChainId, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+			remoteSigner, err := cmd.Flags().GetString(sigs.RemoteSignerFlagName)
+			if err != nil {
+				return err
+			}
+			txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithChainID(networkChainId)
+			return relayer.TxSenderDaemon(context.Background(), clientCtx, txFactory, args[0], remoteSigner)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmdServer)
 	cmdServer.MarkFlagRequired(flags.FlagFrom)
 	flags.AddTxFlagsToCmd(cmdPortalServer)
@@ -195,14 +230,68 @@ func main() {
 	cmdTestClient.MarkFlagRequired(flags.FlagFrom)
 	cmdTestClient.Flags().Bool("secure", false, "secure sends reliability on every message")
 	cmdPortalServer.Flags().Bool("secure", false, "secure sends reliability on every message")
+	cmdPortalServer.Flags().Uint64(sentry.StatefulRelayTargetsFlag, 0, "number of providers state changing relays (transactions) are sent to, 0 sends to all of the pairing")
+	cmdTestClient.Flags().Uint64(sentry.StatefulRelayTargetsFlag, 0, "number of providers state changing relays (transactions) are sent to, 0 sends to all of the pairing")
 	cmdPortalServer.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
 	cmdPortalServer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
+	cmdPortalServer.Flags().Uint64(performance.LocalCacheSizeFlagName, performance.DefaultLocalCacheSize, "size in MB of the in memory cache of the portal, layered over the cache server if set, 0 disables it")
+	cmdPortalServer.Flags().String(chainproxy.DappsConfigFlagName, "", "json file of the dapps allowed to use the portal with their api keys and limits, when empty the portal is open")
+	cmdPortalServer.Flags().String(chainproxy.DappsUsageAddressFlagName, "", "address serving the dapps usage counters, requires --"+chainproxy.DappsConfigFlagName)
+	cmdPortalServer.Flags().String(chainproxy.PortalLogsConfigFlagName, "", "json file of the sinks the relays are logged to, with their sampling and params redaction. when empty relays are reported to new relic from its environment variables")
+	cmdPortalServer.Flags().StringSlice(chainproxy.GrpcDescriptorSetFlagName, nil, "protobuf descriptor set files of the chain, served by the grpc portal reflection and gateway instead of the descriptors fetched from the providers")
 	cmdServer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
+	cmdServer.Flags().Uint(chainproxy.MaxNodeConnsFlagName, chainproxy.DefaultMaxConnsPerNode, "maximum number of connections to each node")
+	cmdServer.Flags().String(chainproxy.ArchiveNodeUrlsFlagName, "", "comma separated archive node urls, preferred for requests on old blocks")
+	cmdServer.Flags().String(sigs.RemoteSignerFlagName, "", "unix socket of a relay signer holding the --from key, unix:///path, when empty the keyring key is used")
+	cmdServer.Flags().String(txsender.TxSenderFlagName, "", "unix socket of a tx sender of the --from account, unix:///path, sending the transactions of the servers of all its chains. when empty the server sends its own")
+	cmdPortalServer.Flags().String(sigs.RemoteSignerFlagName, "", "unix socket of a relay signer holding the --from key, unix:///path, when empty the keyring key is used")
+	cmdServer.Flags().String(tracing.TracingEndpointFlagName, "", "otlp/http collector endpoint the relay traces are exported to, i.e http://localhost:4318, when empty tracing is off")
+	cmdPortalServer.Flags().String(tracing.TracingEndpointFlagName, "", "otlp/http collector endpoint the relay traces are exported to, i.e http://localhost:4318, when empty tracing is off")
+	flags.AddTxFlagsToCmd(cmdRelaySigner)
+	for _, cmd := range []*cobra.Command{cmdServer, cmdPortalServer, cmdTestClient, cmdRelaySigner, cmdTxSender} {
+		cmd.Flags().String(utils.ComponentLogLevelsFlagName, "", "log levels overriding --"+flags.FlagLogLevel+" for components, i.e chainproxy=debug,sentry=info")
+	}
+	cmdRelaySigner.MarkFlagRequired(flags.FlagFrom)
+	cmdRelaySigner.Flags().StringSlice(sigs.AllowedChainsFlagName, nil, "chain ids the relays are signed for, relays of other chains are refused")
+	cmdRelaySigner.MarkFlagRequired(sigs.AllowedChainsFlagName)
+	flags.AddTxFlagsToCmd(cmdTxSender)
+	cmdTxSender.MarkFlagRequired(flags.FlagFrom)
+	cmdTxSender.Flags().String(flags.FlagChainID, app.Name, "network chain id")
+	cmdTxSender.Flags().String(sigs.RemoteSignerFlagName, "", "unix socket of a relay signer holding the --from key, unix:///path, when empty the keyring key is used")
 	rootCmd.AddCommand(cmdServer)
 	rootCmd.AddCommand(cmdPortalServer)
 	rootCmd.AddCommand(cmdTestClient)
+	rootCmd.AddCommand(cmdRelaySigner)
+	rootCmd.AddCommand(cmdTxSender)
+	addVrfKeysCmd(rootCmd)
 
 	if err := svrcmd.Execute(rootCmd, app.DefaultNodeHome); err != nil {
 		os.Exit(1)
 	}
 }
+
+// setLogging configures the relayer logs from the log flags, --log_format selects plain, json or logfmt output.
+// when --log_format isn't set the format of LAVA_OUTPUT is kept
+func setLogging(cmd *cobra.Command) {
+	logLevel, err := cmd.Flags().GetString(flags.FlagLogLevel)
+	if err != nil {
+		utils.LavaFormatFatal("failed to read log level flag", err, nil)
+	}
+	componentLevels, err := cmd.Flags().GetString(utils.ComponentLogLevelsFlagName)
+	if err != nil {
+		utils.LavaFormatFatal("failed to read component log levels flag", err, nil)
+	}
+	if cmd.Flags().Changed(flags.FlagLogFormat) {
+		logFormat, err := cmd.Flags().GetString(flags.FlagLogFormat)
+		if err != nil {
+			utils.LavaFormatFatal("failed to read log format flag", err, nil)
+		}
+		if err := utils.LoggingFormat(logFormat); err != nil {
+			utils.LavaFormatFatal("invalid log format flag", err, nil)
+		}
+	}
+	if err := utils.LoggingComponentLevels(componentLevels); err != nil {
+		utils.LavaFormatFatal("invalid component log levels flag", err, nil)
+	}
+	utils.LoggingLevel(logLevel)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/coniks-sys/coniks-go/crypto/vrf"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/cobra"
+)
+
+// vrfKeysCmd manages the vrf keys of the keyring accounts, used by consumers to prove data reliability selection
+func vrfKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vrf",
+		Short: "Manage the vrf keys of the keyring accounts",
+		Long:  `vrf keys are stored in the keyring as ed25519 keys named vrf-<name>, encrypted at rest by the keyring backend`,
+	}
+	exportCmd := &cobra.Command{
+		Use:   "export [name]",
+		Short: "Export the vrf secret key of the account in hex, unencrypted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := vrfKeysClientContext(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			skipConfirmation, _ := cmd.Flags().GetBool(flags.FlagSkipConfirmation)
+			if !skipConfirmation {
+				buf := bufio.NewReader(clientCtx.Input)
+				confirmed, err := input.GetConfirmation("WARNING: the vrf secret key will be exported unencrypted, continue?", buf, cmd.ErrOrStderr())
+				if err != nil || !confirmed {
+					return err
+				}
+			}
+			sk, _, err := utils.LoadVRFKey(clientCtx)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(hex.EncodeToString(sk) + "\n")
+		},
+	}
+	exportCmd.Flags().BoolP(flags.FlagSkipConfirmation, "y", false, "Skip the unencrypted export confirmation")
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "generate [name]",
+			Short: "Generate a vrf key for the account",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				clientCtx, err := vrfKeysClientContext(cmd, args[0])
+				if err != nil {
+					return err
+				}
+				if _, _, err := utils.LoadVRFKey(clientCtx); err == nil {
+					return fmt.Errorf("account %s already has a vrf key, rotate it with tx pairing rotate-vrf-key", args[0])
+				}
+				_, pk, err := utils.GenerateVRFKey(clientCtx)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintString(pk.String() + "\n")
+			},
+		},
+		&cobra.Command{
+			Use:   "show [name]",
+			Short: "Show the vrf pk of the account, the one it replaced if kept and a staged one",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				clientCtx, err := vrfKeysClientContext(cmd, args[0])
+				if err != nil {
+					return err
+				}
+				current, previous, err := utils.LoadVRFKeys(clientCtx)
+				if err != nil {
+					return err
+				}
+				currentPk, err := utils.VrfPublicKey(current)
+				if err != nil {
+					return err
+				}
+				out := "vrfpk: " + currentPk.String() + "\n"
+				if previous != nil {
+					previousPk, err := utils.VrfPublicKey(previous)
+					if err != nil {
+						return err
+					}
+					out += "previous vrfpk: " + previousPk.String() + "\n"
+				}
+				if pending, err := utils.LoadPendingVRFKey(clientCtx); err == nil {
+					pendingPk, err := utils.VrfPublicKey(pending)
+					if err != nil {
+						return err
+					}
+					out += "staged vrfpk: " + pendingPk.String() + "\n"
+				}
+				return clientCtx.PrintString(out)
+			},
+		},
+		&cobra.Command{
+			Use:   "import [name]",
+			Short: "Import a hex vrf secret key for the account, read from the input",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				clientCtx, err := vrfKeysClientContext(cmd, args[0])
+				if err != nil {
+					return err
+				}
+				if _, _, err := utils.LoadVRFKey(clientCtx); err == nil {
+					return fmt.Errorf("account %s already has a vrf key", args[0])
+				}
+				skHex, err := input.GetPassword("Enter the hex vrf secret key:", bufio.NewReader(clientCtx.Input))
+				if err != nil {
+					return err
+				}
+				sk, err := hex.DecodeString(skHex)
+				if err != nil {
+					return err
+				}
+				pk, err := utils.VrfPublicKey(sk)
+				if err != nil {
+					return err
+				}
+				err = utils.SetVRFKeys(clientCtx, vrf.PrivateKey(sk), nil)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintString(pk.String() + "\n")
+			},
+		},
+		&cobra.Command{
+			Use:   "promote [name]",
+			Short: "Make the staged vrf key of the account the current one, once its rotation transaction was included successfully",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				clientCtx, err := vrfKeysClientContext(cmd, args[0])
+				if err != nil {
+					return err
+				}
+				err = utils.PromoteVRFKey(clientCtx)
+				if err != nil {
+					return err
+				}
+				_, pk, err := utils.LoadVRFKey(clientCtx)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintString(pk.String() + "\n")
+			},
+		},
+		&cobra.Command{
+			Use:   "discard [name]",
+			Short: "Delete the staged vrf key of the account, when its rotation transaction wasn't included",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				clientCtx, err := vrfKeysClientContext(cmd, args[0])
+				if err != nil {
+					return err
+				}
+				if _, err := utils.LoadPendingVRFKey(clientCtx); err != nil {
+					return fmt.Errorf("account %s has no staged vrf key", args[0])
+				}
+				return utils.DiscardPendingVRFKey(clientCtx)
+			},
+		},
+		exportCmd,
+	)
+	return cmd
+}
+
+func vrfKeysClientContext(cmd *cobra.Command, name string) (client.Context, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return clientCtx, err
+	}
+	return clientCtx.WithFromName(name), nil
+}
+
+// addVrfKeysCmd adds the vrf commands under the keys command of the root command
+func addVrfKeysCmd(rootCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "keys" {
+			cmd.AddCommand(vrfKeysCmd())
+			return
+		}
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/btcsuite/btcd/btcec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/websocket/v2"
@@ -15,9 +14,12 @@ import (
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
 	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -37,31 +39,42 @@ type NodeMessage interface {
 type ChainProxy interface {
 	Start(context.Context) error
 	GetSentry() *sentry.Sentry
-	ParseMsg(string, []byte, string) (NodeMessage, error)
-	PortalStart(context.Context, *btcec.PrivateKey, string)
+	ParseMsg(url string, data []byte, connectionType string, metadata []pairingtypes.Metadata) (NodeMessage, error)
+	PortalStart(context.Context, sigs.Signer, string)
 	FetchLatestBlockNum(ctx context.Context) (int64, error)
 	FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error)
 	GetConsumerSessionManager() *lavasession.ConsumerSessionManager
 	SetCache(*performance.Cache)
 	GetCache() *performance.Cache
+	SetDappRegistry(*DappRegistry)
+	GetDappRegistry() *DappRegistry
+	GetPortalLogs() *PortalLogs
 }
 
-func GetChainProxy(nodeUrl string, nConns uint, sentry *sentry.Sentry, pLogs *PortalLogs) (ChainProxy, error) {
+// GetChainProxy creates the chain proxy for the sentry api interface. requests are failed over between nodeUrls,
+// and archiveNodeUrls are preferred for requests on old blocks. each node starts with nConns connections that grow up to maxConns
+func GetChainProxy(nodeUrls []string, archiveNodeUrls []string, nConns uint, maxConns uint, sentry *sentry.Sentry, pLogs *PortalLogs) (ChainProxy, error) {
 	consumerSessionManagerInstance := &lavasession.ConsumerSessionManager{}
+	nodes := NewNodeSet(nodeUrls, archiveNodeUrls, sentry.GetAllowedBlockLagForQosSync())
 	switch sentry.ApiInterface {
 	case spectypes.APIInterfaceJsonRPC:
-		return NewJrpcChainProxy(nodeUrl, nConns, sentry, consumerSessionManagerInstance, pLogs), nil
+		return NewJrpcChainProxy(nodes, nConns, maxConns, sentry, consumerSessionManagerInstance, pLogs), nil
 	case spectypes.APIInterfaceTendermintRPC:
-		return NewtendermintRpcChainProxy(nodeUrl, nConns, sentry, consumerSessionManagerInstance, pLogs), nil
+		return NewtendermintRpcChainProxy(nodes, nConns, maxConns, sentry, consumerSessionManagerInstance, pLogs), nil
 	case spectypes.APIInterfaceRest:
-		return NewRestChainProxy(nodeUrl, sentry, consumerSessionManagerInstance, pLogs), nil
+		return NewRestChainProxy(nodes, maxConns, sentry, consumerSessionManagerInstance, pLogs), nil
 	case spectypes.APIInterfaceGrpc:
-		return NewGrpcChainProxy(nodeUrl, nConns, sentry, consumerSessionManagerInstance, pLogs), nil
+		return NewGrpcChainProxy(nodes, nConns, maxConns, sentry, consumerSessionManagerInstance, pLogs), nil
 	}
 	return nil, fmt.Errorf("chain proxy for apiInterface (%s) not found", sentry.ApiInterface)
 }
 
-func VerifyRelayReply(reply *pairingtypes.RelayReply, relayRequest *pairingtypes.RelayRequest, addr string, comparesHashes bool) error {
+// isProviderSigner checks signerAddr is the provider address or the operator signing its relays
+func isProviderSigner(signerAddr string, addr string, operator string) bool {
+	return signerAddr == addr || (operator != "" && signerAddr == operator)
+}
+
+func VerifyRelayReply(reply *pairingtypes.RelayReply, relayRequest *pairingtypes.RelayRequest, addr string, operator string, comparesHashes bool) error {
 	serverKey, err := sigs.RecoverPubKeyFromRelayReply(reply, relayRequest)
 	if err != nil {
 		return err
@@ -70,7 +83,7 @@ func VerifyRelayReply(reply *pairingtypes.RelayReply, relayRequest *pairingtypes
 	if err != nil {
 		return err
 	}
-	if serverAddr.String() != addr {
+	if !isProviderSigner(serverAddr.String(), addr, operator) {
 		return fmt.Errorf("server address mismatch in reply (%s) (%s)", serverAddr.String(), addr)
 	}
 
@@ -89,7 +102,7 @@ func VerifyRelayReply(reply *pairingtypes.RelayReply, relayRequest *pairingtypes
 			return err
 		}
 
-		if serverAddr.String() != strAdd.String() {
+		if !isProviderSigner(serverAddr.String(), strAdd.String(), operator) {
 			return fmt.Errorf("server address mismatch in reply sigblocks (%s) (%s)", serverAddr.String(), strAdd.String())
 		}
 	}
@@ -100,28 +113,132 @@ func VerifyRelayReply(reply *pairingtypes.RelayReply, relayRequest *pairingtypes
 func SendRelay(
 	ctx context.Context,
 	cp ChainProxy,
-	privKey *btcec.PrivateKey,
+	signer sigs.Signer,
 	url string,
 	req string,
 	connectionType string,
 	dappID string,
-) (*pairingtypes.RelayReply, *pairingtypes.Relayer_RelaySubscribeClient, error) {
+	clientID string, // the client ip, or address of its websocket connection. empty disables sticky sessions
+	metadata []pairingtypes.Metadata,
+	selection *ProviderSelection, // nil relays to the providers the session manager picks
+) (reply *pairingtypes.RelayReply, replyServer *pairingtypes.Relayer_RelaySubscribeClient, provenance *RelayProvenance, err error) {
+	ctx, span := tracing.StartSpan(ctx, "SendRelay", trace.SpanKindInternal)
+	span.SetAttributes(
+		attribute.String("chain_id", cp.GetSentry().ChainID),
+		attribute.String("api_interface", cp.GetSentry().ApiInterface),
+		attribute.String("dapp_id", dappID),
+	)
+	defer func() {
+		if provenance != nil {
+			span.SetAttributes(
+				attribute.String("provider", provenance.ProviderAddress),
+				attribute.Bool("cache_hit", provenance.CacheHit),
+				attribute.Bool("coalesced", provenance.Coalesced),
+			)
+		}
+		tracing.RecordError(span, err)
+		span.End()
+	}()
 	// Unmarshal request
-	nodeMsg, err := cp.ParseMsg(url, []byte(req), connectionType)
+	nodeMsg, err := cp.ParseMsg(url, []byte(req), connectionType, metadata)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+	span.SetAttributes(attribute.String("method", nodeMsg.GetServiceApi().Name))
+	start := time.Now()
+	defer func() {
+		cp.GetPortalLogs().LogRelay(cp.GetSentry().ChainID, dappID, nodeMsg.GetServiceApi(), req, provenance, time.Since(start), err)
+	}()
+	// only the headers the spec passes are signed and sent to the provider
+	metadata = requestMetadata(nodeMsg.GetServiceApi(), metadata)
+	if isStatefulRelay(nodeMsg) {
+		reply, provenance, err := sendStatefulRelay(ctx, cp, signer, nodeMsg, url, req, connectionType, metadata, selection)
+		return reply, nil, provenance, err
+	}
+	// relays selecting their providers must reach them, they don't share the relays of other clients
+	if key, ok := CoalescingKey(cp.GetSentry().ChainID, cp.GetSentry().ApiInterface, nodeMsg, url, []byte(req), metadata); ok && selection == nil {
+		reply, provenance, err := consumerRelayCoalescer.doWithProvenance(key, []byte(req), func() (*pairingtypes.RelayReply, *RelayProvenance, error) {
+			// the relay is shared by several clients, so it isn't canceled with the client that started it. it keeps its values
+			relayCtx, cancel := context.WithTimeout(DetachedContext(ctx), coalescedRelayTimeout)
+			defer cancel()
+			reply, _, provenance, err := sendRelay(relayCtx, cp, signer, nodeMsg, url, req, connectionType, dappID, clientID, metadata, nil)
+			return reply, provenance, err
+		})
+		return reply, nil, provenance, err
 	}
+	return sendRelay(ctx, cp, signer, nodeMsg, url, req, connectionType, dappID, clientID, metadata, selection)
+}
+
+func sendRelay(
+	ctx context.Context,
+	cp ChainProxy,
+	signer sigs.Signer,
+	nodeMsg NodeMessage,
+	url string,
+	req string,
+	connectionType string,
+	dappID string,
+	clientID string,
+	metadata []pairingtypes.Metadata,
+	selection *ProviderSelection,
+) (*pairingtypes.RelayReply, *pairingtypes.Relayer_RelaySubscribeClient, *RelayProvenance, error) {
+	var err error
 	isSubscription := nodeMsg.GetInterface().Category.Subscription
 	blockHeight := int64(-1) // to sync reliability blockHeight in case it changes
 	requestedBlock := int64(0)
 
+	// relays creating or using node objects (i.e filters) must reach the provider that holds them
+	stickyKey := getStickySessionKey(dappID, clientID)
+	isLocal := nodeMsg.GetInterface().Category.Local
+	stickyProvider, pinned := "", false
+	stickyRequestId := getStickyIdFromRequest(nodeMsg.GetServiceApi().Name, []byte(req))
+	if stickyKey != "" {
+		stickyProvider, pinned = cp.GetConsumerSessionManager().GetStickyProvider(stickyKey, isLocal, stickyRequestId)
+		if _, excluded := selection.excludedProviders()[stickyProvider]; pinned && excluded {
+			// the client is moved off the excluded provider (i.e a failed subscription), the next local relay pins it again
+			pinned = false
+		}
+	}
+	forced := selection != nil && selection.ForceProvider != ""
+
 	// Get Session. we get session here so we can use the epoch in the callbacks
-	singleConsumerSession, epoch, providerPublicAddress, reportedProviders, err := cp.GetConsumerSessionManager().GetSession(ctx, nodeMsg.GetServiceApi().ComputeUnits, nil)
+	var singleConsumerSession *lavasession.SingleConsumerSession
+	var epoch uint64
+	var providerPublicAddress string
+	var reportedProviders []byte
+	_, sessionSpan := tracing.StartSpan(ctx, "GetSession", trace.SpanKindInternal)
+	if forced {
+		// a forced provider overrides the sticky one, the client asked for it
+		singleConsumerSession, epoch, providerPublicAddress, reportedProviders, err = cp.GetConsumerSessionManager().GetSessionFromProvider(ctx, selection.ForceProvider, nodeMsg.GetServiceApi().ComputeUnits)
+		if lavasession.StickyProviderUnavailableError.Is(err) {
+			sessionSpan.End()
+			return nil, nil, nil, utils.LavaFormatError("forced provider is unavailable", err, &map[string]string{"provider": selection.ForceProvider, "dappID": dappID, "ChainID": cp.GetSentry().ChainID})
+		}
+	} else if pinned {
+		singleConsumerSession, epoch, providerPublicAddress, reportedProviders, err = cp.GetConsumerSessionManager().GetSessionFromProvider(ctx, stickyProvider, nodeMsg.GetServiceApi().ComputeUnits)
+		if lavasession.StickyProviderUnavailableError.Is(err) {
+			cp.GetConsumerSessionManager().RemoveStickyProvider(stickyKey)
+			sessionSpan.End()
+			return nil, nil, nil, utils.LavaFormatError("provider holding the client node objects is unavailable", err, &map[string]string{"provider": stickyProvider, "dappID": dappID, "ChainID": cp.GetSentry().ChainID})
+		}
+	} else {
+		var unwantedProviders map[string]struct{}
+		if selection != nil {
+			unwantedProviders = selection.excludedProviders()
+		}
+		singleConsumerSession, epoch, providerPublicAddress, reportedProviders, err = cp.GetConsumerSessionManager().GetSession(ctx, nodeMsg.GetServiceApi().ComputeUnits, unwantedProviders)
+	}
+	sessionSpan.SetAttributes(attribute.String("provider", providerPublicAddress))
+	tracing.RecordError(sessionSpan, err)
+	sessionSpan.End()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	// consumerSession is locked here.
 
+	// replies of non deterministic apis differ between calls, they aren't cached. relays selecting their providers must reach them
+	useCache := nodeMsg.GetInterface().Category.Deterministic && selection == nil
+	var subscribeRequest *pairingtypes.RelayRequest // paid for by a billed subscription
 	callback_send_relay := func(consumerSession *lavasession.SingleConsumerSession) (*pairingtypes.RelayReply, *pairingtypes.Relayer_RelaySubscribeClient, *pairingtypes.RelayRequest, time.Duration, bool, error) {
 		// client session is locked here
 		blockHeight = int64(epoch) // epochs heights only
@@ -141,8 +258,9 @@ func SendRelay(
 			QoSReport:             consumerSession.QoSInfo.LastQoSReport,
 			DataReliability:       nil,
 			UnresponsiveProviders: reportedProviders,
+			Metadata:              metadata,
 		}
-		sig, err := sigs.SignRelay(privKey, *relayRequest)
+		sig, err := signer.SignRelay(*relayRequest)
 		if err != nil {
 			return nil, nil, nil, 0, false, err
 		}
@@ -157,10 +275,13 @@ func SendRelay(
 
 		relaySentTime := time.Now()
 		if isSubscription {
+			subscribeRequest = relayRequest
 			replyServer, err = c.RelaySubscribe(ctx, relayRequest)
 		} else {
-			cache := cp.GetCache()
-			reply, err = cache.GetEntry(ctx, relayRequest, cp.GetSentry().ApiInterface, nil, cp.GetSentry().ChainID, false) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
+			if useCache {
+				cache := cp.GetCache()
+				reply, err = cache.GetEntry(ctx, relayRequest, cp.GetSentry().ApiInterface, nil, cp.GetSentry().ChainID, false) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
+			}
 			if err != nil || reply == nil {
 				if performance.NotConnectedError.Is(err) {
 					utils.LavaFormatError("cache not connected", err, nil)
@@ -179,17 +300,26 @@ func SendRelay(
 
 		if !isSubscription {
 			// update relay request requestedBlock to the provided one in case it was arbitrary
+			requestedBlock := relayRequest.RequestBlock
 			sentry.UpdateRequestedBlock(relayRequest, reply)
 			finalized := cp.GetSentry().IsFinalizedBlock(relayRequest.RequestBlock, reply.LatestBlock)
-			err = VerifyRelayReply(reply, relayRequest, providerPublicAddress, cp.GetSentry().GetSpecDataReliabilityEnabled())
+			err = VerifyRelayReply(reply, relayRequest, providerPublicAddress, consumerSession.Client.Operator, cp.GetSentry().GetSpecDataReliabilityEnabled())
 			if err != nil {
 				return nil, nil, nil, 0, false, err
 			}
-			cache := cp.GetCache()
-			// TODO: response sanity, check its under an expected format add that format to spec
-			err := cache.SetEntry(ctx, relayRequest, cp.GetSentry().ApiInterface, nil, cp.GetSentry().ChainID, dappID, reply, finalized) // caching in the portal doesn't care about hashes
-			if err != nil && !performance.NotInitialisedError.Is(err) {
-				utils.LavaFormatWarning("error updating cache with new entry", err, nil)
+			if useCache {
+				cache := cp.GetCache()
+				// TODO: response sanity, check its under an expected format add that format to spec
+				err := cache.SetEntry(ctx, relayRequest, cp.GetSentry().ApiInterface, nil, cp.GetSentry().ChainID, dappID, reply, finalized) // caching in the portal doesn't care about hashes
+				if err == nil && requestedBlock != relayRequest.RequestBlock {
+					// requests of the latest block are looked up before it is known, so the reply is also kept under the requested block until the next block
+					latestRequest := *relayRequest
+					latestRequest.RequestBlock = requestedBlock
+					err = cache.SetEntry(ctx, &latestRequest, cp.GetSentry().ApiInterface, nil, cp.GetSentry().ChainID, dappID, reply, false)
+				}
+				if err != nil && !performance.NotInitialisedError.Is(err) {
+					utils.LavaFormatWarning("error updating cache with new entry", err, nil)
+				}
 			}
 			return reply, nil, relayRequest, currentLatency, false, nil
 		}
@@ -218,15 +348,16 @@ func SendRelay(
 			DataReliability:       dataReliability,
 			ConnectionType:        connectionType,
 			UnresponsiveProviders: reportedProviders,
+			Metadata:              metadata,
 		}
 
-		sig, err := sigs.SignRelay(privKey, *relayRequest)
+		sig, err := signer.SignRelay(*relayRequest)
 		if err != nil {
 			return nil, nil, 0, err
 		}
 		relayRequest.Sig = sig
 
-		sig, err = sigs.SignVRFData(privKey, relayRequest.DataReliability)
+		sig, err = signer.SignVRFData(relayRequest.DataReliability)
 		if err != nil {
 			return nil, nil, 0, err
 		}
@@ -238,7 +369,7 @@ func SendRelay(
 			return nil, nil, 0, err
 		}
 		currentLatency := time.Since(relaySentTime)
-		err = VerifyRelayReply(reply, relayRequest, providerAddress, cp.GetSentry().GetSpecDataReliabilityEnabled())
+		err = VerifyRelayReply(reply, relayRequest, providerAddress, consumerSession.Client.Operator, cp.GetSentry().GetSpecDataReliabilityEnabled())
 		if err != nil {
 			return nil, nil, 0, err
 		}
@@ -251,54 +382,96 @@ func SendRelay(
 		// on session failure here
 		errReport := cp.GetConsumerSessionManager().OnSessionFailure(singleConsumerSession, firstSessionError)
 		if errReport != nil {
-			return nil, nil, fmt.Errorf("original error: %v, onSessionFailure: %v", firstSessionError, errReport)
+			return nil, nil, nil, fmt.Errorf("original error: %v, onSessionFailure: %v", firstSessionError, errReport)
 		}
-		if lavasession.SendRelayError.Is(firstSessionError) {
-			// Retry
+		if lavasession.SendRelayError.Is(firstSessionError) && !pinned && !forced {
+			// Retry, a pinned relay can't be retried on a provider that doesn't hold its node objects, nor a forced one
 			originalProviderAddress := providerPublicAddress
-			singleConsumerSession, epoch, providerPublicAddress, reportedProviders, err = cp.GetConsumerSessionManager().GetSessionFromAllExcept(ctx, map[string]struct{}{providerPublicAddress: {}}, nodeMsg.GetServiceApi().ComputeUnits, epoch)
+			_, retrySessionSpan := tracing.StartSpan(ctx, "GetSession", trace.SpanKindInternal)
+			retrySessionSpan.SetAttributes(attribute.Bool("retry", true))
+			singleConsumerSession, epoch, providerPublicAddress, reportedProviders, err = cp.GetConsumerSessionManager().GetSessionFromAllExcept(ctx, selection.excludedProviders(providerPublicAddress), nodeMsg.GetServiceApi().ComputeUnits, epoch)
+			retrySessionSpan.SetAttributes(attribute.String("provider", providerPublicAddress))
+			tracing.RecordError(retrySessionSpan, err)
+			retrySessionSpan.End()
 			if err != nil {
-				return nil, nil, utils.LavaFormatError("relay_retry_attempt - Failed to get a second session from a different provider", nil, &map[string]string{"Original Error": firstSessionError.Error(), "GetSessionFromAllExcept Error": err.Error(), "ChainID": cp.GetSentry().ChainID, "Original_Provider_Address": originalProviderAddress})
+				return nil, nil, nil, utils.LavaFormatError("relay_retry_attempt - Failed to get a second session from a different provider", nil, &map[string]string{"Original Error": firstSessionError.Error(), "GetSessionFromAllExcept Error": err.Error(), "ChainID": cp.GetSentry().ChainID, "Original_Provider_Address": originalProviderAddress})
 			}
 			var secondSessionError error
 			reply, replyServer, relayLatency, isCachedResult, secondSessionError = cp.GetSentry().SendRelay(ctx, singleConsumerSession, epoch, providerPublicAddress, callback_send_relay, callback_send_reliability, nodeMsg.GetInterface().Category)
 			if secondSessionError != nil {
 				errReport = cp.GetConsumerSessionManager().OnSessionFailure(singleConsumerSession, secondSessionError)
 				if errReport != nil {
-					return nil, nil, fmt.Errorf("original error: %v, onSessionFailure: %v", firstSessionError, errReport)
+					return nil, nil, nil, fmt.Errorf("original error: %v, onSessionFailure: %v", firstSessionError, errReport)
 				}
 				// compare error1 with error2
 				if secondSessionError.Error() != firstSessionError.Error() {
-					return nil, nil, utils.LavaFormatError("relay_retry_attempt - Received two different errors from different providers", nil, &map[string]string{"firstSessionError": firstSessionError.Error(), "secondSessionError": secondSessionError.Error(), "firstProviderAddr": originalProviderAddress, "secondProviderAddr": providerPublicAddress})
+					return nil, nil, nil, utils.LavaFormatError("relay_retry_attempt - Received two different errors from different providers", nil, &map[string]string{"firstSessionError": firstSessionError.Error(), "secondSessionError": secondSessionError.Error(), "firstProviderAddr": originalProviderAddress, "secondProviderAddr": providerPublicAddress})
 				} else {
 					// if both errors are the same, just return the first error.
-					return nil, nil, firstSessionError
+					return nil, nil, nil, firstSessionError
 				}
 			}
 			// retry attempt succeeded! can continue normally
 		} else {
-			return nil, nil, firstSessionError
+			return nil, nil, nil, firstSessionError
 		}
 	}
+	provenance := &RelayProvenance{ProviderAddress: providerPublicAddress, CacheHit: isCachedResult, Epoch: epoch, Latency: relayLatency}
+	if reply != nil {
+		provenance.LatestBlock = reply.LatestBlock
+	}
 	if !isSubscription {
 		if isCachedResult {
+			// the session's provider didn't serve the cached reply
+			provenance.ProviderAddress = ""
 			err = cp.GetConsumerSessionManager().OnSessionUnUsed(singleConsumerSession)
-			return reply, replyServer, err
+			return reply, replyServer, provenance, err
 		}
 		latestBlock := reply.LatestBlock
 		expectedBH, numOfProviders := cp.GetSentry().ExpectedBlockHeight()
 		err = cp.GetConsumerSessionManager().OnSessionDone(singleConsumerSession, epoch, latestBlock, nodeMsg.GetServiceApi().ComputeUnits, relayLatency, expectedBH, numOfProviders, cp.GetSentry().GetProvidersCount()) // session done successfully
+	} else if billing := nodeMsg.GetServiceApi().SubscriptionBilling; billing.IsBilled() && replyServer != nil {
+		// the session is held by the subscription until it is closed
+		err = cp.GetConsumerSessionManager().OnSubscriptionStarted(singleConsumerSession)
+		if err == nil {
+			billed := newBilledSubscription(cp, signer, singleConsumerSession, subscribeRequest, billing, *replyServer)
+			billed.start()
+			var billedReplyServer pairingtypes.Relayer_RelaySubscribeClient = billed
+			replyServer = &billedReplyServer
+		}
 	} else {
 		err = cp.GetConsumerSessionManager().OnSessionDoneIncreaseRelayAndCu(singleConsumerSession) // session done successfully
 	}
+	if err == nil && stickyKey != "" && !forced && (isLocal || pinned) {
+		stickyId := ""
+		if isLocal && reply != nil {
+			stickyId = getStickyIdFromReply(reply.Data)
+		}
+		cp.GetConsumerSessionManager().SetStickyProvider(stickyKey, providerPublicAddress, stickyId)
+		if _, removed := stickyIdRemovalMethods[nodeMsg.GetServiceApi().Name]; removed && stickyRequestId != "" {
+			cp.GetConsumerSessionManager().RemoveStickyId(stickyKey, stickyRequestId)
+		}
+	}
 	if reply.Data == nil && err == nil {
-		return nil, nil, utils.LavaFormatError("invalid handling of an error reply Data is nil & error is nil", nil, nil)
+		return nil, nil, nil, utils.LavaFormatError("invalid handling of an error reply Data is nil & error is nil", nil, nil)
 	}
 
-	return reply, replyServer, err
+	return reply, replyServer, provenance, err
+}
+
+// StartPortalSpan starts the span of a relay handled by the portal, the root of the relay trace
+func StartPortalSpan(ctx context.Context, cp ChainProxy, handler string) (context.Context, trace.Span) {
+	ctx, span := tracing.StartSpan(ctx, "portal.handler", trace.SpanKindServer)
+	span.SetAttributes(
+		attribute.String("handler", handler),
+		attribute.String("api_interface", cp.GetSentry().ApiInterface),
+	)
+	return ctx, span
 }
 
-func ConstructFiberCallbackWithDappIDExtraction(callbackToBeCalled fiber.Handler) fiber.Handler {
+// ConstructFiberCallbackWithDappIDExtraction sets the dapp id for the websocket callback. with a dapps registry the
+// connection is opened only for a registered api key, and every message is charged on its limits
+func ConstructFiberCallbackWithDappIDExtraction(callbackToBeCalled fiber.Handler, dapps *DappRegistry) fiber.Handler {
 	webSocketCallback := callbackToBeCalled
 	handler := func(c *fiber.Ctx) error {
 		dappID := ""
@@ -306,6 +479,21 @@ func ConstructFiberCallbackWithDappIDExtraction(callbackToBeCalled fiber.Handler
 			dappID = c.Route().Params[1]
 			dappID = strings.ReplaceAll(dappID, "*", "")
 		}
+		if dapps != nil {
+			apiKey := strings.Clone(c.Params("dappId")) // zeroallocation policy for fiber.Ctx
+			var err error
+			if dappID, err = dapps.Authorize(apiKey); err != nil {
+				return sendJsonRpcDappError(c, err)
+			}
+			c.Context().SetUserValue(ContextUserValueKeyApiKey, apiKey)
+			if dapps.IsTrusted(apiKey) {
+				// zeroallocation policy for fiber.Ctx
+				if selection := parseProviderSelection(strings.Clone(c.Get(ForceProviderHeader)), strings.Clone(c.Get(ExcludeProvidersHeader))); selection != nil {
+					c.Context().SetUserValue(ContextUserValueKeyProviderSelection, selection)
+				}
+			}
+		}
+		c.Context().SetUserValue(ContextUserValueKeyProvenance, c.Query(ProvenanceQueryParam) == "true")
 		c.Context().SetUserValue(ContextUserValueKeyDappID, dappID) // this sets a user value in context and this is given to the callback
 		return webSocketCallback(c)                                 // uses external dappID
 	}
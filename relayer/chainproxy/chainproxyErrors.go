@@ -5,3 +5,9 @@ import (
 )
 
 var ErrFailedToConvertMessage = sdkerrors.New("RPC error", 1000, "failed to convert a message")
+
+var ( // Portal dapps errors
+	ErrDappUnauthorized          = sdkerrors.New("Dapp error", 1100, "invalid or missing api key")
+	ErrDappRateLimited           = sdkerrors.New("Dapp error", 1101, "api key exceeded its requests per second")
+	ErrDappComputeUnitsExhausted = sdkerrors.New("Dapp error", 1102, "api key exhausted its compute units for this epoch")
+)
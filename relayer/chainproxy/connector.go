@@ -1,34 +1,48 @@
 package chainproxy
 
 //
-// Right now this is only for Ethereum
-// TODO: make this into a proper connection pool that supports
-// the chainproxy interface
+// Connection pools to a single node. a pool starts with nConns clients and grows up to maxConns
+// when all of its clients are in use, clients that failed are closed and redialed on demand
 
 import (
 	"context"
 	"errors"
 	"log"
+	"net/http"
 	"sync"
 	"time"
 
 	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
 	"github.com/lavanet/lava/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-const DialTimeout = 500 * time.Millisecond
+const (
+	DialTimeout             = 500 * time.Millisecond
+	DefaultMaxConnsPerNode  = 10
+	MaxNodeConnsFlagName    = "max-node-connections"
+	connectorClosedErrorMsg = "connector closed"
+)
 
 type Connector struct {
-	lock        utils.LavaMutex
-	freeClients []*rpcclient.Client
-	usedClients int
+	addr        string
+	freeClients chan *rpcclient.Client
+	slots       chan struct{} // a token for every client that can still be dialed
+	lock        sync.Mutex    // returned clients and Close are serialized, so no client is put back into a closed pool
+	closed      chan struct{}
 }
 
-func NewConnector(ctx context.Context, nConns uint, addr string) *Connector {
+func NewConnector(ctx context.Context, nConns uint, maxConns uint, addr string) *Connector {
+	if maxConns < nConns {
+		maxConns = nConns
+	}
 	connector := &Connector{
-		freeClients: make([]*rpcclient.Client, 0, nConns),
+		addr:        addr,
+		freeClients: make(chan *rpcclient.Client, maxConns),
+		slots:       make(chan struct{}, maxConns),
+		closed:      make(chan struct{}),
 	}
 
 	for i := uint(0); i < nConns; i++ {
@@ -39,92 +53,148 @@ func NewConnector(ctx context.Context, nConns uint, addr string) *Connector {
 				connector.Close()
 				return nil
 			}
-			nctx, cancel := context.WithTimeout(ctx, DialTimeout)
-			rpcClient, err = rpcclient.DialContext(nctx, addr)
+			rpcClient, err = connector.dial(ctx)
 			if err != nil {
 				utils.LavaFormatError("Could not connect to the client, retrying", err, nil)
-				cancel()
 				continue
 			}
-			cancel()
 			break
 		}
-		connector.freeClients = append(connector.freeClients, rpcClient)
+		connector.freeClients <- rpcClient
+	}
+	for i := nConns; i < maxConns; i++ {
+		connector.slots <- struct{}{}
 	}
 
 	go connector.connectorLoop(ctx)
 	return connector
 }
 
+func (connector *Connector) dial(ctx context.Context) (*rpcclient.Client, error) {
+	nctx, cancel := context.WithTimeout(ctx, DialTimeout)
+	defer cancel()
+	return rpcclient.DialContext(nctx, connector.addr)
+}
+
 func (connector *Connector) connectorLoop(ctx context.Context) {
 	<-ctx.Done()
 	log.Println("connectorLoop ctx.Done")
 	connector.Close()
 }
 
+// Close closes all the free clients, clients in use are closed when they are returned
 func (connector *Connector) Close() {
+	connector.lock.Lock()
+	defer connector.lock.Unlock()
+	if connector.isClosed() {
+		return
+	}
+	close(connector.closed)
+	log.Println("Connector closing", len(connector.freeClients))
 	for {
-		connector.lock.Lock()
-		log.Println("Connector closing", len(connector.freeClients))
-		for i := 0; i < len(connector.freeClients); i++ {
-			connector.freeClients[i].Close()
-		}
-		connector.freeClients = []*rpcclient.Client{}
-
-		if connector.usedClients > 0 {
-			log.Println("Connector closing, waiting for in use clients", connector.usedClients)
-			connector.lock.Unlock()
-			time.Sleep(100 * time.Millisecond)
-		} else {
-			connector.lock.Unlock()
-			break
+		select {
+		case rpc := <-connector.freeClients:
+			rpc.Close()
+		default:
+			return
 		}
 	}
 }
 
-func (connector *Connector) GetRpc(block bool) (*rpcclient.Client, error) {
-	connector.lock.Lock()
-	defer connector.lock.Unlock()
+func (connector *Connector) isClosed() bool {
+	select {
+	case <-connector.closed:
+		return true
+	default:
+		return false
+	}
+}
 
-	if len(connector.freeClients) == 0 {
-		if !block {
-			return nil, errors.New("out of clients")
-		} else {
-			for {
-				connector.lock.Unlock()
-				time.Sleep(50 * time.Millisecond)
-				connector.lock.Lock()
-				if len(connector.freeClients) != 0 {
-					break
-				}
+// GetRpc returns a free client, or dials a new one if the pool didn't reach its maximum size.
+// when block is set and the pool is exhausted it waits for a client until ctx is done
+func (connector *Connector) GetRpc(ctx context.Context, block bool) (*rpcclient.Client, error) {
+	for {
+		if connector.isClosed() {
+			return nil, errors.New(connectorClosedErrorMsg)
+		}
+		var rpc *rpcclient.Client
+		select {
+		case rpc = <-connector.freeClients:
+		default:
+			select {
+			case <-connector.slots:
+				return connector.dialToSlot(ctx)
+			default:
 			}
+			if !block {
+				return nil, errors.New("out of clients")
+			}
+			select {
+			case rpc = <-connector.freeClients:
+			case <-connector.slots:
+				return connector.dialToSlot(ctx)
+			case <-connector.closed:
+				return nil, errors.New(connectorClosedErrorMsg)
+			case <-ctx.Done():
+				return nil, utils.LavaFormatError("timed out waiting for a free client", ctx.Err(), &map[string]string{"addr": connector.addr})
+			}
+		}
+		if !rpc.Healthy() {
+			// dead client, replace it
+			connector.DiscardRpc(rpc)
+			continue
 		}
+		return rpc, nil
 	}
+}
 
-	ret := connector.freeClients[len(connector.freeClients)-1]
-	connector.freeClients = connector.freeClients[:len(connector.freeClients)-1]
-	connector.usedClients++
-
-	return ret, nil
+// the caller took a slot, it is given back if the dial failed
+func (connector *Connector) dialToSlot(ctx context.Context) (*rpcclient.Client, error) {
+	rpc, err := connector.dial(ctx)
+	if err != nil {
+		connector.slots <- struct{}{}
+		return nil, err
+	}
+	return rpc, nil
 }
 
 func (connector *Connector) ReturnRpc(rpc *rpcclient.Client) {
+	if !rpc.Healthy() {
+		connector.DiscardRpc(rpc)
+		return
+	}
 	connector.lock.Lock()
 	defer connector.lock.Unlock()
+	if connector.isClosed() {
+		rpc.Close()
+		return
+	}
+	connector.freeClients <- rpc
+}
 
-	connector.usedClients--
-	connector.freeClients = append(connector.freeClients, rpc)
+// DiscardRpc closes a client that failed instead of returning it, a new client is dialed in its place when needed
+func (connector *Connector) DiscardRpc(rpc *rpcclient.Client) {
+	rpc.Close()
+	connector.slots <- struct{}{}
 }
 
 type GRPCConnector struct {
-	lock        sync.RWMutex
-	freeClients []*grpc.ClientConn
-	usedClients int
+	addr        string
+	freeClients chan *grpc.ClientConn
+	slots       chan struct{} // a token for every client that can still be dialed
+	lock        sync.Mutex    // returned clients and Close are serialized, so no client is put back into a closed pool
+	closed      chan struct{}
 }
 
-func NewGRPCConnector(ctx context.Context, nConns uint, addr string) *GRPCConnector {
+func NewGRPCConnector(ctx context.Context, nConns uint, maxConns uint, addr string) *GRPCConnector {
+	if maxConns < nConns {
+		maxConns = nConns
+	}
 	connector := &GRPCConnector{
-		freeClients: make([]*grpc.ClientConn, 0, nConns),
+		addr:        addr,
+		freeClients: make(chan *grpc.ClientConn, maxConns),
+		slots:       make(chan struct{}, maxConns),
+		closed:      make(chan struct{}),
 	}
 
 	for i := uint(0); i < nConns; i++ {
@@ -135,54 +205,99 @@ func NewGRPCConnector(ctx context.Context, nConns uint, addr string) *GRPCConnec
 				connector.Close()
 				return nil
 			}
-			nctx, cancel := context.WithTimeout(ctx, DialTimeout)
-			grpcClient, err = grpc.DialContext(nctx, addr, grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			grpcClient, err = connector.dial(ctx)
 			if err != nil {
 				utils.LavaFormatError("Could not connect to the client, retrying", err, nil)
-				cancel()
 				continue
 			}
-			cancel()
 			break
 		}
-		connector.freeClients = append(connector.freeClients, grpcClient)
+		connector.freeClients <- grpcClient
+	}
+	for i := nConns; i < maxConns; i++ {
+		connector.slots <- struct{}{}
 	}
 	go connector.connectorLoop(ctx)
 	return connector
 }
 
-func (connector *GRPCConnector) GetRpc(block bool) (*grpc.ClientConn, error) {
-	connector.lock.Lock()
-	defer connector.lock.Unlock()
+func (connector *GRPCConnector) dial(ctx context.Context) (*grpc.ClientConn, error) {
+	nctx, cancel := context.WithTimeout(ctx, DialTimeout)
+	defer cancel()
+	return grpc.DialContext(nctx, connector.addr, grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
 
-	if len(connector.freeClients) == 0 {
-		if !block {
-			return nil, errors.New("out of clients")
-		} else {
-			for {
-				connector.lock.Unlock()
-				time.Sleep(50 * time.Millisecond)
-				connector.lock.Lock()
-				if len(connector.freeClients) != 0 {
-					break
-				}
+func (connector *GRPCConnector) isClosed() bool {
+	select {
+	case <-connector.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetRpc returns a free client, or dials a new one if the pool didn't reach its maximum size.
+// when block is set and the pool is exhausted it waits for a client until ctx is done
+func (connector *GRPCConnector) GetRpc(ctx context.Context, block bool) (*grpc.ClientConn, error) {
+	for {
+		if connector.isClosed() {
+			return nil, errors.New(connectorClosedErrorMsg)
+		}
+		var rpc *grpc.ClientConn
+		select {
+		case rpc = <-connector.freeClients:
+		default:
+			select {
+			case <-connector.slots:
+				return connector.dialToSlot(ctx)
+			default:
 			}
+			if !block {
+				return nil, errors.New("out of clients")
+			}
+			select {
+			case rpc = <-connector.freeClients:
+			case <-connector.slots:
+				return connector.dialToSlot(ctx)
+			case <-connector.closed:
+				return nil, errors.New(connectorClosedErrorMsg)
+			case <-ctx.Done():
+				return nil, utils.LavaFormatError("timed out waiting for a free client", ctx.Err(), &map[string]string{"addr": connector.addr})
+			}
+		}
+		if rpc.GetState() == connectivity.Shutdown {
+			// dead client, replace it
+			connector.DiscardRpc(rpc)
+			continue
 		}
+		return rpc, nil
 	}
+}
 
-	ret := connector.freeClients[len(connector.freeClients)-1]
-	connector.freeClients = connector.freeClients[:len(connector.freeClients)-1]
-	connector.usedClients++
-
-	return ret, nil
+// the caller took a slot, it is given back if the dial failed
+func (connector *GRPCConnector) dialToSlot(ctx context.Context) (*grpc.ClientConn, error) {
+	rpc, err := connector.dial(ctx)
+	if err != nil {
+		connector.slots <- struct{}{}
+		return nil, err
+	}
+	return rpc, nil
 }
 
 func (connector *GRPCConnector) ReturnRpc(rpc *grpc.ClientConn) {
 	connector.lock.Lock()
 	defer connector.lock.Unlock()
+	if connector.isClosed() {
+		rpc.Close()
+		return
+	}
+	connector.freeClients <- rpc
+}
 
-	connector.usedClients--
-	connector.freeClients = append(connector.freeClients, rpc)
+// DiscardRpc closes a client that failed instead of returning it, a new client is dialed in its place when needed
+func (connector *GRPCConnector) DiscardRpc(rpc *grpc.ClientConn) {
+	rpc.Close()
+	connector.slots <- struct{}{}
 }
 
 func (connector *GRPCConnector) connectorLoop(ctx context.Context) {
@@ -191,22 +306,30 @@ func (connector *GRPCConnector) connectorLoop(ctx context.Context) {
 	connector.Close()
 }
 
+// Close closes all the free clients, clients in use are closed when they are returned
 func (connector *GRPCConnector) Close() {
+	connector.lock.Lock()
+	defer connector.lock.Unlock()
+	if connector.isClosed() {
+		return
+	}
+	close(connector.closed)
+	log.Println("Connector closing", len(connector.freeClients))
 	for {
-		connector.lock.Lock()
-		log.Println("Connector closing", len(connector.freeClients))
-		for i := 0; i < len(connector.freeClients); i++ {
-			connector.freeClients[i].Close()
-		}
-		connector.freeClients = []*grpc.ClientConn{}
-
-		if connector.usedClients > 0 {
-			log.Println("Connector closing, waiting for in use clients", connector.usedClients)
-			connector.lock.Unlock()
-			time.Sleep(100 * time.Millisecond)
-		} else {
-			connector.lock.Unlock()
-			break
+		select {
+		case rpc := <-connector.freeClients:
+			rpc.Close()
+		default:
+			return
 		}
 	}
 }
+
+// newNodeHttpClient returns an http client shared by all the requests to the nodes, keeping up to maxConns
+// connections to each node. request deadlines are set on the request context
+func newNodeHttpClient(maxConns uint) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxConnsPerHost = int(maxConns)
+	transport.MaxIdleConnsPerHost = int(maxConns)
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,128 @@
+package chainproxy
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
+	"github.com/stretchr/testify/require"
+)
+
+// http clients don't connect on dial, so the pool can be tested without a node
+const connectorTestAddr = "http://127.0.0.1:1"
+
+func TestConnectorGrowsUpToMax(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	connector := NewConnector(ctx, 1, 3, connectorTestAddr)
+	require.NotNil(t, connector)
+
+	clients := []*rpcclient.Client{}
+	for i := 0; i < 3; i++ {
+		rpc, err := connector.GetRpc(ctx, false)
+		require.Nil(t, err)
+		clients = append(clients, rpc)
+	}
+	_, err := connector.GetRpc(ctx, false)
+	require.NotNil(t, err)
+
+	// a blocking call waits until a client is returned
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		connector.ReturnRpc(clients[0])
+	}()
+	rpc, err := connector.GetRpc(ctx, true)
+	require.Nil(t, err)
+	require.Equal(t, clients[0], rpc)
+}
+
+func TestConnectorWaitDeadline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	connector := NewConnector(ctx, 1, 1, connectorTestAddr)
+	_, err := connector.GetRpc(ctx, true)
+	require.Nil(t, err)
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer waitCancel()
+	_, err = connector.GetRpc(waitCtx, true)
+	require.NotNil(t, err)
+}
+
+func TestConnectorDiscardRedials(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	connector := NewConnector(ctx, 1, 1, connectorTestAddr)
+	rpc, err := connector.GetRpc(ctx, true)
+	require.Nil(t, err)
+	connector.DiscardRpc(rpc)
+
+	newRpc, err := connector.GetRpc(ctx, false)
+	require.Nil(t, err)
+	require.NotEqual(t, rpc, newRpc)
+}
+
+func TestConnectorClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	connector := NewConnector(ctx, 2, 2, connectorTestAddr)
+	rpc, err := connector.GetRpc(ctx, true)
+	require.Nil(t, err)
+	cancel()
+	require.Eventually(t, connector.isClosed, time.Second, 10*time.Millisecond)
+	_, err = connector.GetRpc(context.Background(), true)
+	require.NotNil(t, err)
+	connector.ReturnRpc(rpc)
+	require.Zero(t, len(connector.freeClients))
+}
+
+func TestConnectorReturnRaceWithClose(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		connector := NewConnector(ctx, 4, 4, connectorTestAddr)
+		clients := []*rpcclient.Client{}
+		for j := 0; j < 4; j++ {
+			rpc, err := connector.GetRpc(ctx, false)
+			require.Nil(t, err)
+			clients = append(clients, rpc)
+		}
+		var wg sync.WaitGroup
+		for _, rpc := range clients {
+			wg.Add(1)
+			go func(rpc *rpcclient.Client) {
+				defer wg.Done()
+				connector.ReturnRpc(rpc)
+			}(rpc)
+		}
+		connector.Close()
+		wg.Wait()
+		// clients returned after the close are closed rather than put back into the pool
+		require.Zero(t, len(connector.freeClients))
+		cancel()
+	}
+}
+
+func TestConnectorReplacesDeadClients(t *testing.T) {
+	rpcServer := rpcclient.NewServer()
+	server := httptest.NewServer(rpcServer.WebsocketHandler([]string{"*"}))
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	connector := NewConnector(ctx, 1, 1, "ws"+strings.TrimPrefix(server.URL, "http"))
+	require.NotNil(t, connector)
+	rpc, err := connector.GetRpc(ctx, false)
+	require.Nil(t, err)
+	require.True(t, rpc.Healthy())
+	connector.ReturnRpc(rpc)
+
+	// the node drops the connection of the free client
+	rpcServer.Stop()
+	require.Eventually(t, func() bool { return !rpc.Healthy() }, time.Second, 10*time.Millisecond)
+	newRpc, err := connector.GetRpc(ctx, false)
+	require.Nil(t, err)
+	require.NotEqual(t, rpc, newRpc)
+	require.True(t, newRpc.Healthy())
+}
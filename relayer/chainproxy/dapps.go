@@ -0,0 +1,371 @@
+package chainproxy
+
+//
+// Dapps registry of the portal. when it is configured the dapp id path segment of a request must be a registered
+// api key, and each key is limited in requests per second and in compute units per epoch using token buckets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DappsConfigFlagName       = "dapps-config"
+	DappsUsageAddressFlagName = "dapps-usage-address"
+	ContextUserValueKeyApiKey = "apiKey"
+	GrpcApiKeyHeader          = "x-api-key" // api key metadata of grpc portal requests
+
+	jsonRpcUnauthorizedCode = -32001
+	jsonRpcLimitExceeded    = -32005 // EIP-1474 limit exceeded
+	restUnauthenticatedCode = 16     // grpc codes, as returned by the cosmos rest gateway
+	restResourceExhausted   = 8
+)
+
+type DappKeyConfig struct {
+	Key               string  `json:"key"`
+	RequestsPerSecond float64 `json:"requests_per_second"` // 0 is unlimited
+	Burst             uint64  `json:"burst"`               // requests allowed at once, defaults to requests_per_second
+	CuPerEpoch        uint64  `json:"cu_per_epoch"`        // 0 is unlimited
+	Trusted           bool    `json:"trusted"`             // may force or exclude providers with the provider selection headers
+}
+
+type DappConfig struct {
+	DappID string          `json:"dapp_id"`
+	Keys   []DappKeyConfig `json:"keys"`
+}
+
+type DappsConfig struct {
+	Dapps []DappConfig `json:"dapps"`
+}
+
+type DappUsage struct {
+	Relays       uint64 `json:"relays"`
+	ComputeUnits uint64 `json:"compute_units"`
+	RateLimited  uint64 `json:"rate_limited"`
+}
+
+type tokenBucket struct {
+	capacity   float64
+	tokens     float64
+	refillRate float64 // tokens per second, 0 refills only on reset
+	lastRefill time.Time
+}
+
+func newTokenBucket(capacity float64, refillRate float64, now time.Time) *tokenBucket {
+	return &tokenBucket{capacity: capacity, tokens: capacity, refillRate: refillRate, lastRefill: now}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(tb.lastRefill).Seconds(); elapsed > 0 {
+		tb.tokens += elapsed * tb.refillRate
+		if tb.tokens > tb.capacity {
+			tb.tokens = tb.capacity
+		}
+	}
+	tb.lastRefill = now
+}
+
+func (tb *tokenBucket) reset() {
+	tb.tokens = tb.capacity
+}
+
+type dappKey struct {
+	dappID       string
+	requests     *tokenBucket // nil is unlimited
+	computeUnits *tokenBucket // reset on every epoch, nil is unlimited
+	epoch        uint64
+	trusted      bool
+}
+
+// takeComputeUnits takes compute units from the epoch limit of the key, nothing is taken when not enough are left
+func (key *dappKey) takeComputeUnits(computeUnits uint64, epoch uint64) bool {
+	if key.computeUnits == nil {
+		return true
+	}
+	if key.epoch != epoch {
+		key.computeUnits.reset()
+		key.epoch = epoch
+	}
+	if key.computeUnits.tokens < float64(computeUnits) {
+		return false
+	}
+	key.computeUnits.tokens -= float64(computeUnits)
+	return true
+}
+
+type DappRegistry struct {
+	lock  sync.Mutex
+	keys  map[string]*dappKey   // key == api key
+	usage map[string]*DappUsage // key == dapp id
+}
+
+func NewDappRegistry(config DappsConfig) (*DappRegistry, error) {
+	registry := &DappRegistry{keys: map[string]*dappKey{}, usage: map[string]*DappUsage{}}
+	now := time.Now()
+	for _, dapp := range config.Dapps {
+		if dapp.DappID == "" {
+			return nil, fmt.Errorf("dapp without a dapp_id in the dapps config")
+		}
+		registry.usage[dapp.DappID] = &DappUsage{}
+		for _, keyConfig := range dapp.Keys {
+			if keyConfig.Key == "" {
+				return nil, fmt.Errorf("empty api key for dapp %s", dapp.DappID)
+			}
+			if _, ok := registry.keys[keyConfig.Key]; ok {
+				return nil, fmt.Errorf("api key of dapp %s is used more than once", dapp.DappID)
+			}
+			key := &dappKey{dappID: dapp.DappID, trusted: keyConfig.Trusted}
+			if keyConfig.RequestsPerSecond > 0 {
+				burst := float64(keyConfig.Burst)
+				if burst < keyConfig.RequestsPerSecond {
+					burst = keyConfig.RequestsPerSecond
+				}
+				key.requests = newTokenBucket(burst, keyConfig.RequestsPerSecond, now)
+			}
+			if keyConfig.CuPerEpoch > 0 {
+				key.computeUnits = newTokenBucket(float64(keyConfig.CuPerEpoch), 0, now)
+			}
+			registry.keys[keyConfig.Key] = key
+		}
+	}
+	return registry, nil
+}
+
+// LoadDappRegistry reads the dapps registry from a json file in the DappsConfig format
+func LoadDappRegistry(path string) (*DappRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := DappsConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid dapps config %s: %w", path, err)
+	}
+	return NewDappRegistry(config)
+}
+
+// Authorize returns the dapp of an api key without charging it, used when a websocket connection is opened
+func (dr *DappRegistry) Authorize(apiKey string) (dappID string, err error) {
+	dr.lock.Lock()
+	defer dr.lock.Unlock()
+	key, ok := dr.keys[apiKey]
+	if !ok {
+		return "", ErrDappUnauthorized
+	}
+	return key.dappID, nil
+}
+
+// IsTrusted returns whether an api key may select the providers of its relays
+func (dr *DappRegistry) IsTrusted(apiKey string) bool {
+	dr.lock.Lock()
+	defer dr.lock.Unlock()
+	key, ok := dr.keys[apiKey]
+	return ok && key.trusted
+}
+
+// ChargeRelay charges a relay of computeUnits on the api key limits and returns the dapp of the key.
+// nothing is charged when one of the limits is exceeded
+func (dr *DappRegistry) ChargeRelay(apiKey string, computeUnits uint64, epoch uint64) (dappID string, err error) {
+	dr.lock.Lock()
+	defer dr.lock.Unlock()
+	key, ok := dr.keys[apiKey]
+	if !ok {
+		return "", ErrDappUnauthorized
+	}
+	usage := dr.usage[key.dappID]
+	now := time.Now()
+	if key.requests != nil {
+		key.requests.refill(now)
+		if key.requests.tokens < 1 {
+			usage.RateLimited++
+			return key.dappID, ErrDappRateLimited
+		}
+	}
+	if !key.takeComputeUnits(computeUnits, epoch) {
+		usage.RateLimited++
+		return key.dappID, ErrDappComputeUnitsExhausted
+	}
+	if key.requests != nil {
+		key.requests.tokens--
+	}
+	usage.Relays++
+	usage.ComputeUnits += computeUnits
+	return key.dappID, nil
+}
+
+// ChargeComputeUnits charges compute units on the api key limits without counting a request, used for the
+// compute units a subscription accrues after it was opened. nothing is charged when the compute units are exhausted
+func (dr *DappRegistry) ChargeComputeUnits(apiKey string, computeUnits uint64, epoch uint64) error {
+	dr.lock.Lock()
+	defer dr.lock.Unlock()
+	key, ok := dr.keys[apiKey]
+	if !ok {
+		return ErrDappUnauthorized
+	}
+	usage := dr.usage[key.dappID]
+	if !key.takeComputeUnits(computeUnits, epoch) {
+		usage.RateLimited++
+		return ErrDappComputeUnitsExhausted
+	}
+	usage.ComputeUnits += computeUnits
+	return nil
+}
+
+// Usage returns a copy of the usage counters of every dapp
+func (dr *DappRegistry) Usage() map[string]DappUsage {
+	dr.lock.Lock()
+	defer dr.lock.Unlock()
+	usage := make(map[string]DappUsage, len(dr.usage))
+	for dappID, dappUsage := range dr.usage {
+		usage[dappID] = *dappUsage
+	}
+	return usage
+}
+
+// StartDappsUsageServer serves the dapps usage counters as json on addr, apart from the portal so it isn't public
+func StartDappsUsageServer(addr string, registry *DappRegistry) {
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Get("/dapps/usage", func(c *fiber.Ctx) error {
+		return c.JSON(registry.Usage())
+	})
+	go func() {
+		if err := app.Listen(addr); err != nil {
+			utils.LavaFormatError("dapps usage server failed", err, &map[string]string{"address": addr})
+		}
+	}()
+	utils.LavaFormatInfo("start dapps usage HTTP server", &map[string]string{"IPAddress": addr})
+}
+
+// authorizeDappRelay charges a portal request on the limits of its api key and returns the dapp id to relay with.
+// without a registry the dapp id of the path is used as is
+func authorizeDappRelay(cp ChainProxy, apiKey string, dappID string, url string, data string, connectionType string) (string, error) {
+	registry := cp.GetDappRegistry()
+	if registry == nil {
+		return dappID, nil
+	}
+	computeUnits := uint64(0)
+	// apis the spec doesn't support are failed by the relay, they only count as a request here
+	if nodeMsg, err := cp.ParseMsg(url, []byte(data), connectionType, nil); err == nil {
+		computeUnits = nodeMsg.GetServiceApi().ComputeUnits
+	}
+	return registry.ChargeRelay(apiKey, computeUnits, cp.GetSentry().GetCurrentEpochHeight())
+}
+
+// dappSubscriptionCharge charges an api key for the compute units its subscription accrues with the spec subscription
+// billing, the same compute units the consumer pays the provider on top of the subscribe relay
+type dappSubscriptionCharge struct {
+	cp        ChainProxy
+	apiKey    string
+	billing   spectypes.SubscriptionBilling
+	startTime time.Time
+	messages  uint64
+	charged   uint64
+}
+
+// newDappSubscriptionCharge returns nil, that charges nothing, without a registry or when the subscription isn't billed
+func newDappSubscriptionCharge(cp ChainProxy, apiKey string, data string, connectionType string) *dappSubscriptionCharge {
+	if cp.GetDappRegistry() == nil {
+		return nil
+	}
+	nodeMsg, err := cp.ParseMsg("", []byte(data), connectionType, nil)
+	if err != nil || !nodeMsg.GetServiceApi().SubscriptionBilling.IsBilled() {
+		return nil
+	}
+	return &dappSubscriptionCharge{cp: cp, apiKey: apiKey, billing: nodeMsg.GetServiceApi().SubscriptionBilling, startTime: time.Now()}
+}
+
+// onMessage charges the compute units accrued up to a streamed message, the subscription is closed on an error
+func (dc *dappSubscriptionCharge) onMessage() error {
+	if dc == nil {
+		return nil
+	}
+	dc.messages++
+	return dc.charge()
+}
+
+// charge charges the compute units accrued since the last charge
+func (dc *dappSubscriptionCharge) charge() error {
+	if dc == nil {
+		return nil
+	}
+	accrued := dc.billing.ComputeUnits(dc.messages, time.Since(dc.startTime))
+	if accrued <= dc.charged {
+		return nil
+	}
+	err := dc.cp.GetDappRegistry().ChargeComputeUnits(dc.apiKey, accrued-dc.charged, dc.cp.GetSentry().GetCurrentEpochHeight())
+	if err != nil {
+		return err
+	}
+	dc.charged = accrued
+	return nil
+}
+
+func dappErrorStatus(err error) int {
+	if ErrDappUnauthorized.Is(err) {
+		return fiber.StatusUnauthorized
+	}
+	return fiber.StatusTooManyRequests
+}
+
+func jsonRpcDappError(err error) []byte {
+	code := jsonRpcLimitExceeded
+	if ErrDappUnauthorized.Is(err) {
+		code = jsonRpcUnauthorizedCode
+	}
+	reply, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      nil,
+		"error":   map[string]interface{}{"code": code, "message": err.Error()},
+	})
+	return reply
+}
+
+func sendJsonRpcDappError(c *fiber.Ctx, err error) error {
+	c.Status(dappErrorStatus(err))
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Send(jsonRpcDappError(err))
+}
+
+func sendRestDappError(c *fiber.Ctx, err error) error {
+	code := restResourceExhausted
+	if ErrDappUnauthorized.Is(err) {
+		code = restUnauthenticatedCode
+	}
+	c.Status(dappErrorStatus(err))
+	return c.JSON(map[string]interface{}{"code": code, "message": err.Error(), "details": []interface{}{}})
+}
+
+func grpcDappError(err error) error {
+	if ErrDappUnauthorized.Is(err) {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return status.Error(codes.ResourceExhausted, err.Error())
+}
+
+func apiKeyFromGrpcContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(GrpcApiKeyHeader); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func extractApiKeyFromWebsocketConnection(c *websocket.Conn) string {
+	if apiKey, ok := c.Locals(ContextUserValueKeyApiKey).(string); ok {
+		return apiKey
+	}
+	return ""
+}
@@ -0,0 +1,96 @@
+package chainproxy
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/relayer/sentry"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDappRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dapps.json")
+	err := os.WriteFile(path, []byte(`{"dapps":[{"dapp_id":"explorer","keys":[{"key":"k1","requests_per_second":5},{"key":"k2"}]}]}`), 0o600)
+	require.Nil(t, err)
+	registry, err := LoadDappRegistry(path)
+	require.Nil(t, err)
+	dappID, err := registry.Authorize("k2")
+	require.Nil(t, err)
+	require.Equal(t, "explorer", dappID)
+	_, err = registry.Authorize("unknown")
+	require.True(t, ErrDappUnauthorized.Is(err))
+
+	_, err = NewDappRegistry(DappsConfig{Dapps: []DappConfig{{DappID: "a", Keys: []DappKeyConfig{{Key: "k"}}}, {DappID: "b", Keys: []DappKeyConfig{{Key: "k"}}}}})
+	require.Error(t, err)
+	_, err = NewDappRegistry(DappsConfig{Dapps: []DappConfig{{DappID: "", Keys: []DappKeyConfig{{Key: "k"}}}}})
+	require.Error(t, err)
+	_, err = NewDappRegistry(DappsConfig{Dapps: []DappConfig{{DappID: "a", Keys: []DappKeyConfig{{Key: ""}}}}})
+	require.Error(t, err)
+}
+
+func TestDappRegistryLimits(t *testing.T) {
+	registry, err := NewDappRegistry(DappsConfig{Dapps: []DappConfig{{
+		DappID: "explorer",
+		Keys: []DappKeyConfig{
+			{Key: "requests", RequestsPerSecond: 0.001, Burst: 2},
+			{Key: "cu", CuPerEpoch: 25},
+		},
+	}}})
+	require.Nil(t, err)
+
+	_, err = registry.ChargeRelay("unknown", 10, 20)
+	require.True(t, ErrDappUnauthorized.Is(err))
+
+	// requests per second, the burst is allowed at once
+	for i := 0; i < 2; i++ {
+		dappID, err := registry.ChargeRelay("requests", 10, 20)
+		require.Nil(t, err)
+		require.Equal(t, "explorer", dappID)
+	}
+	_, err = registry.ChargeRelay("requests", 10, 20)
+	require.True(t, ErrDappRateLimited.Is(err))
+
+	// compute units per epoch, a relay over the remaining compute units isn't charged
+	_, err = registry.ChargeRelay("cu", 10, 20)
+	require.Nil(t, err)
+	_, err = registry.ChargeRelay("cu", 20, 20)
+	require.True(t, ErrDappComputeUnitsExhausted.Is(err))
+	_, err = registry.ChargeRelay("cu", 15, 20)
+	require.Nil(t, err)
+	_, err = registry.ChargeRelay("cu", 10, 40)
+	require.Nil(t, err)
+
+	require.Equal(t, map[string]DappUsage{"explorer": {Relays: 5, ComputeUnits: 55, RateLimited: 2}}, registry.Usage())
+}
+
+func TestDappSubscriptionCharge(t *testing.T) {
+	registry, err := NewDappRegistry(DappsConfig{Dapps: []DappConfig{{DappID: "explorer", Keys: []DappKeyConfig{{Key: "cu", CuPerEpoch: 25}}}}})
+	require.Nil(t, err)
+	cp := &JrpcChainProxy{dapps: registry, sentry: &sentry.Sentry{}}
+	dappCharge := &dappSubscriptionCharge{cp: cp, apiKey: "cu", billing: spectypes.SubscriptionBilling{ComputeUnitsPerMessage: 10}, startTime: time.Now()}
+
+	// every streamed message is charged, until the compute units of the epoch are exhausted
+	require.Nil(t, dappCharge.onMessage())
+	require.Nil(t, dappCharge.onMessage())
+	require.True(t, ErrDappComputeUnitsExhausted.Is(dappCharge.onMessage()))
+	require.Equal(t, map[string]DappUsage{"explorer": {ComputeUnits: 20, RateLimited: 1}}, registry.Usage())
+	require.True(t, ErrDappUnauthorized.Is(registry.ChargeComputeUnits("unknown", 10, 0)))
+
+	// without a registry nothing is charged
+	var noCharge *dappSubscriptionCharge
+	require.Nil(t, noCharge.onMessage())
+	require.Nil(t, noCharge.charge())
+}
+
+func TestDappErrors(t *testing.T) {
+	reply := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(jsonRpcDappError(ErrDappRateLimited), &reply))
+	require.Equal(t, "2.0", reply["jsonrpc"])
+	require.Equal(t, float64(jsonRpcLimitExceeded), reply["error"].(map[string]interface{})["code"])
+	require.Equal(t, 401, dappErrorStatus(ErrDappUnauthorized))
+	require.Equal(t, 429, dappErrorStatus(ErrDappComputeUnitsExhausted))
+}
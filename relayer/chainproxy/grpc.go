@@ -9,19 +9,20 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"sync"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/fullstorydev/grpcurl"
 	"github.com/golang/protobuf/proto"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/dynamic"
 	"github.com/jhump/protoreflect/grpcreflect"
 	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
-	"github.com/lavanet/lava/relayer/chainproxy/thirdparty"
 	"github.com/lavanet/lava/relayer/lavasession"
 	"github.com/lavanet/lava/relayer/parser"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -32,6 +33,14 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// GrpcEncodingMetadataName is the relay metadata telling the provider how a grpc request is encoded,
+	// requests without it are json, as sent by older consumers
+	GrpcEncodingMetadataName = "lava-grpc-encoding"
+	GrpcEncodingProto        = "proto"
+	GrpcEncodingJson         = "json"
+)
+
 type GrpcMessage struct {
 	methodDesc *desc.MethodDescriptor
 	formatter  grpcurl.Formatter
@@ -43,29 +52,37 @@ type GrpcMessage struct {
 	msg            interface{}
 	requestedBlock int64
 	connectionType string
+	encoding       string // GrpcEncodingProto or GrpcEncodingJson, how msg is encoded
 	Result         json.RawMessage
+	nodeUrl        string // when set the message is only sent to this node
 }
 
 type GrpcChainProxy struct {
-	conn       *GRPCConnector
+	conns      map[string]*GRPCConnector
+	connsLock  sync.RWMutex
 	nConns     uint
-	nodeUrl    string
+	maxConns   uint
+	nodes      *NodeSet
 	sentry     *sentry.Sentry
 	csm        *lavasession.ConsumerSessionManager
 	portalLogs *PortalLogs
 	chainID    string
 	cache      *performance.Cache
+	dapps      *DappRegistry
+
+	descriptorSetFiles []string // descriptors served by the portal reflection
 }
 
 func (r *GrpcMessage) GetMsg() interface{} {
 	return r.msg
 }
 
-func NewGrpcChainProxy(nodeUrl string, nConns uint, sentry *sentry.Sentry, csm *lavasession.ConsumerSessionManager, pLogs *PortalLogs) ChainProxy {
-	nodeUrl = strings.TrimSuffix(nodeUrl, "/")
+func NewGrpcChainProxy(nodes *NodeSet, nConns uint, maxConns uint, sentry *sentry.Sentry, csm *lavasession.ConsumerSessionManager, pLogs *PortalLogs) ChainProxy {
 	return &GrpcChainProxy{
-		nodeUrl:    nodeUrl,
+		conns:      map[string]*GRPCConnector{},
+		nodes:      nodes,
 		nConns:     nConns,
+		maxConns:   maxConns,
 		sentry:     sentry,
 		csm:        csm,
 		portalLogs: pLogs,
@@ -140,6 +157,7 @@ func (cp *GrpcChainProxy) NewMessage(path string, data []byte, connectionType st
 		apiInterface: apiInterface,
 		path:         path,
 		msg:          data,
+		encoding:     GrpcEncodingJson,
 	}
 
 	return nodeMsg, nil
@@ -154,7 +172,7 @@ func (cp *GrpcChainProxy) FetchBlockHashByNum(ctx context.Context, blockNum int6
 	var nodeMsg NodeMessage
 	var err error
 	if serviceApi.GetParsing().FunctionTemplate != "" {
-		nodeMsg, err = cp.ParseMsg(serviceApi.Name, []byte(fmt.Sprintf(serviceApi.GetParsing().FunctionTemplate, blockNum)), http.MethodGet)
+		nodeMsg, err = cp.ParseMsg(serviceApi.Name, []byte(fmt.Sprintf(serviceApi.GetParsing().FunctionTemplate, blockNum)), http.MethodGet, nil)
 	} else {
 		nodeMsg, err = cp.NewMessage(serviceApi.Name, nil, http.MethodGet)
 	}
@@ -183,6 +201,11 @@ func (cp *GrpcChainProxy) FetchBlockHashByNum(ctx context.Context, blockNum int6
 }
 
 func (cp *GrpcChainProxy) FetchLatestBlockNum(ctx context.Context) (int64, error) {
+	return cp.fetchLatestBlockNumFromNode(ctx, "")
+}
+
+// fetches the latest block from a specific node, or from any node when nodeUrl is empty
+func (cp *GrpcChainProxy) fetchLatestBlockNumFromNode(ctx context.Context, nodeUrl string) (int64, error) {
 	serviceApi, ok := cp.GetSentry().GetSpecApiByTag(spectypes.GET_BLOCKNUM)
 	if !ok {
 		return spectypes.NOT_APPLICABLE, errors.New(spectypes.GET_BLOCKNUM + " tag function not found")
@@ -193,10 +216,11 @@ func (cp *GrpcChainProxy) FetchLatestBlockNum(ctx context.Context) (int64, error
 	if err != nil {
 		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("new Message creation Failed at FetchLatestBlockNum", err, nil)
 	}
+	nodeMsg.nodeUrl = nodeUrl
 
 	_, _, _, err = nodeMsg.Send(ctx, nil)
 	if err != nil {
-		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Message send Failed at FetchLatestBlockNum", err, nil)
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Message send Failed at FetchLatestBlockNum", err, &map[string]string{"nodeUrl": nodeUrl})
 	}
 
 	blocknum, err := parser.ParseBlockFromReply(nodeMsg, serviceApi.Parsing.ResultParsing)
@@ -212,12 +236,66 @@ func (cp *GrpcChainProxy) GetSentry() *sentry.Sentry {
 }
 
 func (cp *GrpcChainProxy) Start(ctx context.Context) error {
-	cp.conn = NewGRPCConnector(ctx, cp.nConns, cp.nodeUrl)
-	if cp.conn == nil {
+	err := cp.startConnectors(ctx)
+	if err != nil {
+		return err
+	}
+	go cp.nodes.HealthCheckLoop(ctx, getNodeHealthInterval(cp.sentry.GetAverageBlockTime()), cp.fetchLatestBlockNumFromNode)
+	return nil
+}
+
+// startConnectors connects to all the nodes in the background and returns once at least one of them is connected
+func (cp *GrpcChainProxy) startConnectors(ctx context.Context) error {
+	nodeUrls := cp.nodes.Urls()
+	if len(nodeUrls) == 0 {
+		return errors.New("no node urls were provided")
+	}
+	connected := make(chan struct{}, len(nodeUrls))
+	for _, nodeUrl := range nodeUrls {
+		go func(nodeUrl string) {
+			conn := NewGRPCConnector(ctx, cp.nConns, cp.maxConns, nodeUrl)
+			if conn == nil {
+				return
+			}
+			cp.connsLock.Lock()
+			cp.conns[nodeUrl] = conn
+			cp.connsLock.Unlock()
+			connected <- struct{}{}
+		}(nodeUrl)
+	}
+	select {
+	case <-connected:
+		return nil
+	case <-ctx.Done():
 		return utils.LavaFormatError("g_conn == nil", nil, nil)
 	}
+}
 
-	return nil
+func (cp *GrpcChainProxy) getConnector(nodeUrl string) (*GRPCConnector, error) {
+	cp.connsLock.RLock()
+	defer cp.connsLock.RUnlock()
+	conn, ok := cp.conns[nodeUrl]
+	if !ok {
+		return nil, fmt.Errorf("node %s is not connected", nodeUrl)
+	}
+	return conn, nil
+}
+
+// nodeUrlsForMessage returns the nodes to try for a message in order
+func (cp *GrpcChainProxy) nodeUrlsForMessage(nodeUrl string, requestedBlock int64) []string {
+	if nodeUrl != "" {
+		return []string{nodeUrl}
+	}
+	return cp.nodes.OrderedUrls(requestedBlock)
+}
+
+// errors returned by a responsive node are not failures, unreachable or timed out nodes are
+func isGrpcNodeFailure(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	code := status.Code(errors.Cause(err))
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
 }
 
 func (cp *GrpcChainProxy) getSupportedApi(path string) (*spectypes.ServiceApi, error) {
@@ -227,10 +305,15 @@ func (cp *GrpcChainProxy) getSupportedApi(path string) (*spectypes.ServiceApi, e
 		}
 		return &api, nil
 	}
+	if path == GrpcReflectionMethod {
+		api := grpcReflectionServiceApi()
+		return &api, nil
+	}
 	return nil, fmt.Errorf("gRPC Api not supported %s ", path)
 }
 
-func (cp *GrpcChainProxy) ParseMsg(path string, data []byte, connectionType string) (NodeMessage, error) {
+// ParseMsg parses a relayed grpc request, headers are not relayed on grpc so metadata only sets the request encoding
+func (cp *GrpcChainProxy) ParseMsg(path string, data []byte, connectionType string, metadata []pairingtypes.Metadata) (NodeMessage, error) {
 	// Check API is supported and save it in nodeMsg.
 	serviceApi, err := cp.getSupportedApi(path)
 	if err != nil {
@@ -251,14 +334,30 @@ func (cp *GrpcChainProxy) ParseMsg(path string, data []byte, connectionType stri
 	nodeMsg := &GrpcMessage{
 		cp:             cp,
 		serviceApi:     serviceApi,
+		apiInterface:   apiInterface,
 		path:           path,
 		msg:            data,
 		connectionType: connectionType,
+		encoding:       grpcEncoding(metadata),
 	}
 
 	return nodeMsg, nil
 }
 
+func grpcEncoding(metadata []pairingtypes.Metadata) string {
+	for _, header := range metadata {
+		if header.Name == GrpcEncodingMetadataName && header.Value == GrpcEncodingProto {
+			return GrpcEncodingProto
+		}
+	}
+	return GrpcEncodingJson
+}
+
+// grpcProtoMetadata marks the portal relays, that are sent in their proto encoding
+func grpcProtoMetadata() []pairingtypes.Metadata {
+	return []pairingtypes.Metadata{{Name: GrpcEncodingMetadataName, Value: GrpcEncodingProto}}
+}
+
 func (cp *GrpcChainProxy) SetCache(cache *performance.Cache) {
 	cp.cache = cache
 }
@@ -267,7 +366,65 @@ func (cp *GrpcChainProxy) GetCache() *performance.Cache {
 	return cp.cache
 }
 
-func (cp *GrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.PrivateKey, listenAddr string) {
+func (cp *GrpcChainProxy) SetDappRegistry(dapps *DappRegistry) {
+	cp.dapps = dapps
+}
+
+func (cp *GrpcChainProxy) GetDappRegistry() *DappRegistry {
+	return cp.dapps
+}
+
+func (cp *GrpcChainProxy) GetPortalLogs() *PortalLogs {
+	return cp.portalLogs
+}
+
+func (cp *GrpcChainProxy) SetDescriptorSetFiles(descriptorSetFiles []string) {
+	cp.descriptorSetFiles = descriptorSetFiles
+}
+
+// portalServiceFiles returns the proto files of the spec services: from the descriptor set files, the chain cache or
+// the providers reflection through relay, and the descriptors linked into lavad when the providers can't be reached
+func (cp *GrpcChainProxy) portalServiceFiles(ctx context.Context, relay grpcRelayFunc) (map[string]string, error) {
+	serviceFiles, err := loadDescriptorSets(cp.descriptorSetFiles)
+	if err != nil {
+		return nil, err
+	}
+	serviceMethods := map[string][]string{}
+	for _, apiName := range cp.sentry.GetSpecApiNames() {
+		service, method := ParseSymbol(apiName)
+		if service == "" {
+			continue
+		}
+		serviceMethods[service] = append(serviceMethods[service], method)
+	}
+	for service, methods := range serviceMethods {
+		if _, ok := serviceFiles[service]; ok {
+			continue
+		}
+		if file, ok := fetchedGrpcDescriptors.get(cp.chainID, service); ok {
+			serviceFiles[service] = file
+			continue
+		}
+		fetchCtx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+		file, fetchErr := fetchGrpcServiceFile(fetchCtx, relay, service)
+		cancel()
+		if fetchErr == nil {
+			fetchedGrpcDescriptors.set(cp.chainID, service, file)
+			serviceFiles[service] = file
+			continue
+		}
+		file, ok := findServiceFile(service, methods)
+		if !ok {
+			utils.LavaFormatWarning("no descriptors found for service, it is relayed but not listed by reflection", fetchErr, &map[string]string{"service": service, "ChainID": cp.chainID})
+			continue
+		}
+		utils.LavaFormatWarning("failed fetching the service descriptors from the providers, using the ones linked into lavad", fetchErr, &map[string]string{"service": service, "ChainID": cp.chainID})
+		serviceFiles[service] = file
+	}
+	return serviceFiles, nil
+}
+
+func (cp *GrpcChainProxy) PortalStart(ctx context.Context, signer sigs.Signer, listenAddr string) {
 	utils.LavaFormatInfo("gRPC PortalStart", nil)
 
 	lis, err := net.Listen("tcp", listenAddr)
@@ -277,9 +434,16 @@ func (cp *GrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 
 	sendRelayCallback := func(ctx context.Context, method string, reqBody []byte) ([]byte, error) {
 		msgSeed := cp.portalLogs.GetMessageSeed()
+		ctx, span := StartPortalSpan(ctx, cp, "grpc")
+		defer span.End()
 		utils.LavaFormatInfo("GRPC Got Relay: "+method, nil)
 		var relayReply *pairingtypes.RelayReply
-		if relayReply, _, err = SendRelay(ctx, cp, privKey, method, string(reqBody), "", "NoDappID"); err != nil {
+		dappID, err := authorizeDappRelay(cp, apiKeyFromGrpcContext(ctx), "NoDappID", method, string(reqBody), "")
+		if err != nil {
+			return nil, grpcDappError(err)
+		}
+		if relayReply, _, _, err = SendRelay(ctx, cp, signer, method, string(reqBody), "", dappID, "", grpcProtoMetadata(), nil); err != nil {
+			tracing.RecordError(span, err)
 			errMasking := cp.portalLogs.GetUniqueGuidResponseForError(err, msgSeed)
 			cp.portalLogs.LogRequestAndResponse("http in/out", true, method, string(reqBody), "", errMasking, msgSeed, err)
 			return nil, utils.LavaFormatError("Failed to SendRelay", fmt.Errorf(errMasking), nil)
@@ -288,10 +452,20 @@ func (cp *GrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 		return relayReply.Data, nil
 	}
 
-	_, httpServer, err := thirdparty.RegisterServer(cp.chainID, sendRelayCallback)
+	// the descriptor fetches are relays of the portal itself, not of a dapp
+	fetchRelay := func(ctx context.Context, method string, reqBody []byte) ([]byte, error) {
+		relayReply, _, _, err := SendRelay(ctx, cp, signer, method, string(reqBody), "", "NoDappID", "", grpcProtoMetadata(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return relayReply.Data, nil
+	}
+	serviceFiles, err := cp.portalServiceFiles(ctx, fetchRelay)
 	if err != nil {
-		utils.LavaFormatFatal("provider failure RegisterServer", err, &map[string]string{"listenAddr": listenAddr})
+		utils.LavaFormatFatal("portal failure loading grpc descriptors", err, &map[string]string{"listenAddr": listenAddr})
 	}
+	utils.LavaFormatInfo("Registering Chain:"+cp.chainID, &map[string]string{"services": fmt.Sprintf("%d", len(serviceFiles))})
+	_, httpServer := newGrpcPortalServer(sendRelayCallback, serviceFiles)
 
 	utils.LavaFormatInfo("Server listening", &map[string]string{"Address": lis.Addr().String()})
 
@@ -308,45 +482,83 @@ func (nm *GrpcMessage) Send(ctx context.Context, ch chan interface{}) (relayRepl
 	if ch != nil {
 		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on rest", nil, nil)
 	}
-	conn, err := nm.cp.conn.GetRpc(true)
-	if err != nil {
-		return nil, "", nil, utils.LavaFormatError("grpc get connection failed ", err, nil)
+	err = errors.New("no nodes to send the message to")
+	for _, nodeUrl := range nm.cp.nodeUrlsForMessage(nm.nodeUrl, nm.requestedBlock) {
+		var nodeErr error
+		relayReply, nodeErr, err = nm.sendToNode(ctx, nodeUrl)
+		nm.cp.nodes.OnNodeResult(nodeUrl, nodeErr)
+		if nodeErr == nil {
+			return relayReply, "", nil, err
+		}
+		if !canRetryOnAnotherNode(nm.apiInterface) {
+			break
+		}
+		utils.LavaFormatWarning("node failed to respond, trying the next node", nodeErr, &map[string]string{"nodeUrl": nodeUrl})
 	}
-	defer nm.cp.conn.ReturnRpc(conn)
+	return relayReply, "", nil, err
+}
 
-	connectCtx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+func (nm *GrpcMessage) sendToNode(ctx context.Context, nodeUrl string) (relayReply *pairingtypes.RelayReply, nodeErr error, err error) {
+	connector, err := nm.cp.getConnector(nodeUrl)
+	if err != nil {
+		return nil, err, err
+	}
+	connectCtx, cancel := context.WithTimeout(ctx, getTimePerCu(nm.serviceApi.ComputeUnits))
 	defer cancel()
+	conn, err := connector.GetRpc(connectCtx, true)
+	if err != nil {
+		if ctx.Err() == nil {
+			nodeErr = err
+		}
+		return nil, nodeErr, utils.LavaFormatError("grpc get connection failed ", err, nil)
+	}
+	defer func() {
+		if nodeErr != nil {
+			connector.DiscardRpc(conn)
+			return
+		}
+		connector.ReturnRpc(conn)
+	}()
 
-	cl := grpcreflect.NewClient(ctx, reflectionpbo.NewServerReflectionClient(conn))
+	cl := grpcreflect.NewClient(connectCtx, reflectionpbo.NewServerReflectionClient(conn))
+	defer cl.Reset()
 	descriptorSource := descriptorSourceFromServer(cl)
 	svc, methodName := ParseSymbol(nm.path)
 	var descriptor desc.Descriptor
 	if descriptor, err = descriptorSource.FindSymbol(svc); err != nil {
-		return nil, "", nil, utils.LavaFormatError("descriptorSource.FindSymbol", err, &map[string]string{"addr": nm.cp.nodeUrl})
+		if isGrpcNodeFailure(ctx, err) {
+			nodeErr = err
+		}
+		return nil, nodeErr, utils.LavaFormatError("descriptorSource.FindSymbol", err, &map[string]string{"addr": nodeUrl})
 	}
 
 	serviceDescriptor, ok := descriptor.(*desc.ServiceDescriptor)
 	if !ok {
-		return nil, "", nil, utils.LavaFormatError("serviceDescriptor, ok := descriptor.(*desc.ServiceDescriptor)", err, &map[string]string{"addr": nm.cp.nodeUrl, "descriptor": fmt.Sprintf("%v", descriptor)})
+		return nil, nil, utils.LavaFormatError("serviceDescriptor, ok := descriptor.(*desc.ServiceDescriptor)", err, &map[string]string{"addr": nodeUrl, "descriptor": fmt.Sprintf("%v", descriptor)})
 	}
 	methodDescriptor := serviceDescriptor.FindMethodByName(methodName)
 	if methodDescriptor == nil {
-		return nil, "", nil, utils.LavaFormatError("serviceDescriptor.FindMethodByName returned nil", err, &map[string]string{"addr": nm.cp.nodeUrl, "methodName": methodName})
+		return nil, nil, utils.LavaFormatError("serviceDescriptor.FindMethodByName returned nil", err, &map[string]string{"addr": nodeUrl, "methodName": methodName})
 	}
 	nm.methodDesc = methodDescriptor
 	msgFactory := dynamic.NewMessageFactoryWithDefaults()
 
-	var reader io.Reader
+	var reader io.Reader = bytes.NewReader(nil) // only read for json requests
 	msg := msgFactory.NewMessage(methodDescriptor.GetInputType())
 	formatMessage := false
 	switch v := nm.msg.(type) {
 	case []byte:
-		if len(v) > 0 {
+		if len(v) > 0 && nm.encoding == GrpcEncodingProto {
+			err = proto.Unmarshal(v, msg)
+			if err != nil {
+				return nil, nil, utils.LavaFormatError("Failed to unmarshal gRPC msg", err, &map[string]string{"Method": nm.path})
+			}
+		} else if len(v) > 0 {
 			reader = bytes.NewReader(v)
 			formatMessage = true
 		}
 	default:
-		return nil, "", nil, utils.LavaFormatError("Unsupported type for gRPC msg", nil, &map[string]string{"type": fmt.Sprintf("%T", v)})
+		return nil, nil, utils.LavaFormatError("Unsupported type for gRPC msg", nil, &map[string]string{"type": fmt.Sprintf("%T", v)})
 	}
 
 	rp, formatter, err := grpcurl.RequestParserAndFormatter(grpcurl.FormatJSON, descriptorSource, reader, grpcurl.FormatOptions{
@@ -355,33 +567,36 @@ func (nm *GrpcMessage) Send(ctx context.Context, ch chan interface{}) (relayRepl
 		AllowUnknownFields:    true,
 	})
 	if err != nil {
-		return nil, "", nil, utils.LavaFormatError("Failed to create formatter", err, &map[string]string{"addr": nm.cp.nodeUrl})
+		return nil, nil, utils.LavaFormatError("Failed to create formatter", err, &map[string]string{"addr": nodeUrl})
 	}
 	nm.formatter = formatter
 	if formatMessage {
 		err = rp.Next(msg)
 		if err != nil {
-			return nil, "", nil, utils.LavaFormatError("rp.Next(msg) Failed", err, nil)
+			return nil, nil, utils.LavaFormatError("rp.Next(msg) Failed", err, nil)
 		}
 	}
 
 	response := msgFactory.NewMessage(methodDescriptor.GetOutputType())
 	err = grpc.Invoke(connectCtx, nm.path, msg, response, conn)
 	if err != nil {
-		return nil, "", nil, utils.LavaFormatError("Invoke Failed", err, &map[string]string{"addr": nm.cp.nodeUrl, "Method": nm.path, "msg": fmt.Sprintf("%s", nm.msg)})
+		if isGrpcNodeFailure(ctx, err) {
+			nodeErr = err
+		}
+		return nil, nodeErr, utils.LavaFormatError("Invoke Failed", err, &map[string]string{"addr": nodeUrl, "Method": nm.path, "msg": fmt.Sprintf("%s", nm.msg)})
 	}
 
 	var respBytes []byte
 	respBytes, err = proto.Marshal(response)
 	if err != nil {
-		return nil, "", nil, utils.LavaFormatError("proto.Marshal(response) Failed", err, &map[string]string{"addr": nm.cp.nodeUrl})
+		return nil, nil, utils.LavaFormatError("proto.Marshal(response) Failed", err, &map[string]string{"addr": nodeUrl})
 	}
 
 	nm.Result = respBytes
 	reply := &pairingtypes.RelayReply{
 		Data: respBytes,
 	}
-	return reply, "", nil, nil
+	return reply, nil, nil
 }
 
 type ServerSource struct {
@@ -0,0 +1,399 @@
+package chainproxy
+
+//
+// JSON/HTTP gateway of the gRPC portal: routes are built from the google.api.http annotations of the served
+// services, requests are transcoded to protobuf and relayed over the grpc interface, replies are returned as JSON
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/lavanet/lava/utils"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcGatewayMaxBodySize is the largest request body the gateway reads, as the jsonrpc server limit
+const grpcGatewayMaxBodySize = 5 * 1024 * 1024
+
+type grpcGatewayRoute struct {
+	httpMethod   string
+	pathSegments []string // literal segments, "*" or "**" and variables as "{name}", "{name=**}" etc
+	body         string   // "" no body, "*" the whole request, otherwise the field the body is set into
+	method       *desc.MethodDescriptor
+}
+
+type grpcGateway struct {
+	routes    []*grpcGatewayRoute
+	sendRelay func(ctx context.Context, method string, reqBody []byte) ([]byte, error)
+}
+
+// newGrpcGateway builds the gateway routes of the services in serviceFiles (service name to proto file)
+func newGrpcGateway(serviceFiles map[string]string, sendRelay func(ctx context.Context, method string, reqBody []byte) ([]byte, error)) *grpcGateway {
+	gateway := &grpcGateway{sendRelay: sendRelay}
+	files := map[string]*desc.FileDescriptor{}
+	for service, fileName := range serviceFiles {
+		fd, err := loadFileDescriptor(fileName, files)
+		if err != nil {
+			utils.LavaFormatWarning("failed loading proto file, its service is not served by the grpc gateway", err, &map[string]string{"file": fileName, "service": service})
+			continue
+		}
+		serviceDescriptor := fd.FindService(service)
+		if serviceDescriptor == nil {
+			continue
+		}
+		for _, method := range serviceDescriptor.GetMethods() {
+			gateway.addMethodRoutes(method)
+		}
+	}
+	// routes with more literal segments are more specific and are matched first
+	sort.SliceStable(gateway.routes, func(i, j int) bool {
+		return gateway.routes[i].literalSegments() > gateway.routes[j].literalSegments()
+	})
+	return gateway
+}
+
+// loadFileDescriptor loads a proto file and its dependencies from the descriptors registered in lavad
+func loadFileDescriptor(fileName string, files map[string]*desc.FileDescriptor) (*desc.FileDescriptor, error) {
+	if fd, ok := files[fileName]; ok {
+		return fd, nil
+	}
+	compressed := gogoproto.FileDescriptor(fileName)
+	if len(compressed) == 0 {
+		//nolint: staticcheck
+		compressed = proto.FileDescriptor(fileName)
+	}
+	if len(compressed) == 0 {
+		return nil, fmt.Errorf("unknown proto file %s", fileName)
+	}
+	fdProto, err := decompressFileDescriptor(compressed)
+	if err != nil {
+		return nil, err
+	}
+	deps := make([]*desc.FileDescriptor, 0, len(fdProto.Dependency))
+	for _, dependency := range fdProto.Dependency {
+		dep, err := loadFileDescriptor(dependency, files)
+		if err != nil {
+			return nil, err
+		}
+		deps = append(deps, dep)
+	}
+	fd, err := desc.CreateFileDescriptor(fdProto, deps...)
+	if err != nil {
+		return nil, err
+	}
+	files[fileName] = fd
+	return fd, nil
+}
+
+func (gw *grpcGateway) addMethodRoutes(method *desc.MethodDescriptor) {
+	options := method.GetMethodOptions()
+	if options == nil || !proto.HasExtension(options, annotations.E_Http) {
+		return
+	}
+	extension, err := proto.GetExtension(options, annotations.E_Http)
+	if err != nil {
+		return
+	}
+	rule, ok := extension.(*annotations.HttpRule)
+	if !ok {
+		return
+	}
+	for _, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+		httpMethod, path := httpRulePattern(binding)
+		if path == "" {
+			continue
+		}
+		gw.routes = append(gw.routes, &grpcGatewayRoute{
+			httpMethod:   httpMethod,
+			pathSegments: splitPathTemplate(path),
+			body:         binding.GetBody(),
+			method:       method,
+		})
+	}
+}
+
+func httpRulePattern(rule *annotations.HttpRule) (httpMethod string, path string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		return pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	}
+	return "", ""
+}
+
+// splits a path template to segments, keeping variables with their own sub path as one segment
+func splitPathTemplate(path string) []string {
+	segments := []string{}
+	current := ""
+	depth := 0
+	for _, char := range strings.TrimPrefix(path, "/") {
+		switch {
+		case char == '{':
+			depth++
+		case char == '}':
+			depth--
+		case char == '/' && depth == 0:
+			segments = append(segments, current)
+			current = ""
+			continue
+		}
+		current += string(char)
+	}
+	return append(segments, current)
+}
+
+func (route *grpcGatewayRoute) literalSegments() int {
+	count := 0
+	for _, segment := range route.pathSegments {
+		if !strings.HasPrefix(segment, "{") && !strings.HasPrefix(segment, "*") {
+			count++
+		}
+	}
+	return count
+}
+
+// match returns the path variables when the path matches the route
+func (route *grpcGatewayRoute) match(httpMethod string, path string) (map[string]string, bool) {
+	if route.httpMethod != httpMethod {
+		return nil, false
+	}
+	pathSegments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	variables := map[string]string{}
+	pos := 0
+	for _, segment := range route.pathSegments {
+		if !strings.HasPrefix(segment, "{") {
+			if segment == "**" {
+				return variables, true
+			}
+			if pos >= len(pathSegments) || (segment != "*" && segment != pathSegments[pos]) {
+				return nil, false
+			}
+			pos++
+			continue
+		}
+		name, subPath := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}"), "*"
+		if eq := strings.Index(name, "="); eq >= 0 {
+			name, subPath = name[:eq], name[eq+1:]
+		}
+		subSegments := strings.Split(subPath, "/")
+		if subSegments[len(subSegments)-1] == "**" {
+			if pos+len(subSegments)-1 > len(pathSegments) {
+				return nil, false
+			}
+			variables[name] = strings.Join(pathSegments[pos:], "/")
+			return variables, true
+		}
+		if pos+len(subSegments) > len(pathSegments) {
+			return nil, false
+		}
+		for i, subSegment := range subSegments {
+			if subSegment != "*" && subSegment != pathSegments[pos+i] {
+				return nil, false
+			}
+		}
+		variables[name] = strings.Join(pathSegments[pos:pos+len(subSegments)], "/")
+		pos += len(subSegments)
+	}
+	return variables, pos == len(pathSegments)
+}
+
+func (gw *grpcGateway) HasRoutes() bool {
+	return len(gw.routes) > 0
+}
+
+func (gw *grpcGateway) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	resp.Header().Set("Access-Control-Allow-Origin", "*")
+	for _, route := range gw.routes {
+		variables, ok := route.match(req.Method, req.URL.Path)
+		if !ok {
+			continue
+		}
+		reply, err := gw.relay(req, route, variables)
+		if err != nil {
+			writeGatewayError(resp, err)
+			return
+		}
+		resp.Header().Set("Content-Type", "application/json")
+		resp.Write(reply)
+		return
+	}
+	writeGatewayError(resp, status.Errorf(codes.NotFound, "no route for %s %s", req.Method, req.URL.Path))
+}
+
+func (gw *grpcGateway) relay(req *http.Request, route *grpcGatewayRoute, variables map[string]string) ([]byte, error) {
+	request := dynamic.NewMessage(route.method.GetInputType())
+	if route.body != "" {
+		body, err := io.ReadAll(io.LimitReader(req.Body, grpcGatewayMaxBodySize+1))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if len(body) > grpcGatewayMaxBodySize {
+			return nil, status.Errorf(codes.InvalidArgument, "request body is larger than %d bytes", grpcGatewayMaxBodySize)
+		}
+		if len(body) > 0 {
+			if route.body != "*" {
+				body = []byte(fmt.Sprintf(`{%q:%s}`, route.body, body))
+			}
+			if err = request.UnmarshalJSON(body); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+	}
+
+	// path variables take precedence over query parameters
+	params := map[string][]string{}
+	if route.body != "*" {
+		for key, values := range req.URL.Query() {
+			params[key] = values
+		}
+	}
+	for name, value := range variables {
+		params[name] = []string{value}
+	}
+	if len(params) > 0 {
+		fields, err := paramsToJSON(route.method.GetInputType(), params)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err = request.UnmarshalMergeJSON(fields); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	requestBytes, err := request.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	fullMethod := route.method.GetService().GetFullyQualifiedName() + "/" + route.method.GetName()
+	// the api key header is read like the metadata of grpc requests
+	ctx := metadata.NewIncomingContext(req.Context(), metadata.MD{GrpcApiKeyHeader: req.Header.Values(GrpcApiKeyHeader)})
+	replyBytes, err := gw.sendRelay(ctx, fullMethod, requestBytes)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	reply := dynamic.NewMessage(route.method.GetOutputType())
+	if err = reply.Unmarshal(replyBytes); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return reply.MarshalJSONPB(&jsonpb.Marshaler{OrigName: true, EmitDefaults: true})
+}
+
+// paramsToJSON converts path and query parameters ("a.b=value") to a JSON object of the request message
+func paramsToJSON(message *desc.MessageDescriptor, params map[string][]string) ([]byte, error) {
+	object := map[string]interface{}{}
+	for key, values := range params {
+		fieldPath := strings.Split(key, ".")
+		current := object
+		currentMessage := message
+		for i, fieldName := range fieldPath {
+			field := currentMessage.FindFieldByName(fieldName)
+			if field == nil {
+				field = currentMessage.FindFieldByJSONName(fieldName)
+			}
+			if field == nil {
+				return nil, fmt.Errorf("unknown field %s in %s", key, message.GetFullyQualifiedName())
+			}
+			if i == len(fieldPath)-1 {
+				value, err := paramValue(field, values)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for %s: %w", key, err)
+				}
+				current[field.GetName()] = value
+				break
+			}
+			if field.GetMessageType() == nil || field.IsRepeated() {
+				return nil, fmt.Errorf("field %s in %s is not a message", fieldName, key)
+			}
+			next, ok := current[field.GetName()].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				current[field.GetName()] = next
+			}
+			current = next
+			currentMessage = field.GetMessageType()
+		}
+	}
+	return json.Marshal(object)
+}
+
+func paramValue(field *desc.FieldDescriptor, values []string) (interface{}, error) {
+	if field.IsRepeated() {
+		list := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			converted, err := paramScalarValue(field, value)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, converted)
+		}
+		return list, nil
+	}
+	return paramScalarValue(field, values[len(values)-1])
+}
+
+func paramScalarValue(field *desc.FieldDescriptor, value string) (interface{}, error) {
+	switch field.GetType() {
+	case dpb.FieldDescriptorProto_TYPE_BOOL:
+		return strconv.ParseBool(value)
+	case dpb.FieldDescriptorProto_TYPE_DOUBLE, dpb.FieldDescriptorProto_TYPE_FLOAT,
+		dpb.FieldDescriptorProto_TYPE_INT32, dpb.FieldDescriptorProto_TYPE_SINT32, dpb.FieldDescriptorProto_TYPE_SFIXED32,
+		dpb.FieldDescriptorProto_TYPE_UINT32, dpb.FieldDescriptorProto_TYPE_FIXED32:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, err
+		}
+		return json.Number(value), nil
+	}
+	// strings, bytes (base64), enums and 64 bit integers are strings in JSON
+	return value, nil
+}
+
+// writes a grpc-gateway style error
+func writeGatewayError(resp http.ResponseWriter, err error) {
+	grpcStatus, _ := status.FromError(err)
+	httpStatus := http.StatusInternalServerError
+	switch grpcStatus.Code() {
+	case codes.InvalidArgument:
+		httpStatus = http.StatusBadRequest
+	case codes.NotFound:
+		httpStatus = http.StatusNotFound
+	case codes.Unavailable:
+		httpStatus = http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		httpStatus = http.StatusGatewayTimeout
+	case codes.Unauthenticated:
+		httpStatus = http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		httpStatus = http.StatusTooManyRequests
+	}
+	body, _ := json.Marshal(map[string]interface{}{"code": grpcStatus.Code(), "message": grpcStatus.Message(), "details": []interface{}{}})
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(httpStatus)
+	resp.Write(body)
+}
@@ -0,0 +1,84 @@
+package chainproxy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGrpcGatewayRouteMatch(t *testing.T) {
+	route := &grpcGatewayRoute{httpMethod: http.MethodGet, pathSegments: splitPathTemplate("/cosmos/bank/v1beta1/denoms_metadata/{denom=**}")}
+	variables, ok := route.match(http.MethodGet, "/cosmos/bank/v1beta1/denoms_metadata/ibc/ABCD")
+	require.True(t, ok)
+	require.Equal(t, "ibc/ABCD", variables["denom"])
+	_, ok = route.match(http.MethodPost, "/cosmos/bank/v1beta1/denoms_metadata/ibc/ABCD")
+	require.False(t, ok)
+
+	route = &grpcGatewayRoute{httpMethod: http.MethodGet, pathSegments: splitPathTemplate("/lavanet/lava/pairing/providers/{chainID}")}
+	variables, ok = route.match(http.MethodGet, "/lavanet/lava/pairing/providers/LAV1")
+	require.True(t, ok)
+	require.Equal(t, "LAV1", variables["chainID"])
+	_, ok = route.match(http.MethodGet, "/lavanet/lava/pairing/providers/LAV1/extra")
+	require.False(t, ok)
+}
+
+func TestGrpcGatewayTranscoding(t *testing.T) {
+	relayed := map[string][]byte{}
+	sendRelay := func(ctx context.Context, method string, reqBody []byte) ([]byte, error) {
+		relayed[method] = reqBody
+		switch method {
+		case "lavanet.lava.pairing.Query/Providers":
+			reply := &pairingtypes.QueryProvidersResponse{Output: "ok"}
+			return reply.Marshal()
+		default:
+			reply := &pairingtypes.QueryParamsResponse{Params: pairingtypes.DefaultParams()}
+			return reply.Marshal()
+		}
+	}
+	gateway := newGrpcGateway(map[string]string{"lavanet.lava.pairing.Query": "pairing/query.proto"}, sendRelay)
+	require.True(t, gateway.HasRoutes())
+
+	resp := httptest.NewRecorder()
+	gateway.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/lavanet/lava/pairing/providers/LAV1", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	request := &pairingtypes.QueryProvidersRequest{}
+	require.Nil(t, request.Unmarshal(relayed["lavanet.lava.pairing.Query/Providers"]))
+	require.Equal(t, "LAV1", request.ChainID)
+	reply := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(resp.Body.Bytes(), &reply))
+	require.Equal(t, "ok", reply["output"])
+
+	resp = httptest.NewRecorder()
+	gateway.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/lavanet/lava/pairing/params", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, relayed, "lavanet.lava.pairing.Query/Params")
+
+	resp = httptest.NewRecorder()
+	gateway.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/lavanet/lava/pairing/unknown", nil))
+	require.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestGrpcGatewayBodyLimit(t *testing.T) {
+	sendRelay := func(ctx context.Context, method string, reqBody []byte) ([]byte, error) {
+		reply := &pairingtypes.QueryParamsResponse{Params: pairingtypes.DefaultParams()}
+		return reply.Marshal()
+	}
+	gateway := newGrpcGateway(map[string]string{"lavanet.lava.pairing.Query": "pairing/query.proto"}, sendRelay)
+	require.NotEmpty(t, gateway.routes)
+	route := &grpcGatewayRoute{httpMethod: http.MethodPost, body: "*", method: gateway.routes[0].method}
+
+	_, err := gateway.relay(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`)), route, nil)
+	require.Nil(t, err)
+	largeBody := `{"pad":"` + strings.Repeat("a", grpcGatewayMaxBodySize) + `"}`
+	_, err = gateway.relay(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(largeBody)), route, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "larger than")
+}
@@ -0,0 +1,246 @@
+package chainproxy
+
+//
+// The gRPC portal is a generic proxy: every method is accepted by the unknown service handler and relayed
+// to the providers as raw bytes, so serving a new chain only needs its spec.
+// server reflection is served from the descriptors of the spec services, fetched from the providers reflection
+// (grpcReflection.go), with descriptor set files given to the portal taking precedence and the descriptors linked into
+// lavad as a fallback when the providers can't be reached. the same descriptors serve a JSON/HTTP gateway on the same
+// address. relaying doesn't need descriptors on the portal, the provider resolves the method from its node reflection
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/lavanet/lava/utils"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	encodingproto "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const GrpcDescriptorSetFlagName = "grpc-descriptor-set"
+
+// rawGrpcFrame is a message relayed without decoding it
+type rawGrpcFrame struct {
+	data []byte
+}
+
+// grpcPortalCodec passes relayed frames as is and uses the proto codec for the portal own services (reflection)
+type grpcPortalCodec struct{}
+
+func (grpcPortalCodec) Marshal(v interface{}) ([]byte, error) {
+	if frame, ok := v.(*rawGrpcFrame); ok {
+		return frame.data, nil
+	}
+	return encoding.GetCodec(encodingproto.Name).Marshal(v)
+}
+
+func (grpcPortalCodec) Unmarshal(data []byte, v interface{}) error {
+	if frame, ok := v.(*rawGrpcFrame); ok {
+		frame.data = append([]byte{}, data...)
+		return nil
+	}
+	return encoding.GetCodec(encodingproto.Name).Unmarshal(data, v)
+}
+
+func (grpcPortalCodec) Name() string {
+	return encodingproto.Name
+}
+
+// newGrpcPortalServer creates a grpc server that relays every method with sendRelay, serviceFiles maps the
+// services to the proto files describing them so they can be listed and resolved by server reflection
+func newGrpcPortalServer(sendRelay func(ctx context.Context, method string, reqBody []byte) ([]byte, error), serviceFiles map[string]string) (*grpc.Server, *http.Server) {
+	relayHandler := func(srv interface{}, stream grpc.ServerStream) error {
+		method, ok := grpc.MethodFromServerStream(stream)
+		if !ok {
+			return status.Error(codes.Internal, "failed to read the method name")
+		}
+		request := &rawGrpcFrame{}
+		if err := stream.RecvMsg(request); err != nil {
+			return err
+		}
+		reply, err := sendRelay(stream.Context(), strings.TrimPrefix(method, "/"), request.data)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return err
+			}
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		return stream.SendMsg(&rawGrpcFrame{data: reply})
+	}
+
+	s := grpc.NewServer(grpc.ForceServerCodec(grpcPortalCodec{}), grpc.UnknownServiceHandler(relayHandler))
+	for service, file := range serviceFiles {
+		// services without methods, their calls fall through to the relay handler
+		s.RegisterService(&grpc.ServiceDesc{ServiceName: service, HandlerType: (*interface{})(nil), Metadata: file}, nil)
+	}
+	gogoreflection.Register(s)
+
+	wrappedServer := grpcweb.WrapServer(s)
+	gateway := newGrpcGateway(serviceFiles, sendRelay)
+	handler := func(resp http.ResponseWriter, req *http.Request) {
+		if gateway.HasRoutes() && !isGrpcRequest(req) && !wrappedServer.IsGrpcWebRequest(req) && !wrappedServer.IsAcceptableGrpcCorsRequest(req) {
+			gateway.ServeHTTP(resp, req)
+			return
+		}
+		// Set CORS headers
+		resp.Header().Set("Access-Control-Allow-Origin", "*")
+		resp.Header().Set("Access-Control-Allow-Headers", "Content-Type,x-grpc-web")
+
+		wrappedServer.ServeHTTP(resp, req)
+	}
+
+	httpServer := &http.Server{
+		Handler: h2c.NewHandler(http.HandlerFunc(handler), &http2.Server{}),
+	}
+	return s, httpServer
+}
+
+func isGrpcRequest(req *http.Request) bool {
+	return req.ProtoMajor == 2 && strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc")
+}
+
+// loadDescriptorSets registers the files of FileDescriptorSet files (protoc --descriptor_set_out --include_imports)
+// so reflection can resolve them, and returns their services
+func loadDescriptorSets(paths []string) (map[string]string, error) {
+	serviceFiles := map[string]string{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, utils.LavaFormatError("failed reading descriptor set", err, &map[string]string{"path": path})
+		}
+		descriptorSet := &dpb.FileDescriptorSet{}
+		err = proto.Unmarshal(data, descriptorSet)
+		if err != nil {
+			return nil, utils.LavaFormatError("failed parsing descriptor set", err, &map[string]string{"path": path})
+		}
+		for _, fd := range descriptorSet.File {
+			if err := registerFileDescriptor(fd); err != nil {
+				return nil, err
+			}
+			for _, service := range fd.Service {
+				serviceFiles[fullProtoName(fd.GetPackage(), service.GetName())] = fd.GetName()
+			}
+		}
+	}
+	return serviceFiles, nil
+}
+
+// registerFileDescriptor makes a proto file resolvable by the portal reflection and gateway, registered files are kept
+func registerFileDescriptor(fd *dpb.FileDescriptorProto) error {
+	if len(gogoproto.FileDescriptor(fd.GetName())) > 0 {
+		return nil
+	}
+	compressed, err := compressFileDescriptor(fd)
+	if err != nil {
+		return err
+	}
+	gogoproto.RegisterFile(fd.GetName(), compressed)
+	return nil
+}
+
+// findServiceFile looks for the proto file of a service in the descriptors linked into lavad.
+// gogo registered files can't be searched by service, so they are found through the request types of the
+// service methods, following the cosmos naming (Query<Method>Request, Msg<Method>)
+func findServiceFile(service string, methods []string) (string, bool) {
+	if descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service)); err == nil {
+		return descriptor.ParentFile().Path(), true
+	}
+	pos := strings.LastIndex(service, ".")
+	if pos < 0 {
+		return "", false
+	}
+	pkg, serviceName := service[:pos], service[pos+1:]
+	for _, method := range methods {
+		for _, typeName := range []string{serviceName + method + "Request", method + "Request", serviceName + method} {
+			fd, ok := fileDescriptorOfType(fullProtoName(pkg, typeName))
+			if !ok {
+				continue
+			}
+			for _, fdService := range fd.Service {
+				if fullProtoName(fd.GetPackage(), fdService.GetName()) == service {
+					return fd.GetName(), true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// the generated messages that can return their file descriptor
+type descriptorMessage interface {
+	Descriptor() ([]byte, []int)
+}
+
+func fileDescriptorOfType(typeName string) (*dpb.FileDescriptorProto, bool) {
+	typ := gogoproto.MessageType(typeName)
+	if typ == nil {
+		return nil, false
+	}
+	message, ok := reflect.Zero(typ).Interface().(descriptorMessage)
+	if !ok {
+		return nil, false
+	}
+	compressed, _ := message.Descriptor()
+	fd, err := decompressFileDescriptor(compressed)
+	if err != nil {
+		return nil, false
+	}
+	return fd, true
+}
+
+func compressFileDescriptor(fd *dpb.FileDescriptorProto) ([]byte, error) {
+	data, err := proto.Marshal(fd)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err = writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompressFileDescriptor(compressed []byte) (*dpb.FileDescriptorProto, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if _, err = buf.ReadFrom(reader); err != nil {
+		return nil, err
+	}
+	fd := &dpb.FileDescriptorProto{}
+	if err = proto.Unmarshal(buf.Bytes(), fd); err != nil {
+		return nil, fmt.Errorf("bad file descriptor: %w", err)
+	}
+	return fd, nil
+}
+
+func fullProtoName(pkg string, name string) string {
+	if pkg == "" {
+		return name
+	}
+	return pkg + "." + name
+}
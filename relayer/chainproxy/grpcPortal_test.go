@@ -0,0 +1,107 @@
+package chainproxy
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
+	"github.com/jhump/protoreflect/grpcreflect"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	reflectionpbo "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+func TestFindServiceFile(t *testing.T) {
+	file, ok := findServiceFile("lavanet.lava.pairing.Query", []string{"Params"})
+	require.True(t, ok)
+	require.Equal(t, "pairing/query.proto", file)
+
+	_, ok = findServiceFile("unknown.chain.Query", []string{"Params"})
+	require.False(t, ok)
+}
+
+func TestGrpcPortalRelaysAnyMethod(t *testing.T) {
+	relayedMethod := ""
+	sendRelay := func(ctx context.Context, method string, reqBody []byte) ([]byte, error) {
+		relayedMethod = method
+		reply := &pairingtypes.QueryParamsResponse{Params: pairingtypes.DefaultParams()}
+		return reply.Marshal()
+	}
+	s, httpServer := newGrpcPortalServer(sendRelay, map[string]string{"lavanet.lava.pairing.Query": "pairing/query.proto"})
+	defer s.Stop()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go httpServer.Serve(lis)
+	defer httpServer.Close()
+
+	ctx := context.Background()
+	conn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Nil(t, err)
+	defer conn.Close()
+
+	reply, err := pairingtypes.NewQueryClient(conn).Params(ctx, &pairingtypes.QueryParamsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, "lavanet.lava.pairing.Query/Params", relayedMethod)
+	require.Equal(t, pairingtypes.DefaultParams(), reply.Params)
+
+	// the registered service is listed and resolved by reflection
+	refClient := grpcreflect.NewClient(ctx, reflectionpbo.NewServerReflectionClient(conn))
+	defer refClient.Reset()
+	services, err := refClient.ListServices()
+	require.Nil(t, err)
+	require.Contains(t, services, "lavanet.lava.pairing.Query")
+	serviceDescriptor, err := refClient.ResolveService("lavanet.lava.pairing.Query")
+	require.Nil(t, err)
+	require.NotNil(t, serviceDescriptor.FindMethodByName("Params"))
+}
+
+type testQueryNode struct {
+	pairingtypes.UnimplementedQueryServer
+	chainID string
+}
+
+func (tn *testQueryNode) Providers(ctx context.Context, request *pairingtypes.QueryProvidersRequest) (*pairingtypes.QueryProvidersResponse, error) {
+	tn.chainID = request.ChainID
+	return &pairingtypes.QueryProvidersResponse{}, nil
+}
+
+func TestGrpcMessageEncoding(t *testing.T) {
+	node := &testQueryNode{}
+	server := grpc.NewServer()
+	pairingtypes.RegisterQueryServer(server, node)
+	gogoreflection.Register(server)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nodeUrl := lis.Addr().String()
+	cp := &GrpcChainProxy{conns: map[string]*GRPCConnector{nodeUrl: NewGRPCConnector(ctx, 1, 1, nodeUrl)}}
+	send := func(data []byte, metadata []pairingtypes.Metadata) {
+		nm := &GrpcMessage{cp: cp, serviceApi: &spectypes.ServiceApi{ComputeUnits: 10}, path: "lavanet.lava.pairing.Query/Providers", msg: data, encoding: grpcEncoding(metadata)}
+		_, nodeErr, err := nm.sendToNode(ctx, nodeUrl)
+		require.Nil(t, nodeErr)
+		require.Nil(t, err)
+	}
+
+	// the proto encoding of this request is also valid json, the encoding is taken from the relay metadata
+	request := &pairingtypes.QueryProvidersRequest{ChainID: strings.Repeat("a", 33) + `"`}
+	data, err := request.Marshal()
+	require.Nil(t, err)
+	require.True(t, json.Valid(data))
+	send(data, grpcProtoMetadata())
+	require.Equal(t, request.ChainID, node.chainID)
+
+	// relays without the encoding metadata are json
+	send([]byte(`{"chainID":"LAV1"}`), nil)
+	require.Equal(t, "LAV1", node.chainID)
+	require.Equal(t, grpcProtoMetadata(), requestMetadata(&spectypes.ServiceApi{}, grpcProtoMetadata()))
+}
@@ -0,0 +1,162 @@
+package chainproxy
+
+//
+// The proto descriptors of a chain are fetched from its providers: the portal relays server reflection requests
+// (grpc.reflection.v1alpha.ServerReflection) like any other relay, and the provider forwards them to its node.
+// the fetched files are registered for the portal reflection and gateway, and cached per chain.
+// reflection is served by every grpc node, so specs don't need to list it
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	reflectionpbo "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+const (
+	GrpcReflectionMethod       = "grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"
+	grpcReflectionComputeUnits = 10
+)
+
+// grpcRelayFunc relays a grpc request in its proto encoding and returns the proto encoded reply
+type grpcRelayFunc func(ctx context.Context, method string, reqBody []byte) ([]byte, error)
+
+// grpcReflectionServiceApi is the api of the reflection relays, on the portal and the provider
+func grpcReflectionServiceApi() spectypes.ServiceApi {
+	return spectypes.ServiceApi{
+		Name:         GrpcReflectionMethod,
+		Enabled:      true,
+		ComputeUnits: grpcReflectionComputeUnits,
+		ApiInterfaces: []spectypes.ApiInterface{{
+			Interface: spectypes.APIInterfaceGrpc,
+			// the descriptors of providers running different node versions can differ, they aren't cached or coalesced
+			Category: &spectypes.SpecCategory{},
+		}},
+	}
+}
+
+// grpcDescriptorCache keeps the proto files of the services fetched from the providers of every chain
+type grpcDescriptorCache struct {
+	lock         sync.Mutex
+	serviceFiles map[string]map[string]string // chainID -> service -> proto file
+}
+
+var fetchedGrpcDescriptors = &grpcDescriptorCache{serviceFiles: map[string]map[string]string{}}
+
+func (dc *grpcDescriptorCache) get(chainID string, service string) (string, bool) {
+	dc.lock.Lock()
+	defer dc.lock.Unlock()
+	file, ok := dc.serviceFiles[chainID][service]
+	return file, ok
+}
+
+func (dc *grpcDescriptorCache) set(chainID string, service string, file string) {
+	dc.lock.Lock()
+	defer dc.lock.Unlock()
+	if dc.serviceFiles[chainID] == nil {
+		dc.serviceFiles[chainID] = map[string]string{}
+	}
+	dc.serviceFiles[chainID][service] = file
+}
+
+// fetchGrpcServiceFile fetches the proto file of a service and the dependencies lavad doesn't know through the
+// providers reflection, registers them and returns the name of the service file
+func fetchGrpcServiceFile(ctx context.Context, relay grpcRelayFunc, service string) (string, error) {
+	files, err := fetchReflectionFiles(ctx, relay, &reflectionpbo.ServerReflectionRequest{
+		MessageRequest: &reflectionpbo.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: service},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("reflection returned no file for service %s", service)
+	}
+	// every relay is a new reflection stream so the dependencies are usually sent along, the missing ones are fetched by name
+	fetched := map[string]*dpb.FileDescriptorProto{}
+	for _, fd := range files {
+		fetched[fd.GetName()] = fd
+	}
+	pending := files
+	for len(pending) > 0 {
+		fd := pending[0]
+		pending = pending[1:]
+		for _, dependency := range fd.GetDependency() {
+			if _, ok := fetched[dependency]; ok || isKnownProtoFile(dependency) {
+				continue
+			}
+			dependencyFiles, err := fetchReflectionFiles(ctx, relay, &reflectionpbo.ServerReflectionRequest{
+				MessageRequest: &reflectionpbo.ServerReflectionRequest_FileByFilename{FileByFilename: dependency},
+			})
+			if err != nil {
+				return "", err
+			}
+			for _, dependencyFile := range dependencyFiles {
+				if _, ok := fetched[dependencyFile.GetName()]; !ok {
+					fetched[dependencyFile.GetName()] = dependencyFile
+					pending = append(pending, dependencyFile)
+				}
+			}
+			if _, ok := fetched[dependency]; !ok {
+				return "", fmt.Errorf("reflection didn't return the proto file %s", dependency)
+			}
+		}
+	}
+	serviceFile := ""
+	for _, fd := range fetched {
+		if err := registerFileDescriptor(fd); err != nil {
+			return "", err
+		}
+		for _, sd := range fd.GetService() {
+			if fullProtoName(fd.GetPackage(), sd.GetName()) == service {
+				serviceFile = fd.GetName()
+			}
+		}
+	}
+	if serviceFile == "" {
+		return "", fmt.Errorf("reflection returned no file declaring service %s", service)
+	}
+	return serviceFile, nil
+}
+
+// fetchReflectionFiles relays a reflection request and returns the file descriptors of its reply
+func fetchReflectionFiles(ctx context.Context, relay grpcRelayFunc, request *reflectionpbo.ServerReflectionRequest) ([]*dpb.FileDescriptorProto, error) {
+	data, err := proto.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	replyData, err := relay(ctx, GrpcReflectionMethod, data)
+	if err != nil {
+		return nil, err
+	}
+	reply := &reflectionpbo.ServerReflectionResponse{}
+	if err = proto.Unmarshal(replyData, reply); err != nil {
+		return nil, err
+	}
+	if errorResponse := reply.GetErrorResponse(); errorResponse != nil {
+		return nil, fmt.Errorf("reflection error %d: %s", errorResponse.GetErrorCode(), errorResponse.GetErrorMessage())
+	}
+	fileResponse := reply.GetFileDescriptorResponse()
+	if fileResponse == nil {
+		return nil, fmt.Errorf("reflection reply has no file descriptors")
+	}
+	files := make([]*dpb.FileDescriptorProto, 0, len(fileResponse.GetFileDescriptorProto()))
+	for _, encoded := range fileResponse.GetFileDescriptorProto() {
+		fd := &dpb.FileDescriptorProto{}
+		if err = proto.Unmarshal(encoded, fd); err != nil {
+			return nil, fmt.Errorf("bad file descriptor: %w", err)
+		}
+		files = append(files, fd)
+	}
+	return files, nil
+}
+
+// isKnownProtoFile is true for the proto files linked into lavad or already registered
+func isKnownProtoFile(fileName string) bool {
+	//nolint: staticcheck
+	return len(gogoproto.FileDescriptor(fileName)) > 0 || len(proto.FileDescriptor(fileName)) > 0
+}
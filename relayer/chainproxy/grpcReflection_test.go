@@ -0,0 +1,147 @@
+package chainproxy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/jhump/protoreflect/grpcreflect"
+	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	reflectionpbo "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// testChainFiles are the protos of a chain that isn't linked into lavad, its query file imports its params file
+func testChainFiles(t *testing.T) *protoregistry.Files {
+	paramsFile := &descriptorpb.FileDescriptorProto{
+		Name:    protov2.String("testchain/v1/params.proto"),
+		Package: protov2.String("testchain.v1"),
+		Syntax:  protov2.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  protov2.String("Params"),
+			Field: []*descriptorpb.FieldDescriptorProto{{Name: protov2.String("denom"), JsonName: protov2.String("denom"), Number: protov2.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()}},
+		}},
+	}
+	queryFile := &descriptorpb.FileDescriptorProto{
+		Name:       protov2.String("testchain/v1/query.proto"),
+		Package:    protov2.String("testchain.v1"),
+		Syntax:     protov2.String("proto3"),
+		Dependency: []string{"testchain/v1/params.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: protov2.String("QueryParamsRequest")},
+			{
+				Name:  protov2.String("QueryParamsResponse"),
+				Field: []*descriptorpb.FieldDescriptorProto{{Name: protov2.String("params"), JsonName: protov2.String("params"), Number: protov2.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(), TypeName: protov2.String(".testchain.v1.Params"), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()}},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name:   protov2.String("Query"),
+			Method: []*descriptorpb.MethodDescriptorProto{{Name: protov2.String("Params"), InputType: protov2.String(".testchain.v1.QueryParamsRequest"), OutputType: protov2.String(".testchain.v1.QueryParamsResponse")}},
+		}},
+	}
+	files := &protoregistry.Files{}
+	for _, fdProto := range []*descriptorpb.FileDescriptorProto{paramsFile, queryFile} {
+		fd, err := protodesc.NewFile(fdProto, files)
+		require.Nil(t, err)
+		require.Nil(t, files.RegisterFile(fd))
+	}
+	return files
+}
+
+// testNodeResolver describes the test chain protos and the ones linked into the node, like the reflection of a chain node
+type testNodeResolver struct {
+	files *protoregistry.Files
+}
+
+func (tr testNodeResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := tr.files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (tr testNodeResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if descriptor, err := tr.files.FindDescriptorByName(name); err == nil {
+		return descriptor, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+func TestFetchGrpcServiceFileFromProviders(t *testing.T) {
+	node := grpc.NewServer()
+	reflectionpbo.RegisterServerReflectionServer(node, reflection.NewServer(reflection.ServerOptions{Services: node, DescriptorResolver: testNodeResolver{files: testChainFiles(t)}}))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go node.Serve(lis)
+	defer node.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nodeUrl := lis.Addr().String()
+	// the provider relays the reflection requests to its node, without the spec listing the reflection api
+	provider := &GrpcChainProxy{nodes: NewNodeSet([]string{nodeUrl}, nil, 0), conns: map[string]*GRPCConnector{nodeUrl: NewGRPCConnector(ctx, 1, 1, nodeUrl)}, sentry: &sentry.Sentry{}}
+	relays := 0
+	relay := func(ctx context.Context, method string, reqBody []byte) ([]byte, error) {
+		relays++
+		nodeMsg, err := provider.ParseMsg(method, reqBody, "", grpcProtoMetadata())
+		if err != nil {
+			return nil, err
+		}
+		reply, _, _, err := nodeMsg.Send(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return reply.Data, nil
+	}
+
+	require.False(t, isKnownProtoFile("testchain/v1/query.proto"))
+	file, err := fetchGrpcServiceFile(ctx, relay, "testchain.v1.Query")
+	require.Nil(t, err)
+	require.Equal(t, "testchain/v1/query.proto", file)
+	require.Equal(t, 1, relays) // the dependencies came along with the service file
+	require.True(t, isKnownProtoFile("testchain/v1/query.proto"))
+	require.True(t, isKnownProtoFile("testchain/v1/params.proto"))
+
+	_, err = fetchGrpcServiceFile(ctx, relay, "unknown.v1.Query")
+	require.Error(t, err)
+
+	// the fetched service is listed and resolved by the portal reflection
+	s, httpServer := newGrpcPortalServer(relay, map[string]string{"testchain.v1.Query": file})
+	defer s.Stop()
+	portalLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go httpServer.Serve(portalLis)
+	defer httpServer.Close()
+	conn, err := grpc.DialContext(ctx, portalLis.Addr().String(), grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.Nil(t, err)
+	defer conn.Close()
+	refClient := grpcreflect.NewClient(ctx, reflectionpbo.NewServerReflectionClient(conn))
+	defer refClient.Reset()
+	services, err := refClient.ListServices()
+	require.Nil(t, err)
+	require.Contains(t, services, "testchain.v1.Query")
+	serviceDescriptor, err := refClient.ResolveService("testchain.v1.Query")
+	require.Nil(t, err)
+	method := serviceDescriptor.FindMethodByName("Params")
+	require.NotNil(t, method)
+	require.NotNil(t, method.GetOutputType().FindFieldByName("params").GetMessageType().FindFieldByName("denom"))
+}
+
+func TestGrpcDescriptorCache(t *testing.T) {
+	cache := &grpcDescriptorCache{serviceFiles: map[string]map[string]string{}}
+	cache.set("TST1", "testchain.v1.Query", "testchain/v1/query.proto")
+	file, ok := cache.get("TST1", "testchain.v1.Query")
+	require.True(t, ok)
+	require.Equal(t, "testchain/v1/query.proto", file)
+	// descriptors are kept per chain
+	_, ok = cache.get("TST2", "testchain.v1.Query")
+	require.False(t, ok)
+}
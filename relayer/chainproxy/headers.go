@@ -0,0 +1,85 @@
+package chainproxy
+
+//
+// Headers policy of the spec apis. headers an api doesn't list are stripped, user headers reach the nodes
+// and node reply headers reach the users only when the spec passes them
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+func getHeaderPolicy(serviceApi *spectypes.ServiceApi, name string) (spectypes.Header, bool) {
+	for _, header := range serviceApi.Headers {
+		if strings.EqualFold(header.Name, name) {
+			return header, true
+		}
+	}
+	return spectypes.Header{}, false
+}
+
+// requestMetadata returns the headers sent to the node for a request: the user headers the api passes
+// followed by the headers the api overwrites. applying it again on its result returns the same headers
+func requestMetadata(serviceApi *spectypes.ServiceApi, metadata []pairingtypes.Metadata) []pairingtypes.Metadata {
+	filtered := []pairingtypes.Metadata{}
+	for _, header := range metadata {
+		if header.Name == GrpcEncodingMetadataName {
+			// not a header, tells the provider how the request is encoded
+			filtered = append(filtered, header)
+			continue
+		}
+		policy, ok := getHeaderPolicy(serviceApi, header.Name)
+		if ok && (policy.Kind == spectypes.HEADER_TYPE_PASS_SEND || policy.Kind == spectypes.HEADER_TYPE_PASS_BOTH) {
+			filtered = append(filtered, header)
+		}
+	}
+	for _, policy := range serviceApi.Headers {
+		if policy.Kind == spectypes.HEADER_TYPE_OVERWRITE {
+			filtered = append(filtered, pairingtypes.Metadata{Name: policy.Name, Value: policy.Value})
+		}
+	}
+	return filtered
+}
+
+// replyMetadata returns the node reply headers the api passes back to the user, in the spec order
+func replyMetadata(serviceApi *spectypes.ServiceApi, headers http.Header) []pairingtypes.Metadata {
+	filtered := []pairingtypes.Metadata{}
+	for _, policy := range serviceApi.Headers {
+		if policy.Kind != spectypes.HEADER_TYPE_PASS_REPLY && policy.Kind != spectypes.HEADER_TYPE_PASS_BOTH {
+			continue
+		}
+		for _, value := range headers.Values(policy.Name) {
+			filtered = append(filtered, pairingtypes.Metadata{Name: policy.Name, Value: value})
+		}
+	}
+	return filtered
+}
+
+func metadataToHttpHeader(metadata []pairingtypes.Metadata) http.Header {
+	headers := http.Header{}
+	for _, header := range metadata {
+		headers.Add(header.Name, header.Value)
+	}
+	return headers
+}
+
+// metadataFromFiberRequest returns the headers of a portal request, the policy is applied when relaying it
+func metadataFromFiberRequest(c *fiber.Ctx) []pairingtypes.Metadata {
+	metadata := []pairingtypes.Metadata{}
+	c.Request().Header.VisitAll(func(key, value []byte) {
+		// fiber reuses its buffers, string() copies them
+		metadata = append(metadata, pairingtypes.Metadata{Name: string(key), Value: string(value)})
+	})
+	return metadata
+}
+
+// setFiberReplyHeaders sets the node reply headers passed by the provider on the portal response
+func setFiberReplyHeaders(c *fiber.Ctx, metadata []pairingtypes.Metadata) {
+	for _, header := range metadata {
+		c.Response().Header.Add(header.Name, header.Value)
+	}
+}
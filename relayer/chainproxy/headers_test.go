@@ -0,0 +1,123 @@
+package chainproxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func headersTestServiceApi() *spectypes.ServiceApi {
+	return &spectypes.ServiceApi{
+		Name:         "/blocks",
+		ComputeUnits: 10,
+		Headers: []spectypes.Header{
+			{Name: "Accept", Kind: spectypes.HEADER_TYPE_PASS_SEND},
+			{Name: "X-Cursor", Kind: spectypes.HEADER_TYPE_PASS_BOTH},
+			{Name: "Content-Type", Kind: spectypes.HEADER_TYPE_PASS_REPLY},
+			{Name: "Authorization", Kind: spectypes.HEADER_TYPE_STRIP},
+			{Name: "X-Api-Version", Kind: spectypes.HEADER_TYPE_OVERWRITE, Value: "2"},
+		},
+	}
+}
+
+func TestRequestMetadata(t *testing.T) {
+	serviceApi := headersTestServiceApi()
+	userHeaders := []pairingtypes.Metadata{
+		{Name: "accept", Value: "application/x-protobuf"},
+		{Name: "Authorization", Value: "secret"},
+		{Name: "X-Api-Version", Value: "1"},
+		{Name: "Content-Type", Value: "text/plain"},
+		{Name: "Cookie", Value: "unlisted"},
+		{Name: "X-Cursor", Value: "5"},
+	}
+	expected := []pairingtypes.Metadata{
+		{Name: "accept", Value: "application/x-protobuf"},
+		{Name: "X-Cursor", Value: "5"},
+		{Name: "X-Api-Version", Value: "2"},
+	}
+	filtered := requestMetadata(serviceApi, userHeaders)
+	require.Equal(t, expected, filtered)
+	// the provider applies the policy again on the headers the consumer signed
+	require.Equal(t, expected, requestMetadata(serviceApi, filtered))
+	require.Empty(t, requestMetadata(&spectypes.ServiceApi{}, userHeaders))
+}
+
+func TestReplyMetadata(t *testing.T) {
+	nodeHeaders := http.Header{}
+	nodeHeaders.Set("Content-Type", "application/json")
+	nodeHeaders.Add("X-Cursor", "6")
+	nodeHeaders.Add("X-Cursor", "7")
+	nodeHeaders.Set("Accept", "application/json")
+	nodeHeaders.Set("Server", "node")
+	require.Equal(t, []pairingtypes.Metadata{
+		{Name: "X-Cursor", Value: "6"},
+		{Name: "X-Cursor", Value: "7"},
+		{Name: "Content-Type", Value: "application/json"},
+	}, replyMetadata(headersTestServiceApi(), nodeHeaders))
+}
+
+func TestRestRelaysHeaders(t *testing.T) {
+	var nodeRequest *http.Request
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nodeRequest = r
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.Header().Set("Server", "node")
+		w.Write([]byte("reply"))
+	}))
+	defer node.Close()
+
+	cp := &RestChainProxy{nodes: NewNodeSet([]string{node.URL}, nil, 5), httpClient: newNodeHttpClient(1)}
+	nodeMsg := &RestMessage{
+		cp:           cp,
+		serviceApi:   headersTestServiceApi(),
+		apiInterface: &spectypes.ApiInterface{Type: http.MethodPost},
+		path:         "/blocks",
+		msg:          []byte("{}"),
+		metadata:     requestMetadata(headersTestServiceApi(), []pairingtypes.Metadata{{Name: "Accept", Value: "application/x-protobuf"}, {Name: "Authorization", Value: "secret"}}),
+	}
+	reply, _, _, err := nodeMsg.Send(context.Background(), nil)
+	require.Nil(t, err)
+	require.Equal(t, "application/x-protobuf", nodeRequest.Header.Get("Accept"))
+	require.Equal(t, "2", nodeRequest.Header.Get("X-Api-Version"))
+	require.Empty(t, nodeRequest.Header.Get("Authorization"))
+	// the node still gets json unless the spec passes the content type
+	require.Equal(t, "application/json", nodeRequest.Header.Get("Content-Type"))
+	require.Equal(t, []pairingtypes.Metadata{{Name: "Content-Type", Value: "application/x-protobuf"}}, reply.Metadata)
+}
+
+func TestJrpcRelaysHeaders(t *testing.T) {
+	var nodeRequest *http.Request
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nodeRequest = r
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Cursor", "8")
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer node.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cp := &JrpcChainProxy{
+		conns: map[string]*Connector{node.URL: NewConnector(ctx, 1, 1, node.URL)},
+		nodes: NewNodeSet([]string{node.URL}, nil, 5),
+	}
+	nodeMsg := &JrpcMessage{
+		cp:         cp,
+		serviceApi: headersTestServiceApi(),
+		msg:        &JsonrpcMessage{Version: "2.0", ID: []byte("1"), Method: "eth_blockNumber", Params: []interface{}{}},
+		metadata:   requestMetadata(headersTestServiceApi(), []pairingtypes.Metadata{{Name: "X-Cursor", Value: "7"}}),
+	}
+	reply, _, _, err := nodeMsg.Send(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, "7", nodeRequest.Header.Get("X-Cursor"))
+	require.Equal(t, "2", nodeRequest.Header.Get("X-Api-Version"))
+	require.Equal(t, []pairingtypes.Metadata{
+		{Name: "X-Cursor", Value: "8"},
+		{Name: "Content-Type", Value: "application/json"},
+	}, reply.Metadata)
+}
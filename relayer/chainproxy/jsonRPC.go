@@ -7,8 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/favicon"
 	"github.com/gofiber/websocket/v2"
@@ -17,6 +17,8 @@ import (
 	"github.com/lavanet/lava/relayer/parser"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -37,6 +39,8 @@ type JrpcMessage struct {
 	apiInterface   *spectypes.ApiInterface
 	msg            *JsonrpcMessage
 	requestedBlock int64
+	nodeUrl        string // when set the message is only sent to this node
+	metadata       []pairingtypes.Metadata
 }
 
 func (j *JrpcMessage) GetMsg() interface{} {
@@ -69,19 +73,24 @@ func convertMsg(rpcMsg *rpcclient.JsonrpcMessage) (*JsonrpcMessage, error) {
 }
 
 type JrpcChainProxy struct {
-	conn       *Connector
+	conns      map[string]*Connector // key: node url
+	connsLock  sync.RWMutex
 	nConns     uint
-	nodeUrl    string
+	maxConns   uint
+	nodes      *NodeSet
 	sentry     *sentry.Sentry
 	csm        *lavasession.ConsumerSessionManager
 	portalLogs *PortalLogs
 	cache      *performance.Cache
+	dapps      *DappRegistry
 }
 
-func NewJrpcChainProxy(nodeUrl string, nConns uint, sentry *sentry.Sentry, csm *lavasession.ConsumerSessionManager, pLogs *PortalLogs) ChainProxy {
+func NewJrpcChainProxy(nodes *NodeSet, nConns uint, maxConns uint, sentry *sentry.Sentry, csm *lavasession.ConsumerSessionManager, pLogs *PortalLogs) ChainProxy {
 	return &JrpcChainProxy{
-		nodeUrl:    nodeUrl,
+		conns:      map[string]*Connector{},
+		nodes:      nodes,
 		nConns:     nConns,
+		maxConns:   maxConns,
 		sentry:     sentry,
 		csm:        csm,
 		portalLogs: pLogs,
@@ -97,11 +106,28 @@ func (cp *JrpcChainProxy) GetCache() *performance.Cache {
 	return cp.cache
 }
 
+func (cp *JrpcChainProxy) SetDappRegistry(dapps *DappRegistry) {
+	cp.dapps = dapps
+}
+
+func (cp *JrpcChainProxy) GetDappRegistry() *DappRegistry {
+	return cp.dapps
+}
+
+func (cp *JrpcChainProxy) GetPortalLogs() *PortalLogs {
+	return cp.portalLogs
+}
+
 func (cp *JrpcChainProxy) GetConsumerSessionManager() *lavasession.ConsumerSessionManager {
 	return cp.csm
 }
 
 func (cp *JrpcChainProxy) FetchLatestBlockNum(ctx context.Context) (int64, error) {
+	return cp.fetchLatestBlockNumFromNode(ctx, "")
+}
+
+// fetches the latest block from a specific node, or from any node when nodeUrl is empty
+func (cp *JrpcChainProxy) fetchLatestBlockNumFromNode(ctx context.Context, nodeUrl string) (int64, error) {
 	serviceApi, ok := cp.GetSentry().GetSpecApiByTag(spectypes.GET_BLOCKNUM)
 	if !ok {
 		return spectypes.NOT_APPLICABLE, errors.New(spectypes.GET_BLOCKNUM + " tag function not found")
@@ -112,16 +138,17 @@ func (cp *JrpcChainProxy) FetchLatestBlockNum(ctx context.Context) (int64, error
 	if err != nil {
 		return spectypes.NOT_APPLICABLE, err
 	}
+	nodeMsg.nodeUrl = nodeUrl
 
 	_, _, _, err = nodeMsg.Send(ctx, nil)
 	if err != nil {
-		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Error On Send FetchLatestBlockNum", err, &map[string]string{"nodeUrl": cp.nodeUrl})
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Error On Send FetchLatestBlockNum", err, &map[string]string{"nodeUrl": nodeUrl})
 	}
 
 	blocknum, err := parser.ParseBlockFromReply(nodeMsg.msg, serviceApi.Parsing.ResultParsing)
 	if err != nil {
 		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Failed To Parse FetchLatestBlockNum", err, &map[string]string{
-			"nodeUrl":  cp.nodeUrl,
+			"nodeUrl":  nodeUrl,
 			"Method":   nodeMsg.msg.Method,
 			"Response": string(nodeMsg.msg.Result),
 		})
@@ -139,7 +166,7 @@ func (cp *JrpcChainProxy) FetchBlockHashByNum(ctx context.Context, blockNum int6
 	var nodeMsg NodeMessage
 	var err error
 	if serviceApi.GetParsing().FunctionTemplate != "" {
-		nodeMsg, err = cp.ParseMsg("", []byte(fmt.Sprintf(serviceApi.GetParsing().FunctionTemplate, blockNum)), http.MethodGet)
+		nodeMsg, err = cp.ParseMsg("", []byte(fmt.Sprintf(serviceApi.GetParsing().FunctionTemplate, blockNum)), http.MethodGet, nil)
 	} else {
 		params := make([]interface{}, 0)
 		params = append(params, blockNum)
@@ -152,7 +179,7 @@ func (cp *JrpcChainProxy) FetchBlockHashByNum(ctx context.Context, blockNum int6
 
 	_, _, _, err = nodeMsg.Send(ctx, nil)
 	if err != nil {
-		return "", utils.LavaFormatError("Error On Send FetchBlockHashByNum", err, &map[string]string{"nodeUrl": cp.nodeUrl})
+		return "", utils.LavaFormatError("Error On Send FetchBlockHashByNum", err, &map[string]string{"nodes": cp.nodes.String()})
 	}
 	// log.Println("%s", reply)
 	msgParsed, ok := nodeMsg.GetMsg().(*JsonrpcMessage)
@@ -190,12 +217,62 @@ func (cp *JrpcChainProxy) GetSentry() *sentry.Sentry {
 }
 
 func (cp *JrpcChainProxy) Start(ctx context.Context) error {
-	cp.conn = NewConnector(ctx, cp.nConns, cp.nodeUrl)
-	if cp.conn == nil {
+	err := cp.startConnectors(ctx)
+	if err != nil {
+		return err
+	}
+	go cp.nodes.HealthCheckLoop(ctx, getNodeHealthInterval(cp.sentry.GetAverageBlockTime()), cp.fetchLatestBlockNumFromNode)
+	return nil
+}
+
+// startConnectors connects to all the nodes in the background and returns once at least one of them is connected
+func (cp *JrpcChainProxy) startConnectors(ctx context.Context) error {
+	nodeUrls := cp.nodes.Urls()
+	if len(nodeUrls) == 0 {
+		return errors.New("no node urls were provided")
+	}
+	connected := make(chan struct{}, len(nodeUrls))
+	for _, nodeUrl := range nodeUrls {
+		go func(nodeUrl string) {
+			conn := NewConnector(ctx, cp.nConns, cp.maxConns, nodeUrl)
+			if conn == nil {
+				return
+			}
+			cp.connsLock.Lock()
+			cp.conns[nodeUrl] = conn
+			cp.connsLock.Unlock()
+			connected <- struct{}{}
+		}(nodeUrl)
+	}
+	select {
+	case <-connected:
+		return nil
+	case <-ctx.Done():
 		return errors.New("g_conn == nil")
 	}
+}
 
-	return nil
+func (cp *JrpcChainProxy) getConnector(nodeUrl string) (*Connector, error) {
+	cp.connsLock.RLock()
+	defer cp.connsLock.RUnlock()
+	conn, ok := cp.conns[nodeUrl]
+	if !ok {
+		return nil, fmt.Errorf("node %s is not connected", nodeUrl)
+	}
+	return conn, nil
+}
+
+// nodeUrlsForMessage returns the nodes to try for a message in order
+func (cp *JrpcChainProxy) nodeUrlsForMessage(nodeUrl string, requestedBlock int64) []string {
+	if nodeUrl != "" {
+		return []string{nodeUrl}
+	}
+	return cp.nodes.OrderedUrls(requestedBlock)
+}
+
+// a node that answered with an error is responsive, anything else means we should try another node
+func isJrpcNodeFailure(ctx context.Context, err error) bool {
+	return err != nil && !errors.Is(err, rpcclient.ErrNoResult) && ctx.Err() == nil
 }
 
 func (cp *JrpcChainProxy) getSupportedApi(name string) (*spectypes.ServiceApi, error) {
@@ -209,7 +286,7 @@ func (cp *JrpcChainProxy) getSupportedApi(name string) (*spectypes.ServiceApi, e
 	return nil, errors.New("JRPC api not supported")
 }
 
-func (cp *JrpcChainProxy) ParseMsg(path string, data []byte, connectionType string) (NodeMessage, error) {
+func (cp *JrpcChainProxy) ParseMsg(path string, data []byte, connectionType string, metadata []pairingtypes.Metadata) (NodeMessage, error) {
 	// connectionType is currently only used in rest API.
 	// Unmarshal request
 	var msg JsonrpcMessage
@@ -245,6 +322,7 @@ func (cp *JrpcChainProxy) ParseMsg(path string, data []byte, connectionType stri
 		apiInterface:   apiInterface,
 		msg:            &msg,
 		requestedBlock: requestedBlock,
+		metadata:       requestMetadata(serviceApi, metadata),
 	}
 	return nodeMsg, nil
 }
@@ -270,7 +348,7 @@ func (cp *JrpcChainProxy) NewMessage(serviceApi *spectypes.ServiceApi, requested
 	return nodeMsg, nil
 }
 
-func (cp *JrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.PrivateKey, listenAddr string) {
+func (cp *JrpcChainProxy) PortalStart(ctx context.Context, signer sigs.Signer, listenAddr string) {
 	//
 	// Setup HTTP Server
 	app := fiber.New(fiber.Config{})
@@ -304,36 +382,55 @@ func (cp *JrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel() // incase there's a problem make sure to cancel the connection
-			dappID := ExtractDappIDFromWebsocketConnection(c)
-			reply, replyServer, err := SendRelay(ctx, cp, privKey, "", string(msg), http.MethodGet, dappID)
+			dappID, err := authorizeDappRelay(cp, extractApiKeyFromWebsocketConnection(c), ExtractDappIDFromWebsocketConnection(c), "", string(msg), http.MethodGet)
+			if err != nil {
+				c.WriteMessage(mt, jsonRpcDappError(err))
+				continue
+			}
+			selection, envelope := extractProvenanceOptionsFromWebsocketConnection(c)
+			streamCtx, cancelStream := context.WithCancel(ctx) // a failed over subscription closes only its stream
+			relayCtx, span := StartPortalSpan(streamCtx, cp, "jsonRpc-WebSocket")
+			reply, replyServer, provenance, err := SendRelay(relayCtx, cp, signer, "", string(msg), http.MethodGet, dappID, c.RemoteAddr().String(), nil, selection)
+			tracing.RecordError(span, err)
+			span.End()
 			if err != nil {
+				cancelStream()
 				cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC)
 				continue
 			}
 
 			// If subscribe the first reply would contain the RPC ID that can be used for disconnect.
 			if replyServer != nil {
-				var reply pairingtypes.RelayReply
-				err = (*replyServer).RecvMsg(&reply) // this reply contains the RPC ID
+				// the subscription is resubscribed with another provider when its stream fails
+				subscription := newFailoverSubscription(ctx, cp, signer, string(msg), dappID, c.RemoteAddr().String(), selection, *replyServer, cancelStream, provenance)
+				reply, err := subscription.Recv() // this reply contains the RPC ID
 				if err != nil {
+					subscription.Close()
 					cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC)
 					continue
 				}
 
-				if err = c.WriteMessage(mt, reply.Data); err != nil {
+				if err = c.WriteMessage(mt, websocketReplyData(reply.Data, subscription.Provenance(), envelope)); err != nil {
+					subscription.Close()
 					cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC)
 					continue
 				}
 				cp.portalLogs.LogRequestAndResponse("jsonrpc ws msg", false, "ws", c.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, nil)
+				// the compute units the subscription accrues are charged on the dapp limits as well
+				dappCharge := newDappSubscriptionCharge(cp, extractApiKeyFromWebsocketConnection(c), string(msg), http.MethodGet)
 				for {
-					err = (*replyServer).RecvMsg(&reply)
+					reply, err = subscription.Recv()
 					if err != nil {
 						cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC)
 						break
 					}
+					if err = dappCharge.onMessage(); err != nil {
+						c.WriteMessage(mt, jsonRpcDappError(err))
+						break
+					}
 
 					// If portal cant write to the client
-					if err = c.WriteMessage(mt, reply.Data); err != nil {
+					if err = c.WriteMessage(mt, websocketReplyData(reply.Data, subscription.Provenance(), envelope)); err != nil {
 						cancel()
 						cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC)
 						// break
@@ -341,8 +438,11 @@ func (cp *JrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 
 					cp.portalLogs.LogRequestAndResponse("jsonrpc ws msg", false, "ws", c.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, nil)
 				}
+				subscription.Close()
+				dappCharge.charge() // the time since the last message
 			} else {
-				if err = c.WriteMessage(mt, reply.Data); err != nil {
+				cancelStream()
+				if err = c.WriteMessage(mt, websocketReplyData(reply.Data, provenance, envelope)); err != nil {
 					cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC)
 					continue
 				}
@@ -350,16 +450,23 @@ func (cp *JrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 			}
 		}
 	})
-	websocketCallbackWithDappID := ConstructFiberCallbackWithDappIDExtraction(webSocketCallback)
+	websocketCallbackWithDappID := ConstructFiberCallbackWithDappIDExtraction(webSocketCallback, cp.dapps)
 	app.Get("/ws/:dappId", websocketCallbackWithDappID)
 	app.Get("/:dappId/websocket", websocketCallbackWithDappID) // catching http://ip:port/1/websocket requests.
 
 	app.Post("/:dappId/*", func(c *fiber.Ctx) error {
 		cp.portalLogs.LogStartTransaction("jsonRpc-http post")
 		msgSeed := cp.portalLogs.GetMessageSeed()
+		ctx, span := StartPortalSpan(ctx, cp, "jsonRpc-http post")
+		defer span.End()
 		dappID := ExtractDappIDFromFiberContext(c)
 		utils.LavaFormatInfo("in <<<", &map[string]string{"seed": msgSeed, "msg": string(c.Body()), "dappID": dappID})
-		reply, _, err := SendRelay(ctx, cp, privKey, "", string(c.Body()), http.MethodGet, dappID)
+		dappID, err := authorizeDappRelay(cp, c.Params("dappId"), dappID, "", string(c.Body()), http.MethodGet)
+		if err != nil {
+			return sendJsonRpcDappError(c, err)
+		}
+		reply, _, provenance, err := SendRelay(ctx, cp, signer, "", string(c.Body()), http.MethodGet, dappID, c.IP(), metadataFromFiberRequest(c), providerSelectionFromFiberRequest(c, cp, c.Params("dappId")))
+		tracing.RecordError(span, err)
 		if err != nil {
 			errMasking := cp.portalLogs.GetUniqueGuidResponseForError(err, msgSeed)
 			cp.portalLogs.LogRequestAndResponse("jsonrpc http", true, "POST", c.Request().URI().String(), string(c.Body()), errMasking, msgSeed, err)
@@ -367,6 +474,8 @@ func (cp *JrpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 			return c.SendString(fmt.Sprintf(`{"error": {"code":-32000,"message":"%s"}}`, errMasking))
 		}
 		cp.portalLogs.LogRequestAndResponse("jsonrpc http", false, "POST", c.Request().URI().String(), string(c.Body()), string(reply.Data), msgSeed, nil)
+		setFiberReplyHeaders(c, reply.Metadata)
+		setFiberProvenanceHeaders(c, provenance)
 		return c.SendString(string(reply.Data))
 	})
 
@@ -390,23 +499,58 @@ func (nm *JrpcMessage) RequestedBlock() int64 {
 }
 
 func (nm *JrpcMessage) Send(ctx context.Context, ch chan interface{}) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	err = errors.New("no nodes to send the message to")
+	for _, nodeUrl := range nm.cp.nodeUrlsForMessage(nm.nodeUrl, nm.requestedBlock) {
+		var nodeErr error
+		relayReply, subscriptionID, relayReplyServer, nodeErr, err = nm.sendToNode(ctx, ch, nodeUrl)
+		nm.cp.nodes.OnNodeResult(nodeUrl, nodeErr)
+		if nodeErr == nil {
+			return relayReply, subscriptionID, relayReplyServer, err
+		}
+		if !canRetryOnAnotherNode(nm.apiInterface) {
+			break
+		}
+		utils.LavaFormatWarning("node failed to respond, trying the next node", nodeErr, &map[string]string{"nodeUrl": nodeUrl})
+	}
+	return relayReply, subscriptionID, relayReplyServer, err
+}
+
+func (nm *JrpcMessage) sendToNode(ctx context.Context, ch chan interface{}, nodeUrl string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, nodeErr error, err error) {
 	// Get node
-	rpc, err := nm.cp.conn.GetRpc(true)
+	conn, err := nm.cp.getConnector(nodeUrl)
 	if err != nil {
-		return nil, "", nil, err
+		return nil, "", nil, err, err
 	}
-	defer nm.cp.conn.ReturnRpc(rpc)
+	connectCtx, cancel := context.WithTimeout(ctx, getTimePerCu(nm.serviceApi.ComputeUnits))
+	defer cancel()
+	rpc, err := conn.GetRpc(connectCtx, true)
+	if err != nil {
+		if ctx.Err() == nil {
+			nodeErr = err
+		}
+		return nil, "", nil, nodeErr, err
+	}
+	defer func() {
+		if nodeErr != nil {
+			conn.DiscardRpc(rpc)
+			return
+		}
+		conn.ReturnRpc(rpc)
+	}()
 
 	// Call our node
 	var rpcMessage *rpcclient.JsonrpcMessage
 	var replyMessage *JsonrpcMessage
 	var sub *rpcclient.ClientSubscription
+	// headers only apply to http nodes
+	callCtx, replyHeaders := rpcclient.NewContextWithReplyHeaders(rpcclient.NewContextWithHeaders(connectCtx, metadataToHttpHeader(nm.metadata)))
 	if ch != nil {
 		sub, rpcMessage, err = rpc.Subscribe(context.Background(), nm.msg.ID, nm.msg.Method, ch, nm.msg.Params)
 	} else {
-		connectCtx, cancel := context.WithTimeout(ctx, getTimePerCu(nm.serviceApi.ComputeUnits))
-		defer cancel()
-		rpcMessage, err = rpc.CallContext(connectCtx, nm.msg.ID, nm.msg.Method, nm.msg.Params)
+		rpcMessage, err = rpc.CallContext(callCtx, nm.msg.ID, nm.msg.Method, nm.msg.Params)
+	}
+	if isJrpcNodeFailure(ctx, err) {
+		nodeErr = err
 	}
 
 	var replyMsg JsonrpcMessage
@@ -424,7 +568,7 @@ func (nm *JrpcMessage) Send(ctx context.Context, ch chan interface{}) (relayRepl
 	} else {
 		replyMessage, err = convertMsg(rpcMessage)
 		if err != nil {
-			return nil, "", nil, utils.LavaFormatError("jsonRPC error", err, nil)
+			return nil, "", nil, nodeErr, utils.LavaFormatError("jsonRPC error", err, nil)
 		}
 
 		nm.msg = replyMessage
@@ -434,22 +578,23 @@ func (nm *JrpcMessage) Send(ctx context.Context, ch chan interface{}) (relayRepl
 	data, err := json.Marshal(replyMsg)
 	if err != nil {
 		nm.msg.Result = []byte(fmt.Sprintf("%s", err))
-		return nil, "", nil, err
+		return nil, "", nil, nodeErr, err
 	}
 
 	reply := &pairingtypes.RelayReply{
-		Data: data,
+		Data:     data,
+		Metadata: replyMetadata(nm.serviceApi, replyHeaders),
 	}
 
 	if ch != nil {
 		subscriptionID, err = strconv.Unquote(string(replyMsg.Result))
 		if err != nil {
-			return nil, "", nil, utils.LavaFormatError("Subscription failed", err, nil)
+			return nil, "", nil, nodeErr, utils.LavaFormatError("Subscription failed", err, nil)
 		}
 	}
 	if replyMsg.Error != nil {
-		return reply, "", nil, utils.LavaFormatError(replyMsg.Error.Message, nil, nil)
+		return reply, "", nil, nodeErr, utils.LavaFormatError(replyMsg.Error.Message, nil, nil)
 	}
 
-	return reply, subscriptionID, sub, err
+	return reply, subscriptionID, sub, nodeErr, err
 }
@@ -0,0 +1,255 @@
+package chainproxy
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	MaxConsecutiveNodeFailures  = 3   // after this many failures in a row a node is skipped until it recovers
+	NodeErrorRateThreshold      = 0.5 // error rate in the current window that marks a node as unhealthy
+	MinRequestsForErrorRate     = 10  // don't judge the error rate on too few requests
+	DefaultArchiveBlockDistance = 128 // requests older than latest - distance prefer archive nodes
+	DefaultNodeHealthInterval   = 10 * time.Second
+)
+
+const (
+	NodeUrlsSeparator       = "," // separates multiple node urls given in a single argument
+	ArchiveNodeUrlsFlagName = "archive-node-urls"
+)
+
+type nodeHealth struct {
+	url                 string
+	archive             bool
+	latestBlock         int64
+	requests            uint64 // requests in the current health window
+	errors              uint64 // errors in the current health window
+	consecutiveFailures uint64
+	errorRateExceeded   bool // the error rate of the last evaluated window was over the threshold
+}
+
+func (nh *nodeHealth) errorRate() float64 {
+	if nh.requests < MinRequestsForErrorRate {
+		return 0
+	}
+	return float64(nh.errors) / float64(nh.requests)
+}
+
+// NodeSet keeps a list of upstream node urls of a single chain proxy and their health,
+// and decides the order in which nodes are tried for each request
+type NodeSet struct {
+	lock                 sync.RWMutex
+	nodes                []*nodeHealth
+	nextIndex            int // round robin between healthy nodes
+	allowedBlockLag      int64
+	archiveBlockDistance int64
+}
+
+func ParseNodeUrls(nodeUrls string) []string {
+	urls := []string{}
+	for _, url := range strings.Split(nodeUrls, NodeUrlsSeparator) {
+		url = strings.TrimSuffix(strings.TrimSpace(url), "/")
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+func NewNodeSet(nodeUrls []string, archiveNodeUrls []string, allowedBlockLag int64) *NodeSet {
+	nodeSet := &NodeSet{allowedBlockLag: allowedBlockLag, archiveBlockDistance: DefaultArchiveBlockDistance}
+	for _, url := range nodeUrls {
+		nodeSet.nodes = append(nodeSet.nodes, &nodeHealth{url: url})
+	}
+	for _, url := range archiveNodeUrls {
+		nodeSet.nodes = append(nodeSet.nodes, &nodeHealth{url: url, archive: true})
+	}
+	return nodeSet
+}
+
+func (ns *NodeSet) Urls() []string {
+	ns.lock.RLock()
+	defer ns.lock.RUnlock()
+	urls := make([]string, 0, len(ns.nodes))
+	for _, node := range ns.nodes {
+		urls = append(urls, node.url)
+	}
+	return urls
+}
+
+func (ns *NodeSet) getNode(url string) *nodeHealth {
+	for _, node := range ns.nodes {
+		if node.url == url {
+			return node
+		}
+	}
+	return nil
+}
+
+// must be called with the lock held
+func (ns *NodeSet) highestLatestBlock() int64 {
+	highest := int64(0)
+	for _, node := range ns.nodes {
+		if node.latestBlock > highest {
+			highest = node.latestBlock
+		}
+	}
+	return highest
+}
+
+// must be called with the lock held
+func (ns *NodeSet) isHealthy(node *nodeHealth, highestBlock int64) bool {
+	if node.consecutiveFailures >= MaxConsecutiveNodeFailures {
+		return false
+	}
+	if node.errorRateExceeded || node.errorRate() > NodeErrorRateThreshold {
+		return false
+	}
+	if node.latestBlock != 0 && highestBlock-node.latestBlock > ns.allowedBlockLag {
+		return false
+	}
+	return true
+}
+
+// OrderedUrls returns all the node urls in the order they should be tried for a request on requestedBlock.
+// healthy nodes come first, rotated so load is spread between them, and unhealthy nodes are kept as a last resort.
+// old blocks prefer archive nodes and everything else prefers the pruned nodes.
+func (ns *NodeSet) OrderedUrls(requestedBlock int64) []string {
+	ns.lock.Lock()
+	defer ns.lock.Unlock()
+	nodesCount := len(ns.nodes)
+	if nodesCount == 0 {
+		return nil
+	}
+	highestBlock := ns.highestLatestBlock()
+	preferArchive := requestedBlock >= 0 && highestBlock > 0 && highestBlock-requestedBlock > ns.archiveBlockDistance
+
+	preferred, others, unhealthy := []string{}, []string{}, []string{}
+	start := ns.nextIndex % nodesCount
+	ns.nextIndex++
+	for i := 0; i < nodesCount; i++ {
+		node := ns.nodes[(start+i)%nodesCount]
+		switch {
+		case !ns.isHealthy(node, highestBlock):
+			unhealthy = append(unhealthy, node.url)
+		case node.archive == preferArchive:
+			preferred = append(preferred, node.url)
+		default:
+			others = append(others, node.url)
+		}
+	}
+	return append(append(preferred, others...), unhealthy...)
+}
+
+// OnNodeResult updates the health of a node after a request to it. nodeErr should only be set on node failures
+// (connection problems, timeouts), not on errors returned by a responsive node
+func (ns *NodeSet) OnNodeResult(url string, nodeErr error) {
+	ns.lock.Lock()
+	defer ns.lock.Unlock()
+	node := ns.getNode(url)
+	if node == nil {
+		return
+	}
+	node.requests++
+	if nodeErr != nil {
+		node.errors++
+		node.consecutiveFailures++
+		if node.consecutiveFailures == MaxConsecutiveNodeFailures {
+			utils.LavaFormatWarning("node marked unhealthy, failing over to other nodes", nodeErr, &map[string]string{"nodeUrl": url})
+		}
+		return
+	}
+	node.consecutiveFailures = 0
+}
+
+// OnLatestBlock updates the latest block a node reported in a health check
+func (ns *NodeSet) OnLatestBlock(url string, latestBlock int64) {
+	ns.lock.Lock()
+	defer ns.lock.Unlock()
+	node := ns.getNode(url)
+	if node == nil {
+		return
+	}
+	node.requests++ // health checks keep filling the window of nodes that get no requests
+	node.latestBlock = latestBlock
+	node.consecutiveFailures = 0
+}
+
+// evaluateErrorWindow closes the error rate window of the nodes that got enough requests to judge it. the result holds
+// until their next window is evaluated, so nodes that recovered are used again
+func (ns *NodeSet) evaluateErrorWindow() {
+	ns.lock.Lock()
+	defer ns.lock.Unlock()
+	for _, node := range ns.nodes {
+		if node.requests < MinRequestsForErrorRate {
+			continue
+		}
+		node.errorRateExceeded = node.errorRate() > NodeErrorRateThreshold
+		if node.errorRateExceeded {
+			utils.LavaFormatWarning("node error rate over the threshold, failing over to other nodes", nil, &map[string]string{"nodeUrl": node.url, "errors": strconv.FormatUint(node.errors, 10), "requests": strconv.FormatUint(node.requests, 10)})
+		}
+		node.requests = 0
+		node.errors = 0
+	}
+}
+
+// HealthCheckLoop polls the latest block from every node until ctx is done.
+// fetchLatestBlock must query only the node it was given.
+func (ns *NodeSet) HealthCheckLoop(ctx context.Context, interval time.Duration, fetchLatestBlock func(ctx context.Context, nodeUrl string) (int64, error)) {
+	if len(ns.Urls()) <= 1 {
+		// nothing to fail over to
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, url := range ns.Urls() {
+				latestBlock, err := fetchLatestBlock(ctx, url)
+				if err != nil {
+					ns.OnNodeResult(url, err)
+					continue
+				}
+				ns.OnLatestBlock(url, latestBlock)
+			}
+			ns.evaluateErrorWindow()
+			utils.LavaFormatDebug("node health check", &map[string]string{"nodes": ns.String()})
+		}
+	}
+}
+
+func (ns *NodeSet) String() string {
+	ns.lock.RLock()
+	defer ns.lock.RUnlock()
+	highestBlock := ns.highestLatestBlock()
+	nodes := []string{}
+	for _, node := range ns.nodes {
+		nodes = append(nodes, node.url+"(latest:"+strconv.FormatInt(node.latestBlock, 10)+",healthy:"+strconv.FormatBool(ns.isHealthy(node, highestBlock))+")")
+	}
+	return strings.Join(nodes, " ")
+}
+
+// canRetryOnAnotherNode reports whether a message that failed on a node may be sent to the next one. state changing
+// calls (i.e transaction broadcasts) and subscriptions may have reached the failed node, so they aren't resent
+func canRetryOnAnotherNode(apiInterface *spectypes.ApiInterface) bool {
+	if apiInterface == nil || apiInterface.Category == nil {
+		return true
+	}
+	return apiInterface.Category.Stateful != spectypes.CONTEXT_STATE && !apiInterface.Category.Subscription
+}
+
+func getNodeHealthInterval(averageBlockTime int64) time.Duration {
+	if averageBlockTime <= 0 {
+		return DefaultNodeHealthInterval
+	}
+	return time.Duration(averageBlockTime) * time.Millisecond
+}
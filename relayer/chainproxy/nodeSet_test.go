@@ -0,0 +1,84 @@
+package chainproxy
+
+import (
+	"errors"
+	"testing"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNodeUrls(t *testing.T) {
+	require.Equal(t, []string{"http://a", "ws://b:26657"}, ParseNodeUrls(" http://a/, ws://b:26657,,"))
+	require.Empty(t, ParseNodeUrls(""))
+}
+
+func TestNodeSetFailover(t *testing.T) {
+	nodes := NewNodeSet([]string{"a", "b"}, nil, 5)
+	for i := 0; i < MaxConsecutiveNodeFailures; i++ {
+		nodes.OnNodeResult("a", errors.New("connection refused"))
+	}
+	// the unhealthy node is kept as a last resort
+	for i := 0; i < 4; i++ {
+		require.Equal(t, []string{"b", "a"}, nodes.OrderedUrls(-1))
+	}
+	// a successful health check brings it back
+	nodes.OnLatestBlock("a", 100)
+	require.ElementsMatch(t, []string{"a", "b"}, nodes.OrderedUrls(-1)[:2])
+}
+
+func TestNodeSetBlockLag(t *testing.T) {
+	nodes := NewNodeSet([]string{"a", "b"}, nil, 5)
+	nodes.OnLatestBlock("a", 100)
+	nodes.OnLatestBlock("b", 90)
+	require.Equal(t, []string{"a", "b"}, nodes.OrderedUrls(-1))
+	nodes.OnLatestBlock("b", 98)
+	require.ElementsMatch(t, []string{"a", "b"}, nodes.OrderedUrls(-1))
+}
+
+func TestNodeSetArchive(t *testing.T) {
+	nodes := NewNodeSet([]string{"pruned"}, []string{"archive"}, 5)
+	nodes.OnLatestBlock("pruned", 1000)
+	nodes.OnLatestBlock("archive", 1000)
+	for i := 0; i < 2; i++ {
+		require.Equal(t, []string{"archive", "pruned"}, nodes.OrderedUrls(1000-DefaultArchiveBlockDistance-1))
+		require.Equal(t, []string{"pruned", "archive"}, nodes.OrderedUrls(999))
+		require.Equal(t, []string{"pruned", "archive"}, nodes.OrderedUrls(-2)) // latest
+	}
+}
+
+func TestNodeSetErrorRate(t *testing.T) {
+	nodes := NewNodeSet([]string{"a", "b"}, nil, 5)
+	// errors spread between successes never mark the node as failing in a row, only its error rate does
+	for i := 0; i < MinRequestsForErrorRate; i++ {
+		var nodeErr error
+		if i%3 != 0 {
+			nodeErr = errors.New("timeout")
+		}
+		nodes.OnNodeResult("a", nodeErr)
+	}
+	nodes.evaluateErrorWindow()
+	for i := 0; i < 4; i++ {
+		require.Equal(t, []string{"b", "a"}, nodes.OrderedUrls(-1))
+	}
+	// the result holds until the next window has enough requests to be evaluated
+	nodes.OnLatestBlock("a", 100)
+	nodes.evaluateErrorWindow()
+	require.Equal(t, []string{"b", "a"}, nodes.OrderedUrls(-1))
+	for i := 0; i < MinRequestsForErrorRate; i++ {
+		nodes.OnLatestBlock("a", 100)
+	}
+	nodes.evaluateErrorWindow()
+	first := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		first[nodes.OrderedUrls(-1)[0]] = true
+	}
+	require.True(t, first["a"]) // back in the round robin
+}
+
+func TestCanRetryOnAnotherNode(t *testing.T) {
+	require.True(t, canRetryOnAnotherNode(nil))
+	require.True(t, canRetryOnAnotherNode(&spectypes.ApiInterface{Category: &spectypes.SpecCategory{Deterministic: true}}))
+	require.False(t, canRetryOnAnotherNode(&spectypes.ApiInterface{Category: &spectypes.SpecCategory{Stateful: spectypes.CONTEXT_STATE}}))
+	require.False(t, canRetryOnAnotherNode(&spectypes.ApiInterface{Category: &spectypes.SpecCategory{Subscription: true}}))
+}
@@ -0,0 +1,396 @@
+package chainproxy
+
+//
+// Portal log sinks: every relay of the portal is logged with the same fields to the configured sinks. successful
+// relays can be sampled, failed ones are always logged. request params are only logged when enabled, after redacting
+// addresses, keys and the configured patterns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/relayer/otlp"
+	"github.com/lavanet/lava/utils"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+const (
+	PortalLogsConfigFlagName = "portal-logs-config"
+
+	PortalLogSinkStdout   = "stdout"
+	PortalLogSinkFile     = "file"
+	PortalLogSinkOtlp     = "otlp"
+	PortalLogSinkNewRelic = "newrelic"
+
+	CacheStatusHit       = "hit"
+	CacheStatusMiss      = "miss"
+	CacheStatusCoalesced = "coalesced" // the reply of an identical relay sent at the same time was shared
+
+	defaultLogFileMaxSizeMB  = 100
+	defaultLogFileMaxBackups = 5
+	redactedValue            = "[REDACTED]"
+	newRelicRelayEventType   = "LavaPortalRelay"
+)
+
+// params matching these are always redacted: hex addresses, signed transactions, bech32 addresses and 32 byte hex values
+// of key fields. other 32 byte hex values are hashes of blocks and transactions and are kept
+var defaultRedactRules = []redactRule{
+	{pattern: regexp.MustCompile(`0x[0-9a-fA-F]{40}\b`), replacement: redactedValue},
+	{pattern: regexp.MustCompile(`0x[0-9a-fA-F]{65,}`), replacement: redactedValue},
+	{pattern: regexp.MustCompile(`\b[a-z][a-z@]{0,82}1[02-9ac-hj-np-z]{38,}\b`), replacement: redactedValue},
+	{pattern: regexp.MustCompile(`(?i)("[a-z_]*(?:key|secret|seed)"\s*:\s*)"(?:0x)?[0-9a-f]{64}"`), replacement: `${1}"` + redactedValue + `"`},
+}
+
+type redactRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+type PortalLogSinkConfig struct {
+	Type       string            `json:"type"`        // stdout, file, otlp or newrelic
+	Path       string            `json:"path"`        // file: the log file, rotated to path.1 ... path.max_backups
+	MaxSizeMB  uint64            `json:"max_size_mb"` // file: size rotating the file, defaults to 100
+	MaxBackups int               `json:"max_backups"` // file: rotated files kept, defaults to 5
+	Endpoint   string            `json:"endpoint"`    // otlp: http endpoint of the collector, i.e http://localhost:4318
+	Headers    map[string]string `json:"headers"`     // otlp: headers of the export requests, i.e authorization
+	AppName    string            `json:"app_name"`    // newrelic: defaults to the NEW_RELIC_APP_NAME environment variable
+	LicenseKey string            `json:"license_key"` // newrelic: defaults to the NEW_RELIC_LICENSE_KEY environment variable
+}
+
+type PortalLogsConfig struct {
+	Sinks      []PortalLogSinkConfig `json:"sinks"`
+	SampleRate *float64              `json:"sample_rate"` // fraction of the successful relays logged, defaults to all of them
+	LogParams  bool                  `json:"log_params"`  // logs the request params, redacted
+	Redact     []string              `json:"redact"`      // regexps redacted from the logged params, on top of addresses and keys
+}
+
+// RelayLog is the record logged for every portal relay
+type RelayLog struct {
+	Time        time.Time `json:"time"`
+	DappID      string    `json:"dapp_id"`
+	ChainID     string    `json:"chain_id"`
+	Method      string    `json:"method"`
+	Provider    string    `json:"provider"`
+	LatencyMs   int64     `json:"latency_ms"`
+	CU          uint64    `json:"cu"`
+	CacheStatus string    `json:"cache_status"`
+	ErrorCode   string    `json:"error_code"` // empty when the relay succeeded
+	Params      string    `json:"params,omitempty"`
+}
+
+// PortalLogSink receives the relay logs of the portal, implementations must be safe for concurrent use
+type PortalLogSink interface {
+	LogRelay(relayLog *RelayLog)
+	Close() error
+}
+
+// relayErrorCode is the code of a relay error, its codespace and code for registered errors
+func relayErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	if codespace == sdkerrors.UndefinedCodespace {
+		return "internal"
+	}
+	return codespace + ":" + strconv.FormatUint(uint64(code), 10)
+}
+
+type paramsRedactor struct {
+	rules []redactRule
+}
+
+func newParamsRedactor(patterns []string) (*paramsRedactor, error) {
+	rules := append([]redactRule{}, defaultRedactRules...)
+	for _, pattern := range patterns {
+		rule, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redact rule %s: %w", pattern, err)
+		}
+		rules = append(rules, redactRule{pattern: rule, replacement: redactedValue})
+	}
+	return &paramsRedactor{rules: rules}, nil
+}
+
+func (pr *paramsRedactor) redact(params string) string {
+	for _, rule := range pr.rules {
+		params = rule.pattern.ReplaceAllString(params, rule.replacement)
+	}
+	return params
+}
+
+func newPortalLogSink(config PortalLogSinkConfig, newRelicApplication *newrelic.Application) (PortalLogSink, error) {
+	switch config.Type {
+	case PortalLogSinkStdout:
+		return &jsonLogSink{out: os.Stdout}, nil
+	case PortalLogSinkFile:
+		return newFileLogSink(config.Path, config.MaxSizeMB, config.MaxBackups)
+	case PortalLogSinkOtlp:
+		return newOtlpLogSink(config.Endpoint, config.Headers)
+	case PortalLogSinkNewRelic:
+		if newRelicApplication == nil {
+			appName, licenseKey := config.AppName, config.LicenseKey
+			if appName == "" {
+				appName = os.Getenv("NEW_RELIC_APP_NAME")
+			}
+			if licenseKey == "" {
+				licenseKey = os.Getenv("NEW_RELIC_LICENSE_KEY")
+			}
+			var err error
+			newRelicApplication, err = newrelic.NewApplication(newrelic.ConfigAppName(appName), newrelic.ConfigLicense(licenseKey), newrelic.ConfigFromEnvironment())
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &newRelicLogSink{application: newRelicApplication}, nil
+	default:
+		return nil, fmt.Errorf("unknown portal log sink type %s", config.Type)
+	}
+}
+
+// jsonLogSink writes a json line per relay
+type jsonLogSink struct {
+	lock sync.Mutex
+	out  io.Writer
+}
+
+func (js *jsonLogSink) LogRelay(relayLog *RelayLog) {
+	line, err := json.Marshal(relayLog)
+	if err != nil {
+		return
+	}
+	js.lock.Lock()
+	defer js.lock.Unlock()
+	js.out.Write(append(line, '\n'))
+}
+
+func (js *jsonLogSink) Close() error {
+	return nil
+}
+
+// debugLogSink writes the relays to the relayer log at debug level, the portal logs without a config
+type debugLogSink struct{}
+
+func (ds debugLogSink) LogRelay(relayLog *RelayLog) {
+	attributes := map[string]string{
+		"dapp_id":      relayLog.DappID,
+		"chain_id":     relayLog.ChainID,
+		"method":       relayLog.Method,
+		"provider":     relayLog.Provider,
+		"latency_ms":   strconv.FormatInt(relayLog.LatencyMs, 10),
+		"cu":           strconv.FormatUint(relayLog.CU, 10),
+		"cache_status": relayLog.CacheStatus,
+		"error_code":   relayLog.ErrorCode,
+	}
+	if relayLog.Params != "" {
+		attributes["params"] = relayLog.Params
+	}
+	utils.LavaFormatDebug("portal relay", &attributes)
+}
+
+func (ds debugLogSink) Close() error {
+	return nil
+}
+
+// fileLogSink writes json lines to a file, rotated when it reaches its max size
+type fileLogSink struct {
+	lock       sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func newFileLogSink(path string, maxSizeMB uint64, maxBackups int) (*fileLogSink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("file portal log sink requires a path")
+	}
+	if maxSizeMB == 0 {
+		maxSizeMB = defaultLogFileMaxSizeMB
+	}
+	if maxBackups <= 0 {
+		maxBackups = defaultLogFileMaxBackups
+	}
+	fs := &fileLogSink{path: path, maxSize: int64(maxSizeMB) * 1024 * 1024, maxBackups: maxBackups}
+	return fs, fs.open()
+}
+
+func (fs *fileLogSink) open() error {
+	file, err := os.OpenFile(fs.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	fs.file, fs.size = file, info.Size()
+	return nil
+}
+
+// rotate moves path to path.1, path.1 to path.2 and so on, the oldest backup is dropped
+func (fs *fileLogSink) rotate() error {
+	fs.file.Close()
+	fs.file = nil
+	for backup := fs.maxBackups - 1; backup >= 1; backup-- {
+		os.Rename(fs.path+"."+strconv.Itoa(backup), fs.path+"."+strconv.Itoa(backup+1))
+	}
+	if err := os.Rename(fs.path, fs.path+".1"); err != nil {
+		// keep appending to the current file
+		if openErr := fs.open(); openErr != nil {
+			return openErr
+		}
+		return err
+	}
+	return fs.open()
+}
+
+func (fs *fileLogSink) LogRelay(relayLog *RelayLog) {
+	line, err := json.Marshal(relayLog)
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	if fs.file == nil {
+		return
+	}
+	if fs.size > 0 && fs.size+int64(len(line)) > fs.maxSize {
+		if err := fs.rotate(); err != nil {
+			utils.LavaFormatError("failed rotating the portal log file", err, &map[string]string{"path": fs.path})
+			if fs.file == nil {
+				return
+			}
+		}
+	}
+	written, _ := fs.file.Write(line)
+	fs.size += int64(written)
+}
+
+func (fs *fileLogSink) Close() error {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	if fs.file == nil {
+		return nil
+	}
+	err := fs.file.Close()
+	fs.file = nil
+	return err
+}
+
+// otlpLogSink exports the relays as log records to an otlp collector over http, in batches
+type otlpLogSink struct {
+	exporter *otlp.Exporter
+}
+
+func newOtlpLogSink(endpoint string, headers map[string]string) (*otlpLogSink, error) {
+	exporter, err := otlp.NewExporter(endpoint, otlp.LogsPath, headers, encodeOtlpLogs)
+	if err != nil {
+		return nil, fmt.Errorf("otlp portal log sink: %w", err)
+	}
+	return &otlpLogSink{exporter: exporter}, nil
+}
+
+func (ol *otlpLogSink) LogRelay(relayLog *RelayLog) {
+	// a relay dropped by a slow collector isn't held for it
+	ol.exporter.Export(relayLog)
+}
+
+func (ol *otlpLogSink) Close() error {
+	return ol.exporter.Shutdown()
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string                 `json:"timeUnixNano"`
+	SeverityNumber int                    `json:"severityNumber"`
+	SeverityText   string                 `json:"severityText"`
+	Body           map[string]interface{} `json:"body"`
+	Attributes     []otlp.KeyValue        `json:"attributes"`
+}
+
+func encodeOtlpLogs(batch []interface{}) (interface{}, error) {
+	records := make([]otlpLogRecord, 0, len(batch))
+	for _, item := range batch {
+		relayLog := item.(*RelayLog)
+		severityNumber, severityText := 9, "INFO" // otlp severity numbers
+		if relayLog.ErrorCode != "" {
+			severityNumber, severityText = 17, "ERROR"
+		}
+		attributes := []otlp.KeyValue{
+			otlp.Attribute("dapp_id", relayLog.DappID),
+			otlp.Attribute("chain_id", relayLog.ChainID),
+			otlp.Attribute("method", relayLog.Method),
+			otlp.Attribute("provider", relayLog.Provider),
+			otlp.Attribute("latency_ms", relayLog.LatencyMs),
+			otlp.Attribute("cu", relayLog.CU),
+			otlp.Attribute("cache_status", relayLog.CacheStatus),
+			otlp.Attribute("error_code", relayLog.ErrorCode),
+		}
+		if relayLog.Params != "" {
+			attributes = append(attributes, otlp.Attribute("params", relayLog.Params))
+		}
+		records = append(records, otlpLogRecord{
+			TimeUnixNano:   strconv.FormatInt(relayLog.Time.UnixNano(), 10),
+			SeverityNumber: severityNumber,
+			SeverityText:   severityText,
+			Body:           otlp.Value("relay"),
+			Attributes:     attributes,
+		})
+	}
+	return map[string]interface{}{
+		"resourceLogs": []interface{}{map[string]interface{}{
+			"resource": otlp.Resource("lava-portal"),
+			"scopeLogs": []interface{}{map[string]interface{}{
+				"scope":      map[string]string{"name": "lava.portal"},
+				"logRecords": records,
+			}},
+		}},
+	}, nil
+}
+
+// newRelicLogSink records a custom event per relay
+type newRelicLogSink struct {
+	application *newrelic.Application
+}
+
+func (ns *newRelicLogSink) LogRelay(relayLog *RelayLog) {
+	ns.application.RecordCustomEvent(newRelicRelayEventType, newRelicRelayAttributes(relayLog))
+}
+
+// newRelicRelayAttributes are the event attributes of a relay, named like the RelayLog json fields of the other sinks
+func newRelicRelayAttributes(relayLog *RelayLog) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"dapp_id":      relayLog.DappID,
+		"chain_id":     relayLog.ChainID,
+		"method":       relayLog.Method,
+		"provider":     relayLog.Provider,
+		"latency_ms":   relayLog.LatencyMs,
+		"cu":           relayLog.CU,
+		"cache_status": relayLog.CacheStatus,
+		"error_code":   relayLog.ErrorCode,
+	}
+	if relayLog.Params != "" {
+		attributes["params"] = relayLog.Params
+	}
+	return attributes
+}
+
+func (ns *newRelicLogSink) Close() error {
+	return nil
+}
+
+// sampled decides if a successful relay is logged
+func sampled(sampleRate float64) bool {
+	return sampleRate >= 1 || rand.Float64() < sampleRate
+}
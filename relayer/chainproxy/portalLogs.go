@@ -1,15 +1,18 @@
 package chainproxy
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/gofiber/websocket/v2"
 	"github.com/joho/godotenv"
 	"github.com/lavanet/lava/relayer/parser"
 	"github.com/lavanet/lava/utils"
+	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
 
@@ -19,28 +22,134 @@ const webSocketCloseMessage = "websocket: close 1005 (no status)"
 
 type PortalLogs struct {
 	newRelicApplication *newrelic.Application
+	sinks               []PortalLogSink
+	sampleRate          float64
+	redactor            *paramsRedactor // nil when the request params aren't logged
 }
 
+// NewPortalLogs logs the relays at debug level, and to new relic when its environment variables are set
 func NewPortalLogs() (*PortalLogs, error) {
+	pl := &PortalLogs{sampleRate: 1, sinks: []PortalLogSink{debugLogSink{}}}
 	err := godotenv.Load()
 	if err != nil {
 		utils.LavaFormatInfo("New relic missing environment file", nil)
 
-		return &PortalLogs{}, nil
+		return pl, nil
 	}
 
 	NEW_RELIC_APP_NAME := os.Getenv("NEW_RELIC_APP_NAME")
 	NEW_RELIC_LICENSE_KEY := os.Getenv("NEW_RELIC_LICENSE_KEY")
 	if NEW_RELIC_APP_NAME == "" || NEW_RELIC_LICENSE_KEY == "" {
 		utils.LavaFormatInfo("New relic missing environment variables", nil)
-		return &PortalLogs{}, nil
+		return pl, nil
 	}
 	newRelicApplication, err := newrelic.NewApplication(
 		newrelic.ConfigAppName(NEW_RELIC_APP_NAME),
 		newrelic.ConfigLicense(NEW_RELIC_LICENSE_KEY),
 		newrelic.ConfigFromEnvironment(),
 	)
-	return &PortalLogs{newRelicApplication}, err
+	if err != nil {
+		return pl, err
+	}
+	pl.newRelicApplication = newRelicApplication
+	pl.sinks = append(pl.sinks, &newRelicLogSink{application: newRelicApplication})
+	return pl, nil
+}
+
+// NewPortalLogsFromConfig creates the portal logs writing the relays to the sinks of the config
+func NewPortalLogsFromConfig(config PortalLogsConfig) (*PortalLogs, error) {
+	pl := &PortalLogs{sampleRate: 1}
+	if config.SampleRate != nil {
+		if *config.SampleRate < 0 || *config.SampleRate > 1 {
+			return nil, fmt.Errorf("invalid portal logs sample rate %v, must be between 0 and 1", *config.SampleRate)
+		}
+		pl.sampleRate = *config.SampleRate
+	}
+	if config.LogParams {
+		redactor, err := newParamsRedactor(config.Redact)
+		if err != nil {
+			return nil, err
+		}
+		pl.redactor = redactor
+	}
+	for _, sinkConfig := range config.Sinks {
+		sink, err := newPortalLogSink(sinkConfig, pl.newRelicApplication)
+		if err != nil {
+			pl.Close()
+			return nil, err
+		}
+		if newRelicSink, ok := sink.(*newRelicLogSink); ok {
+			// transactions are started on the same application
+			pl.newRelicApplication = newRelicSink.application
+		}
+		pl.sinks = append(pl.sinks, sink)
+	}
+	return pl, nil
+}
+
+// LoadPortalLogs reads the portal logs config from a json file in the PortalLogsConfig format,
+// without a config the relays are logged as NewPortalLogs does
+func LoadPortalLogs(path string) (*PortalLogs, error) {
+	if path == "" {
+		return NewPortalLogs()
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := PortalLogsConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid portal logs config %s: %w", path, err)
+	}
+	return NewPortalLogsFromConfig(config)
+}
+
+// LogRelay writes a relay to the sinks. successful relays are sampled
+func (cp *PortalLogs) LogRelay(chainID string, dappID string, serviceApi *spectypes.ServiceApi, params string, provenance *RelayProvenance, latency time.Duration, relayErr error) {
+	if cp == nil || len(cp.sinks) == 0 {
+		return
+	}
+	if relayErr == nil && !sampled(cp.sampleRate) {
+		return
+	}
+	relayLog := &RelayLog{
+		Time:        time.Now(),
+		DappID:      dappID,
+		ChainID:     chainID,
+		LatencyMs:   latency.Milliseconds(),
+		CacheStatus: CacheStatusMiss,
+		ErrorCode:   relayErrorCode(relayErr),
+	}
+	if serviceApi != nil {
+		relayLog.Method = serviceApi.Name
+		relayLog.CU = serviceApi.ComputeUnits
+	}
+	if provenance != nil {
+		relayLog.Provider = provenance.ProviderAddress
+		switch {
+		case provenance.CacheHit:
+			relayLog.CacheStatus = CacheStatusHit
+		case provenance.Coalesced:
+			relayLog.CacheStatus = CacheStatusCoalesced
+		}
+	}
+	if cp.redactor != nil {
+		relayLog.Params = cp.redactor.redact(params)
+	}
+	for _, sink := range cp.sinks {
+		sink.LogRelay(relayLog)
+	}
+}
+
+// Close flushes and closes the sinks
+func (cp *PortalLogs) Close() error {
+	var closeErr error
+	for _, sink := range cp.sinks {
+		if err := sink.Close(); err != nil {
+			closeErr = err
+		}
+	}
+	return closeErr
 }
 
 func (cp *PortalLogs) GetMessageSeed() string {
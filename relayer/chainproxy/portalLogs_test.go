@@ -0,0 +1,176 @@
+package chainproxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type testLogSink struct {
+	lock sync.Mutex
+	logs []*RelayLog
+}
+
+func (ts *testLogSink) LogRelay(relayLog *RelayLog) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+	ts.logs = append(ts.logs, relayLog)
+}
+
+func (ts *testLogSink) Close() error {
+	return nil
+}
+
+func TestPortalLogsRelayFields(t *testing.T) {
+	out := &bytes.Buffer{}
+	pl := &PortalLogs{sampleRate: 1, sinks: []PortalLogSink{&jsonLogSink{out: out}}}
+	serviceApi := &spectypes.ServiceApi{Name: "eth_blockNumber", ComputeUnits: 10}
+	pl.LogRelay("ETH1", "explorer", serviceApi, `{"method":"eth_blockNumber"}`, &RelayProvenance{ProviderAddress: "lava@provider", CacheHit: true}, 25*time.Millisecond, nil)
+	pl.LogRelay("ETH1", "explorer", serviceApi, "", &RelayProvenance{Coalesced: true}, time.Millisecond, nil)
+	pl.LogRelay("ETH1", "explorer", serviceApi, "", nil, time.Millisecond, ErrDappUnauthorized)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	relayLog := RelayLog{}
+	require.Nil(t, json.Unmarshal([]byte(lines[0]), &relayLog))
+	require.Equal(t, "explorer", relayLog.DappID)
+	require.Equal(t, "ETH1", relayLog.ChainID)
+	require.Equal(t, "eth_blockNumber", relayLog.Method)
+	require.Equal(t, "lava@provider", relayLog.Provider)
+	require.Equal(t, int64(25), relayLog.LatencyMs)
+	require.Equal(t, uint64(10), relayLog.CU)
+	require.Equal(t, CacheStatusHit, relayLog.CacheStatus)
+	require.Empty(t, relayLog.ErrorCode)
+	require.Empty(t, relayLog.Params) // params aren't logged by default
+
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), &relayLog))
+	require.Equal(t, CacheStatusCoalesced, relayLog.CacheStatus)
+	require.Nil(t, json.Unmarshal([]byte(lines[2]), &relayLog))
+	require.Equal(t, CacheStatusMiss, relayLog.CacheStatus)
+	require.Equal(t, "Dapp error:1100", relayLog.ErrorCode)
+
+	// without a config the relays are still logged
+	defaultLogs, err := NewPortalLogs()
+	require.Nil(t, err)
+	require.NotEmpty(t, defaultLogs.sinks)
+	defaultLogs.LogRelay("ETH1", "explorer", serviceApi, "", nil, time.Millisecond, nil)
+
+	// portal logs without sinks, or without portal logs at all, don't log
+	var noLogs *PortalLogs
+	noLogs.LogRelay("ETH1", "explorer", serviceApi, "", nil, 0, nil)
+}
+
+func TestPortalLogsSamplingAndRedaction(t *testing.T) {
+	sampleRate := 0.0
+	pl, err := NewPortalLogsFromConfig(PortalLogsConfig{SampleRate: &sampleRate, LogParams: true, Redact: []string{`"secret":"[^"]*"`}})
+	require.Nil(t, err)
+	sink := &testLogSink{}
+	pl.sinks = []PortalLogSink{sink}
+
+	params := `{"params":["0x5aeda56215b167893e80b4fe645ba6d5bab767de","latest"],"secret":"hunter2"}`
+	pl.LogRelay("ETH1", "explorer", nil, params, nil, 0, nil)
+	require.Empty(t, sink.logs) // successful relays are all sampled out
+	pl.LogRelay("ETH1", "explorer", nil, params, nil, 0, ErrDappUnauthorized)
+	require.Len(t, sink.logs, 1) // failed relays are always logged
+	require.Equal(t, `{"params":["[REDACTED]","latest"],[REDACTED]}`, sink.logs[0].Params)
+
+	redactor, err := newParamsRedactor(nil)
+	require.Nil(t, err)
+	require.Equal(t, `["[REDACTED]"]`, redactor.redact(`["lava@1qgszv7xk2pyxzqdx8m8mlq4hhxlyg86g60hxj9"]`))
+	require.Equal(t, `{"height":"1024"}`, redactor.redact(`{"height":"1024"}`))
+	// hashes are kept, 32 byte hex values of key fields aren't
+	txHash := "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b"
+	require.Equal(t, `["`+txHash+`"]`, redactor.redact(`["`+txHash+`"]`))
+	require.Equal(t, `{"private_key":"[REDACTED]","hash":"`+txHash[2:]+`"}`, redactor.redact(`{"private_key":"`+txHash[2:]+`","hash":"`+txHash[2:]+`"}`))
+	require.Equal(t, `["[REDACTED]"]`, redactor.redact(`["0xf86c0a8502540be400825208944bbeeb066ed09b7aed07bf39eee0460dfa261520880de0b6b3a7640000801ca0f3ae52c1ef3300f44df0bcfd1341c232ed6134672b16e35699ae3f5fe2493379"]`))
+
+	invalidRate := 2.0
+	_, err = NewPortalLogsFromConfig(PortalLogsConfig{SampleRate: &invalidRate})
+	require.Error(t, err)
+	_, err = NewPortalLogsFromConfig(PortalLogsConfig{LogParams: true, Redact: []string{"("}})
+	require.Error(t, err)
+	_, err = NewPortalLogsFromConfig(PortalLogsConfig{Sinks: []PortalLogSinkConfig{{Type: "syslog"}}})
+	require.Error(t, err)
+	_, err = NewPortalLogsFromConfig(PortalLogsConfig{Sinks: []PortalLogSinkConfig{{Type: PortalLogSinkFile}}})
+	require.Error(t, err)
+}
+
+func TestFileLogSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "relays.log")
+	sink, err := newFileLogSink(path, 1, 2)
+	require.Nil(t, err)
+	sink.maxSize = 300 // a couple of relays per file
+	for i := 0; i < 10; i++ {
+		sink.LogRelay(&RelayLog{DappID: "explorer", ChainID: "ETH1", Method: "eth_blockNumber"})
+	}
+	require.Nil(t, sink.Close())
+
+	for _, file := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(file)
+		require.Nil(t, err)
+		require.LessOrEqual(t, info.Size(), int64(300))
+	}
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestOtlpLogSink(t *testing.T) {
+	var lock sync.Mutex
+	var payloads [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/logs", r.URL.Path)
+		require.Equal(t, "secret", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		lock.Lock()
+		payloads = append(payloads, body)
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "portal_logs.json")
+	err := os.WriteFile(path, []byte(`{"sinks":[{"type":"otlp","endpoint":"`+server.URL+`","headers":{"Authorization":"secret"}}]}`), 0o600)
+	require.Nil(t, err)
+	pl, err := LoadPortalLogs(path)
+	require.Nil(t, err)
+	pl.LogRelay("ETH1", "explorer", &spectypes.ServiceApi{Name: "eth_call", ComputeUnits: 20}, "", &RelayProvenance{ProviderAddress: "lava@provider"}, time.Second, nil)
+	pl.LogRelay("ETH1", "explorer", &spectypes.ServiceApi{Name: "eth_call", ComputeUnits: 20}, "", nil, time.Second, ErrDappUnauthorized)
+	require.Nil(t, pl.Close()) // flushes the batch
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.Len(t, payloads, 1)
+	payload := string(payloads[0])
+	require.Contains(t, payload, `"logRecords"`)
+	require.Contains(t, payload, `{"key":"method","value":{"stringValue":"eth_call"}}`)
+	require.Contains(t, payload, `{"key":"cu","value":{"intValue":"20"}}`)
+	require.Contains(t, payload, `"severityText":"ERROR"`)
+}
+
+func TestNewRelicRelayAttributesMatchRelayLog(t *testing.T) {
+	relayLog := &RelayLog{Time: time.Now(), DappID: "explorer", ChainID: "ETH1", Method: "eth_call", Provider: "lava@provider", LatencyMs: 5, CU: 20, CacheStatus: CacheStatusMiss, Params: "[]"}
+	encoded, err := json.Marshal(relayLog)
+	require.Nil(t, err)
+	fields := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(encoded, &fields))
+	delete(fields, "time") // newrelic timestamps the events itself
+
+	attributes := newRelicRelayAttributes(relayLog)
+	require.Len(t, attributes, len(fields))
+	for name := range fields {
+		require.Contains(t, attributes, name)
+	}
+	require.Equal(t, "explorer", attributes["dapp_id"])
+	require.Equal(t, int64(5), attributes["latency_ms"])
+}
@@ -0,0 +1,148 @@
+package chainproxy
+
+//
+// Provenance of portal replies: the provider that served a relay, the latest block it reported, the epoch and whether
+// the reply came from the cache. returned as response headers, or in an envelope for websockets that ask for it, so a
+// wrong answer can be traced to its provider. trusted api keys can also force or exclude providers when debugging
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+)
+
+const (
+	ProviderAddressHeader  = "Lava-Provider-Address"
+	LatestBlockHeader      = "Lava-Latest-Block"
+	CacheHitHeader         = "Lava-Cache-Hit"
+	CoalescedHeader        = "Lava-Coalesced"
+	EpochHeader            = "Lava-Epoch"
+	LatencyHeader          = "Lava-Latency-Ms"
+	ForceProviderHeader    = "Lava-Force-Provider"
+	ExcludeProvidersHeader = "Lava-Exclude-Providers" // comma separated provider addresses
+	ProvenanceQueryParam   = "lava-provenance"        // websocket url query, true wraps every reply in a provenance envelope
+
+	ContextUserValueKeyProvenance        = "provenance"
+	ContextUserValueKeyProviderSelection = "providerSelection"
+)
+
+// RelayProvenance describes how a portal relay was served
+type RelayProvenance struct {
+	ProviderAddress string // empty when the reply came from the cache
+	LatestBlock     int64
+	CacheHit        bool
+	Coalesced       bool // the relay was shared by identical relays sent at the same time
+	Epoch           uint64
+	Latency         time.Duration
+}
+
+// ProviderSelection overrides the providers a relay is sent to, for debugging
+type ProviderSelection struct {
+	ForceProvider    string              // the relay is sent only to this provider, it fails if the provider can't be used
+	ExcludeProviders map[string]struct{} // the relay isn't sent to these providers
+}
+
+type provenanceEnvelope struct {
+	Reply json.RawMessage `json:"reply"`
+	Lava  provenanceJson  `json:"lava"`
+}
+
+type provenanceJson struct {
+	ProviderAddress string `json:"provider_address"`
+	LatestBlock     int64  `json:"latest_block"`
+	CacheHit        bool   `json:"cache_hit"`
+	Coalesced       bool   `json:"coalesced"`
+	Epoch           uint64 `json:"epoch"`
+	LatencyMs       int64  `json:"latency_ms"`
+}
+
+// parseProviderSelection returns the selection of the request headers values, nil when they select nothing
+func parseProviderSelection(forceProvider string, excludeProviders string) *ProviderSelection {
+	selection := &ProviderSelection{ForceProvider: strings.TrimSpace(forceProvider)}
+	for _, provider := range strings.Split(excludeProviders, ",") {
+		if provider = strings.TrimSpace(provider); provider != "" {
+			if selection.ExcludeProviders == nil {
+				selection.ExcludeProviders = map[string]struct{}{}
+			}
+			selection.ExcludeProviders[provider] = struct{}{}
+		}
+	}
+	if selection.ForceProvider == "" && len(selection.ExcludeProviders) == 0 {
+		return nil
+	}
+	return selection
+}
+
+// providerSelectionFromFiberRequest returns the provider selection headers of a request, only trusted api keys may select
+func providerSelectionFromFiberRequest(c *fiber.Ctx, cp ChainProxy, apiKey string) *ProviderSelection {
+	registry := cp.GetDappRegistry()
+	if registry == nil || !registry.IsTrusted(apiKey) {
+		return nil
+	}
+	// zeroallocation policy for fiber.Ctx
+	return parseProviderSelection(strings.Clone(c.Get(ForceProviderHeader)), strings.Clone(c.Get(ExcludeProvidersHeader)))
+}
+
+// excludedProviders returns a copy of the excluded providers with the given ones added, GetSession adds to the map it gets
+func (ps *ProviderSelection) excludedProviders(providers ...string) map[string]struct{} {
+	excluded := map[string]struct{}{}
+	if ps != nil {
+		for provider := range ps.ExcludeProviders {
+			excluded[provider] = struct{}{}
+		}
+	}
+	for _, provider := range providers {
+		excluded[provider] = struct{}{}
+	}
+	return excluded
+}
+
+func setFiberProvenanceHeaders(c *fiber.Ctx, provenance *RelayProvenance) {
+	if provenance == nil {
+		return
+	}
+	if provenance.ProviderAddress != "" {
+		c.Set(ProviderAddressHeader, provenance.ProviderAddress)
+	}
+	c.Set(LatestBlockHeader, strconv.FormatInt(provenance.LatestBlock, 10))
+	c.Set(CacheHitHeader, strconv.FormatBool(provenance.CacheHit))
+	c.Set(CoalescedHeader, strconv.FormatBool(provenance.Coalesced))
+	c.Set(EpochHeader, strconv.FormatUint(provenance.Epoch, 10))
+	c.Set(LatencyHeader, strconv.FormatInt(provenance.Latency.Milliseconds(), 10))
+}
+
+// websocketReplyData returns the data written to a websocket for a reply, wrapped in a provenance envelope when asked
+func websocketReplyData(data []byte, provenance *RelayProvenance, envelope bool) []byte {
+	if !envelope || provenance == nil {
+		return data
+	}
+	reply := json.RawMessage(data)
+	if !json.Valid(data) {
+		// non json replies are sent as a json string
+		reply, _ = json.Marshal(string(data))
+	}
+	wrapped, err := json.Marshal(provenanceEnvelope{Reply: reply, Lava: provenanceJson{
+		ProviderAddress: provenance.ProviderAddress,
+		LatestBlock:     provenance.LatestBlock,
+		CacheHit:        provenance.CacheHit,
+		Coalesced:       provenance.Coalesced,
+		Epoch:           provenance.Epoch,
+		LatencyMs:       provenance.Latency.Milliseconds(),
+	}})
+	if err != nil {
+		return data
+	}
+	return wrapped
+}
+
+// extractProvenanceOptionsFromWebsocketConnection returns the provider selection and envelope option set when the
+// connection was opened
+func extractProvenanceOptionsFromWebsocketConnection(c *websocket.Conn) (selection *ProviderSelection, envelope bool) {
+	selection, _ = c.Locals(ContextUserValueKeyProviderSelection).(*ProviderSelection)
+	envelope, _ = c.Locals(ContextUserValueKeyProvenance).(bool)
+	return selection, envelope
+}
@@ -0,0 +1,89 @@
+package chainproxy
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProviderSelection(t *testing.T) {
+	require.Nil(t, parseProviderSelection("", ""))
+	require.Nil(t, parseProviderSelection(" ", " , "))
+
+	selection := parseProviderSelection("lava@provider1", "")
+	require.Equal(t, "lava@provider1", selection.ForceProvider)
+	require.Empty(t, selection.ExcludeProviders)
+
+	selection = parseProviderSelection("", "lava@provider1, lava@provider2,")
+	require.Equal(t, "", selection.ForceProvider)
+	require.Equal(t, map[string]struct{}{"lava@provider1": {}, "lava@provider2": {}}, selection.ExcludeProviders)
+
+	// the excluded providers are copied, the session manager adds to them
+	excluded := selection.excludedProviders("lava@provider3")
+	require.Len(t, excluded, 3)
+	require.Len(t, selection.ExcludeProviders, 2)
+	var noSelection *ProviderSelection
+	require.Equal(t, map[string]struct{}{"lava@provider3": {}}, noSelection.excludedProviders("lava@provider3"))
+}
+
+func TestDappRegistryTrustedKeys(t *testing.T) {
+	registry, err := NewDappRegistry(DappsConfig{Dapps: []DappConfig{{DappID: "explorer", Keys: []DappKeyConfig{{Key: "debug", Trusted: true}, {Key: "public"}}}}})
+	require.Nil(t, err)
+	require.True(t, registry.IsTrusted("debug"))
+	require.False(t, registry.IsTrusted("public"))
+	require.False(t, registry.IsTrusted("unknown"))
+}
+
+func TestWebsocketReplyData(t *testing.T) {
+	provenance := &RelayProvenance{ProviderAddress: "lava@provider1", LatestBlock: 100, Epoch: 20, Latency: 15 * time.Millisecond}
+	data := []byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`)
+	require.Equal(t, data, websocketReplyData(data, provenance, false))
+	require.Equal(t, data, websocketReplyData(data, nil, true))
+
+	envelope := provenanceEnvelope{}
+	err := json.Unmarshal(websocketReplyData(data, provenance, true), &envelope)
+	require.Nil(t, err)
+	require.JSONEq(t, string(data), string(envelope.Reply))
+	require.Equal(t, provenanceJson{ProviderAddress: "lava@provider1", LatestBlock: 100, Epoch: 20, LatencyMs: 15}, envelope.Lava)
+
+	err = json.Unmarshal(websocketReplyData([]byte("not json"), provenance, true), &envelope)
+	require.Nil(t, err)
+	require.Equal(t, `"not json"`, string(envelope.Reply))
+}
+
+func TestRelayCoalescerSharesProvenance(t *testing.T) {
+	coalescer := RelayCoalescer{}
+	release := make(chan struct{})
+	relay := func() (*pairingtypes.RelayReply, *RelayProvenance, error) {
+		<-release
+		return &pairingtypes.RelayReply{Data: []byte(`{}`)}, &RelayProvenance{ProviderAddress: "lava@provider1"}, nil
+	}
+	provenances := make([]*RelayProvenance, 2)
+	wg := sync.WaitGroup{}
+	for i := range provenances {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, provenance, err := coalescer.doWithProvenance("key", []byte(`{}`), relay)
+			require.Nil(t, err)
+			provenances[i] = provenance
+		}(i)
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	for _, provenance := range provenances {
+		require.Equal(t, "lava@provider1", provenance.ProviderAddress)
+		require.True(t, provenance.Coalesced)
+	}
+
+	_, provenance, err := coalescer.doWithProvenance("key", []byte(`{}`), func() (*pairingtypes.RelayReply, *RelayProvenance, error) {
+		return &pairingtypes.RelayReply{}, &RelayProvenance{ProviderAddress: "lava@provider2"}, nil
+	})
+	require.Nil(t, err)
+	require.False(t, provenance.Coalesced)
+}
@@ -0,0 +1,111 @@
+package chainproxy
+
+//
+// Identical deterministic relays sent at the same time (i.e eth_blockNumber when a block is produced) are coalesced,
+// the first one is relayed and its reply is shared with the others, each gets its own json rpc id back
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/lavanet/lava/relayer/parser"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	coalescedRelayTimeout = 2 * DefaultTimeout // a relay and its retry on another provider, the relay isn't bound to one client
+)
+
+// consumerRelayCoalescer coalesces the relays of all the portal clients
+var consumerRelayCoalescer RelayCoalescer
+
+// RelayCoalescer shares the reply of an in flight relay with the identical relays sent while it runs.
+// the zero value is ready to use
+type RelayCoalescer struct {
+	group singleflight.Group
+}
+
+// DetachedContext returns a context with the values of ctx (request metadata, trace) that isn't canceled with it,
+// for a coalesced relay that outlives the request that started it
+func DetachedContext(ctx context.Context) context.Context {
+	return detachedContext{parent: ctx}
+}
+
+type detachedContext struct {
+	parent context.Context
+}
+
+func (dc detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (dc detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (dc detachedContext) Err() error {
+	return nil
+}
+
+func (dc detachedContext) Value(key interface{}) interface{} {
+	return dc.parent.Value(key)
+}
+
+// CoalescingKey returns the key identical relays share, or false when the relay can't be shared: only deterministic
+// apis are, relays creating state or pinned to a provider are not. the json rpc id isn't part of the key
+func CoalescingKey(chainID string, apiInterface string, nodeMsg NodeMessage, url string, data []byte, metadata []pairingtypes.Metadata) (string, bool) {
+	category := nodeMsg.GetInterface().Category
+	if category == nil || !category.Deterministic || category.Subscription || category.Local || category.Stateful == spectypes.CONTEXT_STATE {
+		return "", false
+	}
+	var key strings.Builder
+	key.WriteString(chainID + "\n" + apiInterface + "\n" + url + "\n")
+	key.Write(parser.NormalizeJsonRpcData(data))
+	for _, header := range metadata {
+		key.WriteString("\n" + header.Name + ":" + header.Value)
+	}
+	return key.String(), true
+}
+
+// Do relays once for all the callers of the same key, every caller gets its own copy of the reply with the
+// json rpc id of its request data
+func (rc *RelayCoalescer) Do(key string, data []byte, relay func() (*pairingtypes.RelayReply, error)) (*pairingtypes.RelayReply, error) {
+	reply, _, err := rc.doWithProvenance(key, data, func() (*pairingtypes.RelayReply, *RelayProvenance, error) {
+		reply, err := relay()
+		return reply, nil, err
+	})
+	return reply, err
+}
+
+type coalescedRelay struct {
+	reply      *pairingtypes.RelayReply
+	provenance *RelayProvenance
+}
+
+// doWithProvenance is Do for portal relays, the provenance of a relay shared by several callers is marked as coalesced
+func (rc *RelayCoalescer) doWithProvenance(key string, data []byte, relay func() (*pairingtypes.RelayReply, *RelayProvenance, error)) (*pairingtypes.RelayReply, *RelayProvenance, error) {
+	result, err, shared := rc.group.Do(key, func() (interface{}, error) {
+		reply, provenance, err := relay()
+		return coalescedRelay{reply: reply, provenance: provenance}, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	relayed := result.(coalescedRelay)
+	if !shared {
+		return relayed.reply, relayed.provenance, nil
+	}
+	reply := proto.Clone(relayed.reply).(*pairingtypes.RelayReply)
+	reply.Data = parser.RestoreJsonRpcId(reply.Data, data)
+	var provenance *RelayProvenance
+	if relayed.provenance != nil {
+		sharedProvenance := *relayed.provenance
+		sharedProvenance.Coalesced = true
+		provenance = &sharedProvenance
+	}
+	return reply, provenance, nil
+}
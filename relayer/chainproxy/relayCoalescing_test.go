@@ -0,0 +1,95 @@
+package chainproxy
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func coalescingTestMessage(category spectypes.SpecCategory) NodeMessage {
+	return &JrpcMessage{
+		serviceApi:   &spectypes.ServiceApi{Name: "eth_blockNumber", ComputeUnits: 10},
+		apiInterface: &spectypes.ApiInterface{Category: &category},
+	}
+}
+
+func TestCoalescingKey(t *testing.T) {
+	deterministic := coalescingTestMessage(spectypes.SpecCategory{Deterministic: true})
+	key, ok := CoalescingKey("ETH1", "jsonrpc", deterministic, "", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`), nil)
+	require.True(t, ok)
+	otherKey, ok := CoalescingKey("ETH1", "jsonrpc", deterministic, "", []byte(`{"id":"a", "method":"eth_blockNumber", "params":[ ], "jsonrpc":"2.0"}`), nil)
+	require.True(t, ok)
+	require.Equal(t, key, otherKey)
+
+	otherKey, _ = CoalescingKey("ETH1", "jsonrpc", deterministic, "", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["latest",false]}`), nil)
+	require.NotEqual(t, key, otherKey)
+	otherKey, _ = CoalescingKey("GTH1", "jsonrpc", deterministic, "", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`), nil)
+	require.NotEqual(t, key, otherKey)
+	otherKey, _ = CoalescingKey("ETH1", "jsonrpc", deterministic, "", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`), []pairingtypes.Metadata{{Name: "Accept", Value: "application/json"}})
+	require.NotEqual(t, key, otherKey)
+
+	for _, category := range []spectypes.SpecCategory{
+		{Deterministic: false},
+		{Deterministic: true, Local: true},
+		{Deterministic: true, Subscription: true},
+		{Deterministic: true, Stateful: spectypes.CONTEXT_STATE},
+	} {
+		_, ok = CoalescingKey("ETH1", "jsonrpc", coalescingTestMessage(category), "", []byte(`{}`), nil)
+		require.False(t, ok)
+	}
+}
+
+func TestRelayCoalescerSharesReply(t *testing.T) {
+	coalescer := RelayCoalescer{}
+	release := make(chan struct{})
+	var relays int32
+	relay := func() (*pairingtypes.RelayReply, error) {
+		atomic.AddInt32(&relays, 1)
+		<-release
+		return &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`)}, nil
+	}
+
+	const waiters = 5
+	replies := make([][]byte, waiters)
+	wg := sync.WaitGroup{}
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			request := []byte(`{"jsonrpc":"2.0","id":` + string(rune('1'+i)) + `,"method":"eth_blockNumber","params":[]}`)
+			reply, err := coalescer.Do("key", request, relay)
+			require.Nil(t, err)
+			replies[i] = reply.Data
+		}(i)
+	}
+	// let every waiter join the relay in flight
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&relays))
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`, string(replies[0]))
+	for i := 1; i < waiters; i++ {
+		require.Equal(t, `{"id":`+string(rune('1'+i))+`,"jsonrpc":"2.0","result":"0x10"}`, string(replies[i]))
+	}
+}
+
+func TestDetachedContext(t *testing.T) {
+	type testKey struct{}
+	ctx, cancel := context.WithCancel(metadata.NewIncomingContext(context.WithValue(context.Background(), testKey{}, "value"), metadata.Pairs("x-api-key", "secret")))
+	detached := DetachedContext(ctx)
+	cancel()
+	// the request values are kept, its cancellation isn't
+	require.Error(t, ctx.Err())
+	require.Nil(t, detached.Err())
+	require.Nil(t, detached.Done())
+	require.Equal(t, "value", detached.Value(testKey{}))
+	require.Equal(t, "secret", apiKeyFromGrpcContext(detached))
+}
@@ -10,7 +10,6 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/favicon"
 	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
@@ -18,6 +17,8 @@ import (
 	"github.com/lavanet/lava/relayer/parser"
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -31,24 +32,28 @@ type RestMessage struct {
 	requestedBlock int64
 	Result         json.RawMessage
 	apiInterface   *spectypes.ApiInterface
+	nodeUrl        string // when set the message is only sent to this node
+	metadata       []pairingtypes.Metadata
 }
 
 type RestChainProxy struct {
-	nodeUrl    string
+	nodes      *NodeSet
+	httpClient *http.Client
 	sentry     *sentry.Sentry
 	csm        *lavasession.ConsumerSessionManager
 	portalLogs *PortalLogs
 	cache      *performance.Cache
+	dapps      *DappRegistry
 }
 
 func (r *RestMessage) GetMsg() interface{} {
 	return r.msg
 }
 
-func NewRestChainProxy(nodeUrl string, sentry *sentry.Sentry, csm *lavasession.ConsumerSessionManager, pLogs *PortalLogs) ChainProxy {
-	nodeUrl = strings.TrimSuffix(nodeUrl, "/")
+func NewRestChainProxy(nodes *NodeSet, maxConns uint, sentry *sentry.Sentry, csm *lavasession.ConsumerSessionManager, pLogs *PortalLogs) ChainProxy {
 	return &RestChainProxy{
-		nodeUrl:    nodeUrl,
+		nodes:      nodes,
+		httpClient: newNodeHttpClient(maxConns),
 		sentry:     sentry,
 		csm:        csm,
 		portalLogs: pLogs,
@@ -111,6 +116,18 @@ func (cp *RestChainProxy) GetCache() *performance.Cache {
 	return cp.cache
 }
 
+func (cp *RestChainProxy) SetDappRegistry(dapps *DappRegistry) {
+	cp.dapps = dapps
+}
+
+func (cp *RestChainProxy) GetDappRegistry() *DappRegistry {
+	return cp.dapps
+}
+
+func (cp *RestChainProxy) GetPortalLogs() *PortalLogs {
+	return cp.portalLogs
+}
+
 func (cp *RestChainProxy) FetchBlockHashByNum(ctx context.Context, blockNum int64) (string, error) {
 	serviceApi, ok := cp.GetSentry().GetSpecApiByTag(spectypes.GET_BLOCK_BY_NUM)
 	if !ok {
@@ -120,7 +137,7 @@ func (cp *RestChainProxy) FetchBlockHashByNum(ctx context.Context, blockNum int6
 	var nodeMsg NodeMessage
 	var err error
 	if serviceApi.GetParsing().FunctionTemplate != "" {
-		nodeMsg, err = cp.ParseMsg(fmt.Sprintf(serviceApi.GetParsing().FunctionTemplate, blockNum), nil, http.MethodGet)
+		nodeMsg, err = cp.ParseMsg(fmt.Sprintf(serviceApi.GetParsing().FunctionTemplate, blockNum), nil, http.MethodGet, nil)
 	} else {
 		nodeMsg, err = cp.NewMessage(serviceApi.Name, nil, http.MethodGet)
 	}
@@ -131,7 +148,7 @@ func (cp *RestChainProxy) FetchBlockHashByNum(ctx context.Context, blockNum int6
 
 	_, _, _, err = nodeMsg.Send(ctx, nil)
 	if err != nil {
-		return "", utils.LavaFormatError("Error On Send FetchBlockHashByNum", err, &map[string]string{"nodeUrl": cp.nodeUrl})
+		return "", utils.LavaFormatError("Error On Send FetchBlockHashByNum", err, &map[string]string{"nodes": cp.nodes.String()})
 	}
 
 	blockData, err := parser.ParseMessageResponse((nodeMsg.(*RestMessage)), serviceApi.Parsing.ResultParsing)
@@ -149,6 +166,11 @@ func (cp *RestChainProxy) FetchBlockHashByNum(ctx context.Context, blockNum int6
 }
 
 func (cp *RestChainProxy) FetchLatestBlockNum(ctx context.Context) (int64, error) {
+	return cp.fetchLatestBlockNumFromNode(ctx, "")
+}
+
+// fetches the latest block from a specific node, or from any node when nodeUrl is empty
+func (cp *RestChainProxy) fetchLatestBlockNumFromNode(ctx context.Context, nodeUrl string) (int64, error) {
 	serviceApi, ok := cp.GetSentry().GetSpecApiByTag(spectypes.GET_BLOCKNUM)
 	if !ok {
 		return spectypes.NOT_APPLICABLE, errors.New(spectypes.GET_BLOCKNUM + " tag function not found")
@@ -159,16 +181,17 @@ func (cp *RestChainProxy) FetchLatestBlockNum(ctx context.Context) (int64, error
 	if err != nil {
 		return spectypes.NOT_APPLICABLE, err
 	}
+	nodeMsg.nodeUrl = nodeUrl
 
 	_, _, _, err = nodeMsg.Send(ctx, nil)
 	if err != nil {
-		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Error On Send FetchLatestBlockNum", err, &map[string]string{"nodeUrl": cp.nodeUrl})
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Error On Send FetchLatestBlockNum", err, &map[string]string{"nodeUrl": nodeUrl})
 	}
 
 	blocknum, err := parser.ParseBlockFromReply(nodeMsg, serviceApi.Parsing.ResultParsing)
 	if err != nil {
 		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Failed To Parse FetchLatestBlockNum", err, &map[string]string{
-			"nodeUrl":  cp.nodeUrl,
+			"nodeUrl":  nodeUrl,
 			"Method":   nodeMsg.path,
 			"Response": string(nodeMsg.Result),
 		})
@@ -181,10 +204,27 @@ func (cp *RestChainProxy) GetSentry() *sentry.Sentry {
 	return cp.sentry
 }
 
-func (cp *RestChainProxy) Start(context.Context) error {
+func (cp *RestChainProxy) Start(ctx context.Context) error {
+	if len(cp.nodes.Urls()) == 0 {
+		return errors.New("no node urls were provided")
+	}
+	go cp.nodes.HealthCheckLoop(ctx, getNodeHealthInterval(cp.sentry.GetAverageBlockTime()), cp.fetchLatestBlockNumFromNode)
 	return nil
 }
 
+// nodeUrlsForMessage returns the nodes to try for a message in order
+func (cp *RestChainProxy) nodeUrlsForMessage(nodeUrl string, requestedBlock int64) []string {
+	if nodeUrl != "" {
+		return []string{nodeUrl}
+	}
+	return cp.nodes.OrderedUrls(requestedBlock)
+}
+
+// gateway errors mean the node is unreachable behind a proxy, any other status is an answer from the node
+func isRestNodeFailureStatus(statusCode int) bool {
+	return statusCode == http.StatusBadGateway || statusCode == http.StatusServiceUnavailable || statusCode == http.StatusGatewayTimeout
+}
+
 func (cp *RestChainProxy) getSupportedApi(path string) (*spectypes.ServiceApi, error) {
 	path = strings.SplitN(path, "?", 2)[0]
 	if api, ok := cp.sentry.MatchSpecApiByName(path); ok {
@@ -196,7 +236,7 @@ func (cp *RestChainProxy) getSupportedApi(path string) (*spectypes.ServiceApi, e
 	return nil, fmt.Errorf("REST Api not supported %s ", path)
 }
 
-func (cp *RestChainProxy) ParseMsg(path string, data []byte, connectionType string) (NodeMessage, error) {
+func (cp *RestChainProxy) ParseMsg(path string, data []byte, connectionType string, metadata []pairingtypes.Metadata) (NodeMessage, error) {
 	//
 	// Check api is supported an save it in nodeMsg
 	serviceApi, err := cp.getSupportedApi(path)
@@ -221,12 +261,13 @@ func (cp *RestChainProxy) ParseMsg(path string, data []byte, connectionType stri
 		path:         path,
 		msg:          data,
 		apiInterface: apiInterface, // POST,GET etc..
+		metadata:     requestMetadata(serviceApi, metadata),
 	}
 
 	return nodeMsg, nil
 }
 
-func (cp *RestChainProxy) PortalStart(ctx context.Context, privKey *btcec.PrivateKey, listenAddr string) {
+func (cp *RestChainProxy) PortalStart(ctx context.Context, signer sigs.Signer, listenAddr string) {
 	//
 	// Setup HTTP Server
 	app := fiber.New(fiber.Config{})
@@ -238,14 +279,20 @@ func (cp *RestChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 		cp.portalLogs.LogStartTransaction("rest-http")
 
 		msgSeed := cp.portalLogs.GetMessageSeed()
+		ctx, span := StartPortalSpan(ctx, cp, "rest-http")
+		defer span.End()
 		path := "/" + c.Params("*")
 
-		// TODO: handle contentType, in case its not application/json currently we set it to application/json in the Send() method
-		// contentType := string(c.Context().Request.Header.ContentType())
+		// the content type is relayed when the spec passes it, otherwise the node gets application/json
 		dappID := ExtractDappIDFromFiberContext(c)
 		utils.LavaFormatInfo("in <<<", &map[string]string{"path": path, "dappID": dappID, "msgSeed": msgSeed})
 		requestBody := string(c.Body())
-		reply, _, err := SendRelay(ctx, cp, privKey, path, requestBody, http.MethodPost, dappID)
+		dappID, err := authorizeDappRelay(cp, c.Params("dappId"), dappID, path, requestBody, http.MethodPost)
+		if err != nil {
+			return sendRestDappError(c, err)
+		}
+		reply, _, provenance, err := SendRelay(ctx, cp, signer, path, requestBody, http.MethodPost, dappID, c.IP(), metadataFromFiberRequest(c), providerSelectionFromFiberRequest(c, cp, c.Params("dappId")))
+		tracing.RecordError(span, err)
 		if err != nil {
 			errMasking := cp.portalLogs.GetUniqueGuidResponseForError(err, msgSeed)
 			cp.portalLogs.LogRequestAndResponse("http in/out", true, http.MethodPost, path, requestBody, errMasking, msgSeed, err)
@@ -254,6 +301,8 @@ func (cp *RestChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 		}
 		responseBody := string(reply.Data)
 		cp.portalLogs.LogRequestAndResponse("http in/out", false, http.MethodPost, path, requestBody, responseBody, msgSeed, nil)
+		setFiberReplyHeaders(c, reply.Metadata)
+		setFiberProvenanceHeaders(c, provenance)
 		return c.SendString(responseBody)
 	})
 
@@ -262,6 +311,8 @@ func (cp *RestChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 	app.Use("/:dappId/*", func(c *fiber.Ctx) error {
 		cp.portalLogs.LogStartTransaction("rest-http")
 		msgSeed := cp.portalLogs.GetMessageSeed()
+		ctx, span := StartPortalSpan(ctx, cp, "rest-http")
+		defer span.End()
 
 		query := "?" + string(c.Request().URI().QueryString())
 		path := "/" + c.Params("*")
@@ -271,7 +322,12 @@ func (cp *RestChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 			dappID = strings.ReplaceAll(dappID, "*", "")
 		}
 		utils.LavaFormatInfo("in <<<", &map[string]string{"path": path, "dappID": dappID, "msgSeed": msgSeed})
-		reply, _, err := SendRelay(ctx, cp, privKey, path, query, http.MethodGet, dappID)
+		dappID, err := authorizeDappRelay(cp, c.Params("dappId"), dappID, path, query, http.MethodGet)
+		if err != nil {
+			return sendRestDappError(c, err)
+		}
+		reply, _, provenance, err := SendRelay(ctx, cp, signer, path, query, http.MethodGet, dappID, c.IP(), metadataFromFiberRequest(c), providerSelectionFromFiberRequest(c, cp, c.Params("dappId")))
+		tracing.RecordError(span, err)
 		if err != nil {
 			errMasking := cp.portalLogs.GetUniqueGuidResponseForError(err, msgSeed)
 			cp.portalLogs.LogRequestAndResponse("http in/out", true, http.MethodGet, path, "", errMasking, msgSeed, err)
@@ -280,6 +336,8 @@ func (cp *RestChainProxy) PortalStart(ctx context.Context, privKey *btcec.Privat
 		}
 		responseBody := string(reply.Data)
 		cp.portalLogs.LogRequestAndResponse("http in/out", false, http.MethodGet, path, "", responseBody, msgSeed, nil)
+		setFiberReplyHeaders(c, reply.Metadata)
+		setFiberProvenanceHeaders(c, provenance)
 		return c.SendString(responseBody)
 	})
 	//
@@ -306,10 +364,23 @@ func (nm *RestMessage) Send(ctx context.Context, ch chan interface{}) (relayRepl
 	if ch != nil {
 		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on rest", nil, nil)
 	}
-	httpClient := http.Client{
-		Timeout: getTimePerCu(nm.serviceApi.ComputeUnits),
+	err = errors.New("no nodes to send the message to")
+	for _, nodeUrl := range nm.cp.nodeUrlsForMessage(nm.nodeUrl, nm.requestedBlock) {
+		var nodeErr error
+		relayReply, nodeErr, err = nm.sendToNode(ctx, nodeUrl)
+		nm.cp.nodes.OnNodeResult(nodeUrl, nodeErr)
+		if nodeErr == nil {
+			return relayReply, "", nil, err
+		}
+		if !canRetryOnAnotherNode(nm.apiInterface) {
+			break
+		}
+		utils.LavaFormatWarning("node failed to respond, trying the next node", nodeErr, &map[string]string{"nodeUrl": nodeUrl})
 	}
+	return relayReply, "", nil, err
+}
 
+func (nm *RestMessage) sendToNode(ctx context.Context, nodeUrl string) (relayReply *pairingtypes.RelayReply, nodeErr error, err error) {
 	var connectionTypeSlected string = http.MethodGet
 	// if ConnectionType is default value or empty we will choose http.MethodGet otherwise choosing the header type provided
 	if nm.apiInterface.Type != "" {
@@ -317,25 +388,35 @@ func (nm *RestMessage) Send(ctx context.Context, ch chan interface{}) (relayRepl
 	}
 
 	msgBuffer := bytes.NewBuffer(nm.msg)
-	url := nm.cp.nodeUrl + nm.path
+	url := strings.TrimSuffix(nodeUrl, "/") + nm.path
 	// Only get calls uses query params the rest uses the body
 	if connectionTypeSlected == http.MethodGet {
 		url += string(nm.msg)
 	}
-	req, err := http.NewRequest(connectionTypeSlected, url, msgBuffer)
+	connectCtx, cancel := context.WithTimeout(ctx, getTimePerCu(nm.serviceApi.ComputeUnits))
+	defer cancel()
+	req, err := http.NewRequestWithContext(connectCtx, connectionTypeSlected, url, msgBuffer)
 	if err != nil {
 		nm.Result = []byte(fmt.Sprintf("%s", err))
-		return nil, "", nil, err
+		return nil, nil, err
 	}
 
 	// setting the content-type to be application/json instead of Go's defult http.DefaultClient
 	if connectionTypeSlected == http.MethodPost || connectionTypeSlected == http.MethodPut {
 		req.Header.Set("Content-Type", "application/json")
 	}
-	res, err := httpClient.Do(req)
+	for name, values := range metadataToHttpHeader(nm.metadata) {
+		req.Header[name] = values
+	}
+	res, err := nm.cp.httpClient.Do(req)
 	if err != nil {
 		nm.Result = []byte(fmt.Sprintf("%s", err))
-		return nil, "", nil, err
+		if ctx.Err() == nil {
+			nodeErr = err
+			// drop the kept alive connections so the next requests dial the node again
+			nm.cp.httpClient.CloseIdleConnections()
+		}
+		return nil, nodeErr, err
 	}
 
 	if res.Body != nil {
@@ -345,13 +426,17 @@ func (nm *RestMessage) Send(ctx context.Context, ch chan interface{}) (relayRepl
 	body, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		nm.Result = []byte(fmt.Sprintf("%s", err))
-		return nil, "", nil, err
+		return nil, err, err
+	}
+	if isRestNodeFailureStatus(res.StatusCode) {
+		nodeErr = fmt.Errorf("node responded with status %d", res.StatusCode)
 	}
 
 	reply := &pairingtypes.RelayReply{
-		Data: body,
+		Data:     body,
+		Metadata: replyMetadata(nm.serviceApi, res.Header),
 	}
 	nm.Result = body
 
-	return reply, "", nil, nil
+	return reply, nodeErr, nil
 }
@@ -79,6 +79,9 @@ type Client struct {
 
 	idCounter uint32
 
+	// set while the connection is broken and wasn't reconnected yet
+	connBroken int32
+
 	// This function, if non-nil, is called when the connection is lost.
 	reconnectFunc reconnectFunc
 
@@ -252,6 +255,17 @@ func (c *Client) Close() {
 	}
 }
 
+// Healthy reports whether the client can still serve requests, it is false once the client was closed or its
+// connection broke. a broken connection is redialed on the next write
+func (c *Client) Healthy() bool {
+	select {
+	case <-c.closing:
+		return false
+	default:
+	}
+	return atomic.LoadInt32(&c.connBroken) == 0
+}
+
 // SetHeader adds a custom HTTP header to the client's requests.
 // This method only works for clients using HTTP, it doesn't have
 // any effect for clients using another transport.
@@ -621,6 +635,7 @@ func (c *Client) dispatch(codec ServerCodec) {
 			conn.handler.log.Debug("RPC connection read error", "err", err)
 			conn.close(err, lastOp)
 			reading = false
+			atomic.StoreInt32(&c.connBroken, 1)
 
 		// Reconnect:
 		case newcodec := <-c.reconnected:
@@ -636,6 +651,7 @@ func (c *Client) dispatch(codec ServerCodec) {
 			}
 			go c.read(newcodec)
 			reading = true
+			atomic.StoreInt32(&c.connBroken, 0)
 			conn = c.newClientConn(newcodec)
 			// Re-register the in-flight request on the new handler
 			// because that's where it will be sent.
@@ -194,12 +194,16 @@ func (hc *httpConn) doRequest(ctx context.Context, msg interface{}) (io.ReadClos
 	hc.mu.Lock()
 	req.Header = hc.headers.Clone()
 	hc.mu.Unlock()
+	setHeaders(req.Header, headersFromContext(ctx))
 
 	// do request
 	resp, err := hc.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
+	if replyHeaders := replyHeadersFromContext(ctx); replyHeaders != nil {
+		setHeaders(replyHeaders, resp.Header)
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		var buf bytes.Buffer
 		var body []byte
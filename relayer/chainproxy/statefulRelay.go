@@ -0,0 +1,167 @@
+package chainproxy
+
+//
+// Stateful relays change the chain state (i.e transactions). they are sent to several providers in parallel so
+// a provider whose node drops the request doesn't lose it. their replies differ between providers by design,
+// so they are not cached and not checked by data reliability
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+type statefulRelayResult struct {
+	reply           *pairingtypes.RelayReply
+	providerAddress string
+	epoch           uint64
+	latency         time.Duration
+	err             error
+}
+
+func isStatefulRelay(nodeMsg NodeMessage) bool {
+	category := nodeMsg.GetInterface().Category
+	return category != nil && category.Stateful == spectypes.CONTEXT_STATE && !category.Subscription
+}
+
+// statefulRelayTargets returns the number of providers a stateful relay is sent to
+func statefulRelayTargets(configuredTargets uint64, pairingSize uint64) uint64 {
+	if configuredTargets == 0 || configuredTargets > pairingSize {
+		return pairingSize
+	}
+	return configuredTargets
+}
+
+// sendStatefulRelay sends the relay to the configured number of paired providers and returns the first successful reply.
+// every session is done or failed when its provider answers, also after the first reply was returned.
+// a forced provider is the only one the relay is sent to
+func sendStatefulRelay(
+	ctx context.Context,
+	cp ChainProxy,
+	signer sigs.Signer,
+	nodeMsg NodeMessage,
+	url string,
+	req string,
+	connectionType string,
+	metadata []pairingtypes.Metadata,
+	selection *ProviderSelection,
+) (*pairingtypes.RelayReply, *RelayProvenance, error) {
+	csm := cp.GetConsumerSessionManager()
+	computeUnits := nodeMsg.GetServiceApi().ComputeUnits
+	targets := statefulRelayTargets(cp.GetSentry().GetStatefulRelayTargets(), csm.GetAtomicPairingAddressesLength())
+	forced := selection != nil && selection.ForceProvider != ""
+	if forced {
+		targets = 1
+	}
+
+	usedProviders := selection.excludedProviders() // the excluded providers are skipped like the used ones
+	results := make(chan statefulRelayResult, targets)
+	sent := 0
+	for i := uint64(0); i < targets; i++ {
+		var consumerSession *lavasession.SingleConsumerSession
+		var epoch uint64
+		var providerAddress string
+		var reportedProviders []byte
+		var err error
+		if forced {
+			consumerSession, epoch, providerAddress, reportedProviders, err = csm.GetSessionFromProvider(ctx, selection.ForceProvider, computeUnits)
+		} else {
+			// GetSession adds to the providers it is given, so it gets a copy
+			unwantedProviders := make(map[string]struct{}, len(usedProviders))
+			for providerAddress := range usedProviders {
+				unwantedProviders[providerAddress] = struct{}{}
+			}
+			consumerSession, epoch, providerAddress, reportedProviders, err = csm.GetSession(ctx, computeUnits, unwantedProviders)
+		}
+		if err != nil {
+			if sent == 0 {
+				return nil, nil, err
+			}
+			utils.LavaFormatWarning("stateful relay sent to less providers than targeted", err, &map[string]string{"targets": strconv.FormatUint(targets, 10), "sent": strconv.Itoa(sent)})
+			break
+		}
+		usedProviders[providerAddress] = struct{}{}
+		sent++
+		go func() {
+			relaySentTime := time.Now()
+			reply, err := sendStatefulRelayToProvider(cp, signer, nodeMsg, consumerSession, epoch, providerAddress, reportedProviders, url, req, connectionType, metadata)
+			results <- statefulRelayResult{reply: reply, providerAddress: providerAddress, epoch: epoch, latency: time.Since(relaySentTime), err: err}
+		}()
+	}
+
+	var firstErr error
+	for i := 0; i < sent; i++ {
+		result := <-results
+		if result.err == nil {
+			return result.reply, &RelayProvenance{ProviderAddress: result.providerAddress, LatestBlock: result.reply.LatestBlock, Epoch: result.epoch, Latency: result.latency}, nil
+		}
+		utils.LavaFormatWarning("stateful relay failed on provider", result.err, &map[string]string{"provider": result.providerAddress})
+		if firstErr == nil {
+			firstErr = result.err
+		}
+	}
+	if firstErr == nil {
+		firstErr = errors.New("no providers to send the stateful relay to")
+	}
+	return nil, nil, firstErr
+}
+
+// sendStatefulRelayToProvider relays on a locked session and settles it. the relay isn't bound to the user request
+// context, the other providers keep relaying after the first reply was returned
+func sendStatefulRelayToProvider(
+	cp ChainProxy,
+	signer sigs.Signer,
+	nodeMsg NodeMessage,
+	consumerSession *lavasession.SingleConsumerSession,
+	epoch uint64,
+	providerAddress string,
+	reportedProviders []byte,
+	url string,
+	req string,
+	connectionType string,
+	metadata []pairingtypes.Metadata,
+) (reply *pairingtypes.RelayReply, err error) {
+	csm := cp.GetConsumerSessionManager()
+	relayRequest := &pairingtypes.RelayRequest{
+		Provider:              providerAddress,
+		ConnectionType:        connectionType,
+		ApiUrl:                url,
+		Data:                  []byte(req),
+		SessionId:             uint64(consumerSession.SessionId),
+		ChainID:               cp.GetSentry().ChainID,
+		CuSum:                 consumerSession.CuSum + consumerSession.LatestRelayCu,
+		BlockHeight:           int64(epoch),
+		RelayNum:              consumerSession.RelayNum + lavasession.RelayNumberIncrement,
+		RequestBlock:          nodeMsg.RequestedBlock(),
+		QoSReport:             consumerSession.QoSInfo.LastQoSReport,
+		DataReliability:       nil,
+		UnresponsiveProviders: reportedProviders,
+		Metadata:              metadata,
+	}
+	relayRequest.Sig, err = signer.SignRelay(*relayRequest)
+	if err == nil {
+		relayCtx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+		defer cancel()
+		relaySentTime := time.Now()
+		reply, err = (*consumerSession.Endpoint.Client).Relay(relayCtx, relayRequest)
+		if err == nil {
+			err = VerifyRelayReply(reply, relayRequest, providerAddress, consumerSession.Client.Operator, cp.GetSentry().GetSpecDataReliabilityEnabled())
+		}
+		if err == nil {
+			expectedBH, numOfProviders := cp.GetSentry().ExpectedBlockHeight()
+			err = csm.OnSessionDone(consumerSession, epoch, reply.LatestBlock, nodeMsg.GetServiceApi().ComputeUnits, time.Since(relaySentTime), expectedBH, numOfProviders, cp.GetSentry().GetProvidersCount())
+			return reply, err
+		}
+	}
+	if errReport := csm.OnSessionFailure(consumerSession, err); errReport != nil {
+		utils.LavaFormatError("failed reporting a stateful relay session failure", errReport, &map[string]string{"provider": providerAddress})
+	}
+	return nil, err
+}
@@ -0,0 +1,146 @@
+package chainproxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestStatefulRelayTargets(t *testing.T) {
+	require.Equal(t, uint64(5), statefulRelayTargets(0, 5))
+	require.Equal(t, uint64(2), statefulRelayTargets(2, 5))
+	require.Equal(t, uint64(5), statefulRelayTargets(10, 5))
+	require.Zero(t, statefulRelayTargets(3, 0))
+}
+
+func TestIsStatefulRelay(t *testing.T) {
+	nodeMsg := &RestMessage{apiInterface: &spectypes.ApiInterface{Category: &spectypes.SpecCategory{Stateful: spectypes.CONTEXT_STATE}}}
+	require.True(t, isStatefulRelay(nodeMsg))
+	nodeMsg.apiInterface.Category.Subscription = true
+	require.False(t, isStatefulRelay(nodeMsg))
+	nodeMsg.apiInterface.Category = &spectypes.SpecCategory{Deterministic: true}
+	require.False(t, isStatefulRelay(nodeMsg))
+}
+
+// testProvider is a provider relayer signing its replies, relays fail when fail is set and wait for delay
+type testProvider struct {
+	pairingtypes.UnimplementedRelayerServer
+	sk      *btcSecp256k1.PrivateKey
+	address string
+	fail    bool
+	delay   time.Duration
+	relays  int32
+}
+
+func (tp *testProvider) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+	atomic.AddInt32(&tp.relays, 1)
+	time.Sleep(tp.delay)
+	if tp.fail {
+		return nil, fmt.Errorf("node dropped the transaction")
+	}
+	reply := &pairingtypes.RelayReply{Data: []byte(`{"result":"` + tp.address + `"}`), LatestBlock: 100}
+	var err error
+	reply.Sig, err = sigs.SignRelayResponse(tp.sk, reply, request)
+	return reply, err
+}
+
+// startTestProviders serves the providers and returns their pairing
+func startTestProviders(t *testing.T, providers []*testProvider) []*lavasession.ConsumerSessionsWithProvider {
+	pairing := []*lavasession.ConsumerSessionsWithProvider{}
+	for _, provider := range providers {
+		sk, address := sigs.GenerateFloatingKey()
+		provider.sk, provider.address = sk, address.String()
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.Nil(t, err)
+		server := grpc.NewServer()
+		pairingtypes.RegisterRelayerServer(server, provider)
+		go server.Serve(lis)
+		t.Cleanup(server.Stop)
+		pairing = append(pairing, &lavasession.ConsumerSessionsWithProvider{
+			Acc:             provider.address,
+			Endpoints:       []*lavasession.Endpoint{{Addr: lis.Addr().String(), Enabled: true}},
+			Sessions:        map[int64]*lavasession.SingleConsumerSession{},
+			MaxComputeUnits: 200,
+			PairingEpoch:    20,
+		})
+	}
+	return pairing
+}
+
+func TestSendStatefulRelayBroadcastsAndBills(t *testing.T) {
+	providers := []*testProvider{{}, {fail: true}, {delay: 200 * time.Millisecond}}
+	pairing := startTestProviders(t, providers)
+	csm := &lavasession.ConsumerSessionManager{}
+	require.Nil(t, csm.UpdateAllProviders(context.Background(), 20, pairing))
+	cp := &RestChainProxy{csm: csm, sentry: &sentry.Sentry{ChainID: "LAV1"}}
+	nodeMsg := &RestMessage{
+		serviceApi:     &spectypes.ServiceApi{Name: "/cosmos/tx/v1beta1/txs", ComputeUnits: 10},
+		apiInterface:   &spectypes.ApiInterface{Category: &spectypes.SpecCategory{Stateful: spectypes.CONTEXT_STATE}},
+		requestedBlock: spectypes.NOT_APPLICABLE,
+	}
+	consumerSk, _ := sigs.GenerateFloatingKey()
+
+	reply, provenance, err := sendStatefulRelay(context.Background(), cp, sigs.NewKeyringSigner(consumerSk), nodeMsg, "/cosmos/tx/v1beta1/txs", `{"tx_bytes":"AA=="}`, "POST", nil, nil)
+	require.Nil(t, err)
+	// the first successful reply is returned, without waiting for the slow provider
+	require.Equal(t, providers[0].address, provenance.ProviderAddress)
+	require.Equal(t, `{"result":"`+providers[0].address+`"}`, string(reply.Data))
+
+	// the relay was broadcast to all of the pairing
+	for i, provider := range providers {
+		provider := provider
+		require.Eventually(t, func() bool { return atomic.LoadInt32(&provider.relays) == 1 }, time.Second, 10*time.Millisecond, i)
+	}
+	// every session is billed once its provider answered, also after the first reply was returned.
+	// a session is read while it is locked for a relay, sessions still relaying aren't returned
+	settledCuSum := func(provider *testProvider) uint64 {
+		session, _, _, _, err := csm.GetSessionFromProvider(context.Background(), provider.address, 10)
+		if err != nil {
+			return 0
+		}
+		cuSum := session.CuSum
+		require.Nil(t, csm.OnSessionUnUsed(session))
+		return cuSum
+	}
+	require.Equal(t, uint64(10), settledCuSum(providers[0]))
+	require.Eventually(t, func() bool { return settledCuSum(providers[2]) == 10 }, 2*time.Second, 10*time.Millisecond)
+	// the failed session isn't billed
+	require.Eventually(t, func() bool {
+		pairing[1].Lock.Lock()
+		defer pairing[1].Lock.Unlock()
+		return pairing[1].UsedComputeUnits == 0
+	}, time.Second, 10*time.Millisecond)
+	require.Zero(t, settledCuSum(providers[1]))
+}
+
+func TestSendStatefulRelayAllProvidersFail(t *testing.T) {
+	providers := []*testProvider{{fail: true}, {fail: true}}
+	pairing := startTestProviders(t, providers)
+	csm := &lavasession.ConsumerSessionManager{}
+	require.Nil(t, csm.UpdateAllProviders(context.Background(), 20, pairing))
+	cp := &RestChainProxy{csm: csm, sentry: &sentry.Sentry{ChainID: "LAV1"}}
+	nodeMsg := &RestMessage{
+		serviceApi:     &spectypes.ServiceApi{ComputeUnits: 10},
+		apiInterface:   &spectypes.ApiInterface{Category: &spectypes.SpecCategory{Stateful: spectypes.CONTEXT_STATE}},
+		requestedBlock: spectypes.NOT_APPLICABLE,
+	}
+	consumerSk, _ := sigs.GenerateFloatingKey()
+
+	_, _, err := sendStatefulRelay(context.Background(), cp, sigs.NewKeyringSigner(consumerSk), nodeMsg, "/cosmos/tx/v1beta1/txs", "{}", "POST", nil, nil)
+	require.Error(t, err)
+	for _, provider := range providers {
+		require.Equal(t, int32(1), atomic.LoadInt32(&provider.relays))
+	}
+}
@@ -0,0 +1,62 @@
+package chainproxy
+
+//
+// Sticky sessions keep the node objects a client creates with local apis (i.e filters) usable. local relays and relays
+// of methods taking an id a local relay returned are sent to the same provider, per dappID and client
+
+import (
+	"encoding/json"
+)
+
+// stickyIdMethods take the id of a node object as their first param, they are pinned to the provider that created it
+var stickyIdMethods = map[string]struct{}{
+	"eth_getFilterChanges": {},
+	"eth_getFilterLogs":    {},
+	"eth_uninstallFilter":  {},
+}
+
+// stickyIdRemovalMethods remove the node object of their id, relays taking it aren't pinned afterwards
+var stickyIdRemovalMethods = map[string]struct{}{
+	"eth_uninstallFilter": {},
+}
+
+// getStickySessionKey returns an empty key when the client is unknown, its relays are not pinned
+func getStickySessionKey(dappID string, clientID string) string {
+	if clientID == "" {
+		return ""
+	}
+	return dappID + "/" + clientID
+}
+
+// getStickyIdFromReply returns the id of the node object a local relay created, when the json rpc result is a string
+func getStickyIdFromReply(data []byte) string {
+	var reply struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(data, &reply); err != nil || len(reply.Result) == 0 {
+		return ""
+	}
+	var id string
+	if err := json.Unmarshal(reply.Result, &id); err != nil {
+		return ""
+	}
+	return id
+}
+
+// getStickyIdFromRequest returns the node object id a relay takes, only for the methods taking one
+func getStickyIdFromRequest(method string, data []byte) string {
+	if _, ok := stickyIdMethods[method]; !ok {
+		return ""
+	}
+	var request struct {
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(data, &request); err != nil || len(request.Params) == 0 {
+		return ""
+	}
+	var id string
+	if err := json.Unmarshal(request.Params[0], &id); err != nil {
+		return ""
+	}
+	return id
+}
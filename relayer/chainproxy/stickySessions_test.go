@@ -0,0 +1,102 @@
+package chainproxy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStickyIdFromReply(t *testing.T) {
+	require.Equal(t, "0x1f", getStickyIdFromReply([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1f"}`)))
+	require.Empty(t, getStickyIdFromReply([]byte(`{"jsonrpc":"2.0","id":1,"result":true}`)))
+	require.Empty(t, getStickyIdFromReply([]byte(`{"jsonrpc":"2.0","id":1,"result":[]}`)))
+	require.Empty(t, getStickyIdFromReply([]byte(`not json`)))
+	require.Empty(t, getStickySessionKey("dapp", ""))
+	require.NotEqual(t, getStickySessionKey("dapp", "1.1.1.1"), getStickySessionKey("dapp", "2.2.2.2"))
+}
+
+func TestGetStickyIdFromRequest(t *testing.T) {
+	require.Equal(t, "0x1", getStickyIdFromRequest("eth_getFilterChanges", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_getFilterChanges","params":["0x1"]}`)))
+	require.Equal(t, "0x1", getStickyIdFromRequest("eth_uninstallFilter", []byte(`{"method":"eth_uninstallFilter","params":["0x1"]}`)))
+	// ids are only taken by the filter methods, the same value in other params isn't an id
+	require.Empty(t, getStickyIdFromRequest("eth_getBlockByNumber", []byte(`{"method":"eth_getBlockByNumber","params":["0x1",false]}`)))
+	require.Empty(t, getStickyIdFromRequest("eth_getFilterLogs", []byte(`{"method":"eth_getFilterLogs","params":[]}`)))
+	require.Empty(t, getStickyIdFromRequest("eth_getFilterLogs", []byte(`{"method":"eth_getFilterLogs","params":[1]}`)))
+}
+
+// stickyTestChainProxy parses the json rpc relays of the apis without a spec
+type stickyTestChainProxy struct {
+	*JrpcChainProxy
+	apis map[string]spectypes.ServiceApi
+}
+
+func (cp *stickyTestChainProxy) ParseMsg(url string, data []byte, connectionType string, metadata []pairingtypes.Metadata) (NodeMessage, error) {
+	var msg JsonrpcMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, err
+	}
+	serviceApi := cp.apis[msg.Method]
+	return &JrpcMessage{cp: cp.JrpcChainProxy, serviceApi: &serviceApi, apiInterface: &serviceApi.ApiInterfaces[0], msg: &msg, requestedBlock: spectypes.NOT_APPLICABLE}, nil
+}
+
+func TestSendRelayStickyIds(t *testing.T) {
+	// the provider replies create filters with its address as the filter id
+	providers := []*testProvider{{}, {}}
+	pairing := startTestProviders(t, providers)
+	csm := &lavasession.ConsumerSessionManager{}
+	require.Nil(t, csm.UpdateAllProviders(context.Background(), 20, pairing[:1]))
+	testApi := func(name string, local bool) spectypes.ServiceApi {
+		return spectypes.ServiceApi{Name: name, ComputeUnits: 10, ApiInterfaces: []spectypes.ApiInterface{{Type: "POST", Category: &spectypes.SpecCategory{Local: local}}}}
+	}
+	// the filter methods aren't local here, so only their ids pin them
+	cp := &stickyTestChainProxy{
+		JrpcChainProxy: &JrpcChainProxy{csm: csm, sentry: &sentry.Sentry{ChainID: "ETH1"}},
+		apis: map[string]spectypes.ServiceApi{
+			"eth_newFilter":        testApi("eth_newFilter", true),
+			"eth_getFilterChanges": testApi("eth_getFilterChanges", false),
+			"eth_uninstallFilter":  testApi("eth_uninstallFilter", false),
+			"eth_getBlockByNumber": testApi("eth_getBlockByNumber", false),
+		},
+	}
+	consumerSk, _ := sigs.GenerateFloatingKey()
+	signer := sigs.NewKeyringSigner(consumerSk)
+	relay := func(clientID string, method string, params string) (*RelayProvenance, error) {
+		req := `{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":` + params + `}`
+		_, _, provenance, err := SendRelay(context.Background(), cp, signer, "", req, "POST", "dapp", clientID, nil, nil)
+		return provenance, err
+	}
+	filterId := `["` + providers[0].address + `"]`
+
+	// both clients create a filter on the first provider, the second uninstalls it
+	for _, clientID := range []string{"1.1.1.1", "2.2.2.2"} {
+		provenance, err := relay(clientID, "eth_newFilter", `[{}]`)
+		require.Nil(t, err)
+		require.Equal(t, providers[0].address, provenance.ProviderAddress)
+	}
+	provenance, err := relay("2.2.2.2", "eth_uninstallFilter", filterId)
+	require.Nil(t, err)
+	require.Equal(t, providers[0].address, provenance.ProviderAddress)
+
+	// the first provider leaves the pairing
+	pairing[1].PairingEpoch = 40
+	require.Nil(t, csm.UpdateAllProviders(context.Background(), 40, pairing[1:]))
+
+	// the filter id as another param doesn't pin the relay
+	provenance, err = relay("1.1.1.1", "eth_getBlockByNumber", `["`+providers[0].address+`",false]`)
+	require.Nil(t, err)
+	require.Equal(t, providers[1].address, provenance.ProviderAddress)
+	// the uninstalled filter doesn't pin the relay
+	provenance, err = relay("2.2.2.2", "eth_getFilterChanges", filterId)
+	require.Nil(t, err)
+	require.Equal(t, providers[1].address, provenance.ProviderAddress)
+	// the installed filter is only on the provider that left
+	_, err = relay("1.1.1.1", "eth_getFilterChanges", filterId)
+	require.True(t, lavasession.StickyProviderUnavailableError.Is(err))
+}
@@ -0,0 +1,137 @@
+package chainproxy
+
+//
+// Billed subscriptions: apis with subscription billing in their spec charge compute units for every message streamed
+// and every period a subscription stays open. the consumer session is held by the subscription, and the accrued
+// compute units are acknowledged to the provider with signed payments, a provider closes subscriptions that stop paying
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	SubscriptionPaymentInterval = 10 * time.Second // providers expect a payment at least every few intervals
+)
+
+// billedSubscription pays the provider for the messages read from its subscription stream
+type billedSubscription struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	cp               ChainProxy
+	signer           sigs.Signer
+	consumerSession  *lavasession.SingleConsumerSession // locked until the subscription is done
+	subscribeRequest *pairingtypes.RelayRequest
+	billing          spectypes.SubscriptionBilling
+	startTime        time.Time
+	received         uint64 // atomic, the first message is the reply of the subscribe relay
+	paidCu           uint64 // only accessed by the payments routine
+	done             chan struct{}
+	doneOnce         sync.Once
+}
+
+func newBilledSubscription(cp ChainProxy, signer sigs.Signer, consumerSession *lavasession.SingleConsumerSession, subscribeRequest *pairingtypes.RelayRequest, billing spectypes.SubscriptionBilling, replyServer pairingtypes.Relayer_RelaySubscribeClient) *billedSubscription {
+	return &billedSubscription{
+		Relayer_RelaySubscribeClient: replyServer,
+		cp:                           cp,
+		signer:                       signer,
+		consumerSession:              consumerSession,
+		subscribeRequest:             subscribeRequest,
+		billing:                      billing,
+		startTime:                    time.Now(),
+		done:                         make(chan struct{}),
+	}
+}
+
+func (bs *billedSubscription) Recv() (*pairingtypes.RelayReply, error) {
+	reply, err := bs.Relayer_RelaySubscribeClient.Recv()
+	bs.onReceive(err)
+	return reply, err
+}
+
+func (bs *billedSubscription) RecvMsg(m interface{}) error {
+	err := bs.Relayer_RelaySubscribeClient.RecvMsg(m)
+	bs.onReceive(err)
+	return err
+}
+
+func (bs *billedSubscription) onReceive(err error) {
+	if err != nil {
+		// the stream is closed once it fails
+		bs.doneOnce.Do(func() { close(bs.done) })
+		return
+	}
+	atomic.AddUint64(&bs.received, 1)
+}
+
+// start pays for the subscription periodically until its stream is closed, then releases the consumer session
+func (bs *billedSubscription) start() {
+	go func() {
+		ticker := time.NewTicker(SubscriptionPaymentInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				bs.pay()
+			case <-bs.done:
+				// the messages read until the stream was closed are paid for
+				bs.pay()
+				err := bs.cp.GetConsumerSessionManager().OnSubscriptionDone(bs.consumerSession)
+				if err != nil {
+					utils.LavaFormatError("failed releasing subscription session", err, nil)
+				}
+				return
+			}
+		}
+	}()
+}
+
+// accruedComputeUnits returns the compute units of the subscription on top of the subscribe relay
+func (bs *billedSubscription) accruedComputeUnits() uint64 {
+	messages := atomic.LoadUint64(&bs.received)
+	if messages > 0 {
+		messages-- // the subscribe reply is paid for by the subscribe relay
+	}
+	return bs.billing.ComputeUnits(messages, time.Since(bs.startTime))
+}
+
+func (bs *billedSubscription) pay() {
+	accrued := bs.accruedComputeUnits()
+	if accrued <= bs.paidCu {
+		return
+	}
+	providerAddress := bs.subscribeRequest.Provider
+	err := bs.cp.GetConsumerSessionManager().OnSubscriptionCharge(bs.consumerSession, accrued-bs.paidCu)
+	if err != nil {
+		// the provider closes the subscription when it isn't paid
+		utils.LavaFormatWarning("failed charging subscription", err, &map[string]string{"provider": providerAddress, "computeUnits": strconv.FormatUint(accrued-bs.paidCu, 10)})
+		return
+	}
+	bs.paidCu = accrued
+
+	paymentRequest := bs.subscribeRequest.ShallowCopy()
+	paymentRequest.CuSum = bs.consumerSession.CuSum
+	paymentRequest.RelayNum = bs.consumerSession.RelayNum
+	paymentRequest.QoSReport = nil
+	sig, err := bs.signer.SignRelay(*paymentRequest)
+	if err != nil {
+		utils.LavaFormatError("failed signing subscription payment", err, &map[string]string{"provider": providerAddress})
+		return
+	}
+	paymentRequest.Sig = sig
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = (*bs.consumerSession.Endpoint.Client).SubscriptionPayment(ctx, paymentRequest)
+	if err != nil {
+		// the next payment carries the sum, it covers this one
+		utils.LavaFormatWarning("failed sending subscription payment", err, &map[string]string{"provider": providerAddress, "cuSum": strconv.FormatUint(paymentRequest.CuSum, 10)})
+	}
+}
@@ -0,0 +1,50 @@
+package chainproxy
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSubscribeClient streams a number of replies and then fails
+type mockSubscribeClient struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	replies int
+}
+
+func (m *mockSubscribeClient) RecvMsg(msg interface{}) error {
+	if m.replies == 0 {
+		return io.EOF
+	}
+	m.replies--
+	return nil
+}
+
+func TestBilledSubscriptionAccruedComputeUnits(t *testing.T) {
+	billing := spectypes.SubscriptionBilling{ComputeUnitsPerMessage: 3, ComputeUnitsPerPeriod: 100, PeriodSeconds: 3600}
+	bs := newBilledSubscription(nil, nil, nil, nil, billing, &mockSubscribeClient{replies: 4})
+	require.Equal(t, uint64(0), bs.accruedComputeUnits())
+
+	var reply pairingtypes.RelayReply
+	require.NoError(t, bs.RecvMsg(&reply)) // the subscribe reply isn't billed
+	require.Equal(t, uint64(0), bs.accruedComputeUnits())
+	for i := 0; i < 3; i++ {
+		require.NoError(t, bs.RecvMsg(&reply))
+	}
+	require.Equal(t, uint64(9), bs.accruedComputeUnits())
+
+	bs.startTime = time.Now().Add(-2 * time.Hour)
+	require.Equal(t, uint64(209), bs.accruedComputeUnits())
+
+	require.Error(t, bs.RecvMsg(&reply))
+	select {
+	case <-bs.done:
+	default:
+		require.Fail(t, "subscription isn't done after its stream failed")
+	}
+	require.Error(t, bs.RecvMsg(&reply)) // closing twice doesn't panic
+}
@@ -0,0 +1,334 @@
+package chainproxy
+
+//
+// Subscription failover: when the provider streaming a websocket subscription fails, or leaves the pairing at an epoch
+// boundary, the portal sends the original subscribe request to another paired provider. the dapp keeps the subscription
+// id it got first, and notifications it already got from the previous provider are suppressed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	maxSubscriptionFailovers         = 3               // consecutive resubscribe attempts before the subscription fails
+	subscriptionPairingCheckInterval = 5 * time.Second // how often the subscription provider is checked to be paired
+	recentNotificationsSize          = 1024            // notifications remembered to suppress duplicates
+	subscriptionFailoverBackoff      = 500 * time.Millisecond
+)
+
+// failoverSubscription reads a subscription stream, resubscribing with another provider when it fails
+type failoverSubscription struct {
+	ctx       context.Context // the dapp connection, the subscription ends with it
+	cp        ChainProxy
+	signer    sigs.Signer
+	request   string
+	dappID    string
+	clientID  string
+	selection *ProviderSelection
+	subscribe func(ctx context.Context, selection *ProviderSelection) (pairingtypes.Relayer_RelaySubscribeClient, *RelayProvenance, error)
+
+	lock           sync.Mutex
+	stream         pairingtypes.Relayer_RelaySubscribeClient
+	cancelStream   context.CancelFunc
+	provenance     *RelayProvenance
+	leftPairing    bool                // the stream was closed because its provider left the pairing
+	failed         map[string]struct{} // providers whose streams failed
+	subscriptionID string              // the id the dapp got, empty when the api doesn't return one
+	currentID      string              // the id of the current provider
+	subscribed     bool                // the subscribe reply of the first stream was read
+	recent         *recentNotifications
+	closed         chan struct{}
+	closeOnce      sync.Once
+}
+
+func newFailoverSubscription(ctx context.Context, cp ChainProxy, signer sigs.Signer, request string, dappID string, clientID string, selection *ProviderSelection,
+	stream pairingtypes.Relayer_RelaySubscribeClient, cancelStream context.CancelFunc, provenance *RelayProvenance,
+) *failoverSubscription {
+	fs := &failoverSubscription{
+		ctx:          ctx,
+		cp:           cp,
+		signer:       signer,
+		request:      request,
+		dappID:       dappID,
+		clientID:     clientID,
+		selection:    selection,
+		stream:       stream,
+		cancelStream: cancelStream,
+		provenance:   provenance,
+		failed:       map[string]struct{}{},
+		recent:       newRecentNotifications(recentNotificationsSize),
+		closed:       make(chan struct{}),
+	}
+	fs.subscribe = fs.sendSubscribeRelay
+	go fs.watchPairing(subscriptionPairingCheckInterval)
+	return fs
+}
+
+// sendSubscribeRelay sends the original subscribe request to a provider of the selection
+func (fs *failoverSubscription) sendSubscribeRelay(ctx context.Context, selection *ProviderSelection) (pairingtypes.Relayer_RelaySubscribeClient, *RelayProvenance, error) {
+	_, replyServer, provenance, err := SendRelay(ctx, fs.cp, fs.signer, "", fs.request, http.MethodGet, fs.dappID, fs.clientID, nil, selection)
+	if err != nil {
+		return nil, nil, err
+	}
+	if replyServer == nil {
+		return nil, nil, utils.LavaFormatError("resubscribe relay didn't open a subscription", nil, &map[string]string{"request": fs.request})
+	}
+	return *replyServer, provenance, nil
+}
+
+// Provenance returns the provenance of the provider currently streaming the subscription
+func (fs *failoverSubscription) Provenance() *RelayProvenance {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	return fs.provenance
+}
+
+// Close ends the current stream and stops watching the pairing
+func (fs *failoverSubscription) Close() {
+	fs.closeOnce.Do(func() { close(fs.closed) })
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	fs.cancelStream()
+}
+
+// Recv returns the next reply for the dapp, the first one is the subscribe reply
+func (fs *failoverSubscription) Recv() (*pairingtypes.RelayReply, error) {
+	for {
+		fs.lock.Lock()
+		stream := fs.stream
+		fs.lock.Unlock()
+
+		var reply pairingtypes.RelayReply
+		err := stream.RecvMsg(&reply)
+		if err != nil {
+			if fs.ctx.Err() != nil || !fs.isSubscribed() {
+				// the dapp closed the connection, or didn't get a subscription id yet and gets the error
+				return nil, err
+			}
+			if err = fs.failover(err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		fs.lock.Lock()
+		if !fs.subscribed {
+			fs.subscribed = true
+			fs.subscriptionID = getStickyIdFromReply(reply.Data)
+			fs.currentID = fs.subscriptionID
+			fs.lock.Unlock()
+			return &reply, nil
+		}
+		if fs.currentID != fs.subscriptionID {
+			// notifications of the new provider carry its id
+			reply.Data = bytes.ReplaceAll(reply.Data, []byte(strconv.Quote(fs.currentID)), []byte(strconv.Quote(fs.subscriptionID)))
+		}
+		fs.lock.Unlock()
+		if key, ok := notificationKey(reply.Data); ok && !fs.recent.add(key) {
+			// already sent by the previous provider
+			continue
+		}
+		return &reply, nil
+	}
+}
+
+func (fs *failoverSubscription) isSubscribed() bool {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	return fs.subscribed
+}
+
+// failover resubscribes with another provider, excluding the ones that failed
+func (fs *failoverSubscription) failover(streamErr error) error {
+	fs.lock.Lock()
+	previousProvider := fs.provenance.ProviderAddress
+	leftPairing := fs.leftPairing
+	fs.leftPairing = false
+	fs.cancelStream()
+	fs.lock.Unlock()
+
+	if fs.selection != nil && fs.selection.ForceProvider != "" {
+		// a forced provider can't be replaced
+		return streamErr
+	}
+	if !leftPairing {
+		fs.failed[previousProvider] = struct{}{}
+	}
+	utils.LavaFormatInfo("subscription provider stream closed, resubscribing", &map[string]string{"provider": previousProvider, "leftPairing": strconv.FormatBool(leftPairing), "error": streamErr.Error(), "dappID": fs.dappID})
+
+	var err error
+	for attempt := 0; attempt < maxSubscriptionFailovers; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-fs.ctx.Done():
+				return fs.ctx.Err()
+			case <-time.After(subscriptionFailoverBackoff):
+			}
+		}
+		err = fs.resubscribe(previousProvider)
+		if err == nil {
+			return nil
+		}
+		if fs.ctx.Err() != nil {
+			return err
+		}
+	}
+	return utils.LavaFormatError("failed resubscribing with another provider", err, &map[string]string{"previousProvider": previousProvider, "originalError": streamErr.Error(), "dappID": fs.dappID})
+}
+
+func (fs *failoverSubscription) resubscribe(previousProvider string) error {
+	// the dapp selection is kept, the previous and failed providers are excluded on top of it
+	selection := &ProviderSelection{}
+	if fs.selection != nil {
+		*selection = *fs.selection
+	}
+	selection.ExcludeProviders = fs.selection.excludedProviders(previousProvider)
+	for provider := range fs.failed {
+		selection.ExcludeProviders[provider] = struct{}{}
+	}
+	streamCtx, cancelStream := context.WithCancel(fs.ctx)
+	stream, provenance, err := fs.subscribe(streamCtx, selection)
+	if err != nil {
+		cancelStream()
+		return err
+	}
+	// the subscribe reply of the new provider isn't sent to the dapp, it already has its id
+	var reply pairingtypes.RelayReply
+	if err = stream.RecvMsg(&reply); err != nil {
+		cancelStream()
+		fs.failed[provenance.ProviderAddress] = struct{}{}
+		return err
+	}
+
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	fs.stream = stream
+	fs.cancelStream = cancelStream
+	fs.provenance = provenance
+	if fs.subscriptionID != "" {
+		fs.currentID = getStickyIdFromReply(reply.Data)
+	}
+	utils.LavaFormatInfo("resubscribed with another provider", &map[string]string{"provider": provenance.ProviderAddress, "previousProvider": previousProvider, "dappID": fs.dappID})
+	return nil
+}
+
+// watchPairing closes the stream when its provider leaves the pairing, so the subscription moves to a paired one
+func (fs *failoverSubscription) watchPairing(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-fs.closed:
+			return
+		case <-fs.ctx.Done():
+			return
+		case <-ticker.C:
+			fs.lock.Lock()
+			if provider := fs.provenance.ProviderAddress; provider != "" && !fs.cp.GetConsumerSessionManager().IsProviderInPairing(provider) {
+				fs.leftPairing = true
+				fs.cancelStream()
+			}
+			fs.lock.Unlock()
+		}
+	}
+}
+
+// notificationKey identifies notifications by the block they were sent for, where they reference one. notifications
+// without a block reference aren't suppressed
+func notificationKey(data []byte) (string, bool) {
+	var notification struct {
+		Params struct {
+			Result json.RawMessage `json:"result"`
+		} `json:"params"` // ethereum
+		Result json.RawMessage `json:"result"` // tendermint
+	}
+	if err := json.Unmarshal(data, &notification); err != nil {
+		return "", false
+	}
+	if len(notification.Params.Result) > 0 {
+		var result struct {
+			Hash      string `json:"hash"`
+			BlockHash string `json:"blockHash"`
+			LogIndex  string `json:"logIndex"`
+			Removed   bool   `json:"removed"`
+		}
+		if err := json.Unmarshal(notification.Params.Result, &result); err != nil {
+			return "", false
+		}
+		switch {
+		case result.BlockHash != "" && result.LogIndex != "":
+			// logs, the logs a reorg removed are sent again marked as removed
+			return "log:" + result.BlockHash + ":" + result.LogIndex + ":" + strconv.FormatBool(result.Removed), true
+		case result.Hash != "":
+			// new heads
+			return "block:" + result.Hash, true
+		}
+		return "", false
+	}
+	if len(notification.Result) > 0 {
+		var result struct {
+			Query string `json:"query"`
+			Data  struct {
+				Value struct {
+					Block *struct {
+						Header struct {
+							Height string `json:"height"`
+						} `json:"header"`
+					} `json:"block"`
+					Header *struct {
+						Height string `json:"height"`
+					} `json:"header"`
+				} `json:"value"`
+			} `json:"data"`
+			Events map[string][]string `json:"events"`
+		}
+		if err := json.Unmarshal(notification.Result, &result); err != nil {
+			return "", false
+		}
+		value := result.Data.Value
+		switch {
+		case value.Block != nil && value.Block.Header.Height != "":
+			return "tendermint-block:" + result.Query + ":" + value.Block.Header.Height, true
+		case value.Header != nil && value.Header.Height != "":
+			return "tendermint-header:" + result.Query + ":" + value.Header.Height, true
+		case len(result.Events["tx.hash"]) > 0 && len(result.Events["tx.height"]) > 0:
+			return "tendermint-tx:" + result.Query + ":" + result.Events["tx.height"][0] + ":" + result.Events["tx.hash"][0], true
+		}
+	}
+	return "", false
+}
+
+// recentNotifications remembers the last keys added to it
+type recentNotifications struct {
+	keys []string
+	set  map[string]struct{}
+	next int
+}
+
+func newRecentNotifications(size int) *recentNotifications {
+	return &recentNotifications{keys: make([]string, size), set: make(map[string]struct{}, size)}
+}
+
+// add returns false when the key is already remembered
+func (rn *recentNotifications) add(key string) bool {
+	if _, ok := rn.set[key]; ok {
+		return false
+	}
+	if evicted := rn.keys[rn.next]; evicted != "" {
+		delete(rn.set, evicted)
+	}
+	rn.keys[rn.next] = key
+	rn.set[key] = struct{}{}
+	rn.next = (rn.next + 1) % len(rn.keys)
+	return true
+}
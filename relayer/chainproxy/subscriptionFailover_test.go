@@ -0,0 +1,222 @@
+package chainproxy
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/relayer/lavasession"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRepliesClient streams the given replies and then fails
+type mockRepliesClient struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	replies []string
+}
+
+func (m *mockRepliesClient) RecvMsg(msg interface{}) error {
+	if len(m.replies) == 0 {
+		return io.EOF
+	}
+	msg.(*pairingtypes.RelayReply).Data = []byte(m.replies[0])
+	m.replies = m.replies[1:]
+	return nil
+}
+
+func TestNotificationKey(t *testing.T) {
+	playbook := []struct {
+		name string
+		data string
+		key  string
+	}{
+		{"eth new heads", `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1","result":{"number":"0x10","hash":"0xaa"}}}`, "block:0xaa"},
+		{"eth logs", `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1","result":{"blockHash":"0xaa","logIndex":"0x2","removed":false}}}`, "log:0xaa:0x2:false"},
+		{"eth removed logs", `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1","result":{"blockHash":"0xaa","logIndex":"0x2","removed":true}}}`, "log:0xaa:0x2:true"},
+		{"eth pending transactions", `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1","result":"0xbb"}}`, ""},
+		{"tendermint new block", `{"jsonrpc":"2.0","id":1,"result":{"query":"tm.event='NewBlock'","data":{"value":{"block":{"header":{"height":"12"}}}}}}`, "tendermint-block:tm.event='NewBlock':12"},
+		{"tendermint tx", `{"jsonrpc":"2.0","id":1,"result":{"query":"tm.event='Tx'","data":{"value":{}},"events":{"tx.hash":["AB"],"tx.height":["12"]}}}`, "tendermint-tx:tm.event='Tx':12:AB"},
+		{"tendermint subscribe reply", `{"jsonrpc":"2.0","id":1,"result":{}}`, ""},
+		{"not json", `banana`, ""},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			key, ok := notificationKey([]byte(play.data))
+			require.Equal(t, play.key != "", ok)
+			require.Equal(t, play.key, key)
+		})
+	}
+}
+
+func TestRecentNotifications(t *testing.T) {
+	recent := newRecentNotifications(2)
+	require.True(t, recent.add("a"))
+	require.False(t, recent.add("a"))
+	require.True(t, recent.add("b"))
+	require.True(t, recent.add("c")) // evicts a
+	require.True(t, recent.add("a"))
+	require.False(t, recent.add("c"))
+}
+
+func TestFailoverSubscriptionRecv(t *testing.T) {
+	stream := &mockRepliesClient{replies: []string{
+		`{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+		`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x2","result":{"number":"0x10","hash":"0xaa"}}}`,
+		`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x2","result":{"number":"0x10","hash":"0xaa"}}}`,
+		`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x2","result":{"number":"0x11","hash":"0xbb"}}}`,
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	fs := &failoverSubscription{ctx: ctx, stream: stream, cancelStream: cancel, provenance: &RelayProvenance{}, recent: newRecentNotifications(recentNotificationsSize), closed: make(chan struct{})}
+
+	reply, err := fs.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "0x1", fs.subscriptionID)
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, string(reply.Data))
+
+	// as if the subscription failed over to a provider that returned another id
+	fs.currentID = "0x2"
+	reply, err = fs.Recv()
+	require.NoError(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1","result":{"number":"0x10","hash":"0xaa"}}}`, string(reply.Data))
+	// the duplicate head is suppressed
+	reply, err = fs.Recv()
+	require.NoError(t, err)
+	require.Contains(t, string(reply.Data), `"hash":"0xbb"`)
+
+	// the dapp closed the connection, the subscription isn't failed over
+	fs.Close()
+	_, err = fs.Recv()
+	require.Error(t, err)
+}
+
+// newTestFailoverSubscription returns a subscription streaming from provider, resubscribing with the given function
+func newTestFailoverSubscription(provider string, stream pairingtypes.Relayer_RelaySubscribeClient, selection *ProviderSelection,
+	subscribe func(ctx context.Context, selection *ProviderSelection) (pairingtypes.Relayer_RelaySubscribeClient, *RelayProvenance, error),
+) *failoverSubscription {
+	ctx := context.Background()
+	_, cancelStream := context.WithCancel(ctx)
+	return &failoverSubscription{
+		ctx:          ctx,
+		selection:    selection,
+		subscribe:    subscribe,
+		stream:       stream,
+		cancelStream: cancelStream,
+		provenance:   &RelayProvenance{ProviderAddress: provider},
+		failed:       map[string]struct{}{},
+		recent:       newRecentNotifications(recentNotificationsSize),
+		closed:       make(chan struct{}),
+	}
+}
+
+func TestFailoverSubscriptionResubscribes(t *testing.T) {
+	stream := &mockRepliesClient{replies: []string{
+		`{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+		`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1","result":{"number":"0x10","hash":"0xaa"}}}`,
+	}}
+	var selections []*ProviderSelection
+	subscribe := func(ctx context.Context, selection *ProviderSelection) (pairingtypes.Relayer_RelaySubscribeClient, *RelayProvenance, error) {
+		selections = append(selections, selection)
+		return &mockRepliesClient{replies: []string{
+			`{"jsonrpc":"2.0","id":1,"result":"0x9"}`,
+			`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x9","result":{"number":"0x10","hash":"0xaa"}}}`,
+			`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x9","result":{"number":"0x11","hash":"0xbb"}}}`,
+		}}, &RelayProvenance{ProviderAddress: "provider2"}, nil
+	}
+	fs := newTestFailoverSubscription("provider1", stream, &ProviderSelection{ExcludeProviders: map[string]struct{}{"provider3": {}}}, subscribe)
+	defer fs.Close()
+
+	reply, err := fs.Recv()
+	require.NoError(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, string(reply.Data))
+	_, err = fs.Recv()
+	require.NoError(t, err)
+
+	// the stream ends, the subscription moves to another provider. its subscribe reply and the head the dapp already got
+	// aren't sent, the next head carries the id the dapp got
+	reply, err = fs.Recv()
+	require.NoError(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1","result":{"number":"0x11","hash":"0xbb"}}}`, string(reply.Data))
+	require.Equal(t, "provider2", fs.Provenance().ProviderAddress)
+	require.Len(t, selections, 1)
+	require.Equal(t, map[string]struct{}{"provider1": {}, "provider3": {}}, selections[0].ExcludeProviders)
+	// the dapp selection isn't changed
+	require.Equal(t, map[string]struct{}{"provider3": {}}, fs.selection.ExcludeProviders)
+	require.Contains(t, fs.failed, "provider1")
+}
+
+func TestFailoverSubscriptionForcedProvider(t *testing.T) {
+	stream := &mockRepliesClient{replies: []string{`{"jsonrpc":"2.0","id":1,"result":"0x1"}`}}
+	subscribe := func(ctx context.Context, selection *ProviderSelection) (pairingtypes.Relayer_RelaySubscribeClient, *RelayProvenance, error) {
+		require.Fail(t, "a forced provider can't be replaced")
+		return nil, nil, nil
+	}
+	fs := newTestFailoverSubscription("provider1", stream, &ProviderSelection{ForceProvider: "provider1"}, subscribe)
+	defer fs.Close()
+
+	_, err := fs.Recv()
+	require.NoError(t, err)
+	_, err = fs.Recv()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestFailoverSubscriptionGivesUp(t *testing.T) {
+	stream := &mockRepliesClient{replies: []string{`{"jsonrpc":"2.0","id":1,"result":"0x1"}`}}
+	var selections []*ProviderSelection
+	subscribe := func(ctx context.Context, selection *ProviderSelection) (pairingtypes.Relayer_RelaySubscribeClient, *RelayProvenance, error) {
+		selections = append(selections, selection)
+		// the provider opens the stream and ends it before the subscribe reply
+		return &mockRepliesClient{}, &RelayProvenance{ProviderAddress: "provider" + string(rune('2'+len(selections)-1))}, nil
+	}
+	fs := newTestFailoverSubscription("provider1", stream, nil, subscribe)
+	defer fs.Close()
+
+	_, err := fs.Recv()
+	require.NoError(t, err)
+	_, err = fs.Recv()
+	require.Error(t, err)
+	require.Len(t, selections, maxSubscriptionFailovers)
+	// every attempt excludes the providers that failed before it
+	require.Equal(t, map[string]struct{}{"provider1": {}}, selections[0].ExcludeProviders)
+	require.Equal(t, map[string]struct{}{"provider1": {}, "provider2": {}}, selections[1].ExcludeProviders)
+	require.Equal(t, map[string]struct{}{"provider1": {}, "provider2": {}, "provider3": {}}, selections[2].ExcludeProviders)
+}
+
+func TestFailoverSubscriptionWatchPairing(t *testing.T) {
+	csm := &lavasession.ConsumerSessionManager{}
+	require.Nil(t, csm.UpdateAllProviders(context.Background(), 20, []*lavasession.ConsumerSessionsWithProvider{{Acc: "provider2"}}))
+	subscribe := func(ctx context.Context, selection *ProviderSelection) (pairingtypes.Relayer_RelaySubscribeClient, *RelayProvenance, error) {
+		return &mockRepliesClient{replies: []string{
+			`{"jsonrpc":"2.0","id":1,"result":"0x1"}`,
+			`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1","result":{"number":"0x11","hash":"0xbb"}}}`,
+		}}, &RelayProvenance{ProviderAddress: "provider2"}, nil
+	}
+	fs := newTestFailoverSubscription("provider1", &mockRepliesClient{replies: []string{`{"jsonrpc":"2.0","id":1,"result":"0x1"}`}}, nil, subscribe)
+	fs.cp = &RestChainProxy{csm: csm}
+	var canceled sync.Once
+	streamCanceled := make(chan struct{})
+	fs.cancelStream = func() { canceled.Do(func() { close(streamCanceled) }) }
+	_, err := fs.Recv()
+	require.NoError(t, err)
+
+	go fs.watchPairing(10 * time.Millisecond)
+	defer fs.Close()
+	// provider1 isn't in the pairing, its stream is closed
+	select {
+	case <-streamCanceled:
+	case <-time.After(time.Second):
+		require.Fail(t, "the stream of a provider that left the pairing wasn't closed")
+	}
+	fs.lock.Lock()
+	require.True(t, fs.leftPairing)
+	fs.lock.Unlock()
+
+	// the subscription moves to a paired provider, the provider that left isn't marked as failed
+	reply, err := fs.Recv()
+	require.NoError(t, err)
+	require.Contains(t, string(reply.Data), `"hash":"0xbb"`)
+	require.Equal(t, "provider2", fs.Provenance().ProviderAddress)
+	require.Empty(t, fs.failed)
+}
@@ -0,0 +1,292 @@
+package chainproxy
+
+//
+// Identical subscriptions of consumers (i.e newHeads) share one node subscription on the provider. its notifications are
+// fanned out to every consumer, each with its own subscription id, and the node subscription is closed with the last one
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
+	"github.com/lavanet/lava/relayer/parser"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	subscriberBufferSize = 128 // notifications a consumer can fall behind before it is dropped
+)
+
+var (
+	UpstreamSubscriptionClosedError = errors.New("node subscription closed")
+	SlowSubscriberError             = errors.New("subscriber fell behind the node subscription")
+)
+
+// UpstreamSubscription is a node subscription, implemented by rpcclient.ClientSubscription
+type UpstreamSubscription interface {
+	Err() <-chan error
+	Unsubscribe()
+}
+
+// SubscribeFunc opens a node subscription delivering its notifications to ch
+type SubscribeFunc func(ch chan interface{}) (reply *pairingtypes.RelayReply, subscriptionID string, upstream UpstreamSubscription, err error)
+
+// SubscriptionMultiplexer shares node subscriptions between the consumers subscribing to the same key.
+// the zero value is ready to use
+type SubscriptionMultiplexer struct {
+	lock          sync.Mutex
+	subscriptions map[string]*sharedSubscription
+}
+
+type sharedSubscription struct {
+	key   string
+	ready chan struct{} // closed once the node subscription was opened, or failed with err
+	err   error
+
+	reply      *pairingtypes.RelayReply // the node subscribe reply
+	upstreamID string
+	idInResult bool // the subscription id is the subscribe result and is sent in the notifications params (ethereum)
+	upstream   UpstreamSubscription
+	replies    chan interface{}
+
+	// guarded by the multiplexer lock
+	subscribers map[*MultiplexedSubscriber]struct{}
+	closed      bool
+}
+
+// MultiplexedSubscriber is the subscription of one consumer on a shared node subscription
+type MultiplexedSubscriber struct {
+	id          string
+	requestID   json.RawMessage
+	multiplexer *SubscriptionMultiplexer
+	shared      *sharedSubscription
+	replies     chan interface{}
+	err         chan error
+	closeOnce   sync.Once
+}
+
+// SubscriptionMultiplexingKey returns the key identical subscribe requests share, the json rpc id isn't part of it
+func SubscriptionMultiplexingKey(chainID string, apiInterface string, url string, data []byte) string {
+	return chainID + "\n" + apiInterface + "\n" + url + "\n" + string(parser.NormalizeJsonRpcData(data))
+}
+
+// Subscribe joins the node subscription of the key, opening it when there is none. data is the consumer subscribe
+// request, the returned reply is the node subscribe reply with the consumer json rpc id and subscription id
+func (sm *SubscriptionMultiplexer) Subscribe(key string, data []byte, subscribe SubscribeFunc) (*MultiplexedSubscriber, *pairingtypes.RelayReply, error) {
+	for {
+		sm.lock.Lock()
+		if sm.subscriptions == nil {
+			sm.subscriptions = map[string]*sharedSubscription{}
+		}
+		shared, ok := sm.subscriptions[key]
+		if !ok {
+			shared = &sharedSubscription{key: key, ready: make(chan struct{}), replies: make(chan interface{}), subscribers: map[*MultiplexedSubscriber]struct{}{}}
+			sm.subscriptions[key] = shared
+			sm.lock.Unlock()
+			sm.open(shared, subscribe)
+		} else {
+			sm.lock.Unlock()
+		}
+
+		<-shared.ready
+		if shared.err != nil {
+			return nil, nil, shared.err
+		}
+		sm.lock.Lock()
+		if shared.closed {
+			// the node subscription ended before joining it, a new one is opened
+			sm.lock.Unlock()
+			continue
+		}
+		subscriber := &MultiplexedSubscriber{
+			id:          shared.upstreamID,
+			requestID:   jsonRpcId(data),
+			multiplexer: sm,
+			shared:      shared,
+			replies:     make(chan interface{}, subscriberBufferSize),
+			err:         make(chan error, 1),
+		}
+		if shared.idInResult {
+			subscriber.id = newSubscriberId()
+		}
+		shared.subscribers[subscriber] = struct{}{}
+		sm.lock.Unlock()
+
+		reply := proto.Clone(shared.reply).(*pairingtypes.RelayReply)
+		reply.Data = parser.RestoreJsonRpcId(reply.Data, data)
+		if shared.idInResult {
+			reply.Data = bytes.ReplaceAll(reply.Data, []byte(strconv.Quote(shared.upstreamID)), []byte(strconv.Quote(subscriber.id)))
+		}
+		return subscriber, reply, nil
+	}
+}
+
+func (sm *SubscriptionMultiplexer) open(shared *sharedSubscription, subscribe SubscribeFunc) {
+	reply, upstreamID, upstream, err := subscribe(shared.replies)
+	if err != nil {
+		sm.lock.Lock()
+		if sm.subscriptions[shared.key] == shared {
+			delete(sm.subscriptions, shared.key)
+		}
+		shared.err = err
+		shared.closed = true
+		sm.lock.Unlock()
+		close(shared.ready)
+		return
+	}
+	shared.reply = reply
+	shared.upstreamID = upstreamID
+	shared.upstream = upstream
+	var subscribeReply struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if json.Unmarshal(reply.Data, &subscribeReply) == nil {
+		shared.idInResult = string(subscribeReply.Result) == strconv.Quote(upstreamID)
+	}
+	close(shared.ready)
+	go sm.fanOut(shared)
+}
+
+// fanOut sends the node notifications to the subscribers until the node subscription ends
+func (sm *SubscriptionMultiplexer) fanOut(shared *sharedSubscription) {
+	for {
+		select {
+		case err := <-shared.upstream.Err():
+			sm.lock.Lock()
+			if shared.closed {
+				// the last subscriber unsubscribed
+				sm.lock.Unlock()
+				return
+			}
+			subscribers := shared.subscribers
+			shared.subscribers = map[*MultiplexedSubscriber]struct{}{}
+			sm.closeShared(shared)
+			sm.lock.Unlock()
+			if err == nil {
+				err = UpstreamSubscriptionClosedError
+			}
+			utils.LavaFormatWarning("node subscription ended", err, &map[string]string{"subscriptionID": shared.upstreamID, "subscribers": strconv.Itoa(len(subscribers))})
+			for subscriber := range subscribers {
+				subscriber.close(err)
+			}
+			shared.upstream.Unsubscribe()
+			return
+		case notification := <-shared.replies:
+			var slow []*MultiplexedSubscriber
+			sm.lock.Lock()
+			for subscriber := range shared.subscribers {
+				select {
+				case subscriber.replies <- subscriber.notification(notification):
+				default:
+					delete(shared.subscribers, subscriber)
+					slow = append(slow, subscriber)
+				}
+			}
+			last := len(slow) > 0 && len(shared.subscribers) == 0
+			if last {
+				sm.closeShared(shared)
+			}
+			sm.lock.Unlock()
+			for _, subscriber := range slow {
+				subscriber.close(SlowSubscriberError)
+			}
+			if last {
+				shared.upstream.Unsubscribe()
+				return
+			}
+		}
+	}
+}
+
+// closeShared removes a shared subscription no subscriber can join anymore, must be called with the lock held
+func (sm *SubscriptionMultiplexer) closeShared(shared *sharedSubscription) {
+	shared.closed = true
+	if sm.subscriptions[shared.key] == shared {
+		delete(sm.subscriptions, shared.key)
+	}
+}
+
+// ID returns the subscription id of the consumer, unique per node subscription
+func (ms *MultiplexedSubscriber) ID() string {
+	return ms.id
+}
+
+// Replies returns the channel of the notifications of the subscription
+func (ms *MultiplexedSubscriber) Replies() <-chan interface{} {
+	return ms.replies
+}
+
+// Err returns the channel receiving the error the subscription ended with. it is closed when the subscription ends
+func (ms *MultiplexedSubscriber) Err() <-chan error {
+	return ms.err
+}
+
+// Unsubscribe leaves the shared subscription, the node subscription is closed with its last subscriber,
+// it can be called more than once
+func (ms *MultiplexedSubscriber) Unsubscribe() {
+	sm := ms.multiplexer
+	shared := ms.shared
+	sm.lock.Lock()
+	_, subscribed := shared.subscribers[ms]
+	delete(shared.subscribers, ms)
+	last := subscribed && len(shared.subscribers) == 0 && !shared.closed
+	if last {
+		sm.closeShared(shared)
+	}
+	sm.lock.Unlock()
+	ms.close(nil)
+	if last {
+		shared.upstream.Unsubscribe()
+	}
+}
+
+func (ms *MultiplexedSubscriber) close(err error) {
+	ms.closeOnce.Do(func() {
+		if err != nil {
+			ms.err <- err
+		}
+		close(ms.err)
+	})
+}
+
+// notification returns the node notification with the subscription id of the consumer
+func (ms *MultiplexedSubscriber) notification(notification interface{}) interface{} {
+	msg, ok := notification.(*rpcclient.JsonrpcMessage)
+	if !ok {
+		return notification
+	}
+	rewritten := *msg
+	if ms.shared.idInResult {
+		rewritten.Params = bytes.ReplaceAll(msg.Params, []byte(strconv.Quote(ms.shared.upstreamID)), []byte(strconv.Quote(ms.id)))
+	} else if msg.ID != nil && ms.requestID != nil {
+		// tendermint notifications carry the json rpc id of the subscribe request
+		rewritten.ID = ms.requestID
+	}
+	return &rewritten
+}
+
+func newSubscriberId() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		utils.LavaFormatFatal("failed generating subscription id", err, nil)
+	}
+	return "0x" + hex.EncodeToString(id)
+}
+
+// jsonRpcId returns the id of a json rpc request, nil when it has none
+func jsonRpcId(data []byte) json.RawMessage {
+	var request struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil
+	}
+	return request.ID
+}
@@ -0,0 +1,142 @@
+package chainproxy
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockUpstreamSubscription struct {
+	err          chan error
+	unsubscribed chan struct{}
+	once         sync.Once
+}
+
+func newMockUpstreamSubscription() *mockUpstreamSubscription {
+	return &mockUpstreamSubscription{err: make(chan error, 1), unsubscribed: make(chan struct{})}
+}
+
+func (m *mockUpstreamSubscription) Err() <-chan error {
+	return m.err
+}
+
+func (m *mockUpstreamSubscription) Unsubscribe() {
+	m.once.Do(func() {
+		close(m.unsubscribed)
+		close(m.err)
+	})
+}
+
+type mockNode struct {
+	lock       sync.Mutex
+	subscribes int
+	ch         chan interface{}
+	upstream   *mockUpstreamSubscription
+}
+
+func (mn *mockNode) subscribe(ch chan interface{}) (*pairingtypes.RelayReply, string, UpstreamSubscription, error) {
+	mn.lock.Lock()
+	defer mn.lock.Unlock()
+	mn.subscribes++
+	mn.ch = ch
+	mn.upstream = newMockUpstreamSubscription()
+	return &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","id":1,"result":"0xnode"}`)}, "0xnode", mn.upstream, nil
+}
+
+func (mn *mockNode) notify(t *testing.T, params string) {
+	select {
+	case mn.ch <- &rpcclient.JsonrpcMessage{Version: "2.0", Method: "eth_subscription", Params: json.RawMessage(params)}:
+	case <-time.After(time.Second):
+		require.Fail(t, "notification wasn't read")
+	}
+}
+
+func receiveNotification(t *testing.T, subscriber *MultiplexedSubscriber) string {
+	select {
+	case notification := <-subscriber.Replies():
+		data, err := json.Marshal(notification)
+		require.NoError(t, err)
+		return string(data)
+	case <-time.After(time.Second):
+		require.Fail(t, "notification wasn't received")
+	}
+	return ""
+}
+
+func TestSubscriptionMultiplexingKey(t *testing.T) {
+	first := SubscriptionMultiplexingKey("ETH1", "jsonrpc", "", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`))
+	second := SubscriptionMultiplexingKey("ETH1", "jsonrpc", "", []byte(`{"id":7,"jsonrpc":"2.0","method":"eth_subscribe","params":[ "newHeads" ]}`))
+	logs := SubscriptionMultiplexingKey("ETH1", "jsonrpc", "", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["logs"]}`))
+	require.Equal(t, first, second)
+	require.NotEqual(t, first, logs)
+}
+
+func TestSubscriptionMultiplexerSharesNodeSubscription(t *testing.T) {
+	var sm SubscriptionMultiplexer
+	node := &mockNode{}
+	key := "newHeads"
+	first, firstReply, err := sm.Subscribe(key, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`), node.subscribe)
+	require.NoError(t, err)
+	second, secondReply, err := sm.Subscribe(key, []byte(`{"jsonrpc":"2.0","id":2,"method":"eth_subscribe","params":["newHeads"]}`), node.subscribe)
+	require.NoError(t, err)
+	require.Equal(t, 1, node.subscribes)
+
+	// every consumer gets its own id
+	require.NotEqual(t, first.ID(), second.ID())
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"`+first.ID()+`"}`, string(firstReply.Data))
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":2,"result":"`+second.ID()+`"}`, string(secondReply.Data))
+
+	node.notify(t, `{"subscription":"0xnode","result":{"number":"0x1"}}`)
+	require.JSONEq(t, `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"`+first.ID()+`","result":{"number":"0x1"}}}`, receiveNotification(t, first))
+	require.JSONEq(t, `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"`+second.ID()+`","result":{"number":"0x1"}}}`, receiveNotification(t, second))
+
+	// the node subscription is closed with the last subscriber
+	first.Unsubscribe()
+	first.Unsubscribe()
+	_, open := <-first.Err()
+	require.False(t, open)
+	select {
+	case <-node.upstream.unsubscribed:
+		require.Fail(t, "node subscription closed while subscribed")
+	default:
+	}
+	node.notify(t, `{"subscription":"0xnode","result":{"number":"0x2"}}`)
+	require.Contains(t, receiveNotification(t, second), `"0x2"`)
+
+	second.Unsubscribe()
+	<-node.upstream.unsubscribed
+
+	// a new node subscription is opened for the next subscriber
+	_, _, err = sm.Subscribe(key, []byte(`{"jsonrpc":"2.0","id":3,"method":"eth_subscribe","params":["newHeads"]}`), node.subscribe)
+	require.NoError(t, err)
+	require.Equal(t, 2, node.subscribes)
+}
+
+func TestSubscriptionMultiplexerNodeFailure(t *testing.T) {
+	var sm SubscriptionMultiplexer
+	node := &mockNode{}
+	subscriber, _, err := sm.Subscribe("newHeads", []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`), node.subscribe)
+	require.NoError(t, err)
+
+	nodeErr := errors.New("node disconnected")
+	node.upstream.err <- nodeErr
+	select {
+	case err := <-subscriber.Err():
+		require.Equal(t, nodeErr, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "subscriber wasn't closed")
+	}
+	subscriber.Unsubscribe() // after the node subscription ended
+
+	failed := errors.New("subscribe failed")
+	_, _, err = sm.Subscribe("newHeads", nil, func(ch chan interface{}) (*pairingtypes.RelayReply, string, UpstreamSubscription, error) {
+		return nil, "", nil, failed
+	})
+	require.Equal(t, failed, err)
+}
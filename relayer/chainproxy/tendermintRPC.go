@@ -8,7 +8,6 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/btcsuite/btcd/btcec"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/favicon"
 	"github.com/gofiber/websocket/v2"
@@ -16,6 +15,8 @@ import (
 	"github.com/lavanet/lava/relayer/lavasession"
 	"github.com/lavanet/lava/relayer/parser"
 	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -44,6 +45,11 @@ func (m TendemintRpcMessage) ParseBlock(inp string) (int64, error) {
 }
 
 func (cp *tendermintRpcChainProxy) FetchLatestBlockNum(ctx context.Context) (int64, error) {
+	return cp.fetchLatestBlockNumFromNode(ctx, "")
+}
+
+// fetches the latest block from a specific node, or from any node when nodeUrl is empty
+func (cp *tendermintRpcChainProxy) fetchLatestBlockNumFromNode(ctx context.Context, nodeUrl string) (int64, error) {
 	serviceApi, ok := cp.GetSentry().GetSpecApiByTag(spectypes.GET_BLOCKNUM)
 	if !ok {
 		return spectypes.NOT_APPLICABLE, errors.New(spectypes.GET_BLOCKNUM + " tag function not found")
@@ -54,10 +60,11 @@ func (cp *tendermintRpcChainProxy) FetchLatestBlockNum(ctx context.Context) (int
 	if err != nil {
 		return spectypes.NOT_APPLICABLE, err
 	}
+	nodeMsg.nodeUrl = nodeUrl
 
 	_, _, _, err = nodeMsg.Send(ctx, nil)
 	if err != nil {
-		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Error On Send FetchLatestBlockNum", err, &map[string]string{"nodeUrl": cp.nodeUrl})
+		return spectypes.NOT_APPLICABLE, utils.LavaFormatError("Error On Send FetchLatestBlockNum", err, &map[string]string{"nodeUrl": nodeUrl})
 	}
 
 	msgParsed, ok := nodeMsg.GetMsg().(*JsonrpcMessage)
@@ -85,7 +92,7 @@ func (cp *tendermintRpcChainProxy) FetchBlockHashByNum(ctx context.Context, bloc
 	var nodeMsg NodeMessage
 	var err error
 	if serviceApi.GetParsing().FunctionTemplate != "" {
-		nodeMsg, err = cp.ParseMsg("", []byte(fmt.Sprintf(serviceApi.Parsing.FunctionTemplate, blockNum)), http.MethodGet)
+		nodeMsg, err = cp.ParseMsg("", []byte(fmt.Sprintf(serviceApi.Parsing.FunctionTemplate, blockNum)), http.MethodGet, nil)
 	} else {
 		params := make([]interface{}, 0)
 		params = append(params, blockNum)
@@ -98,7 +105,7 @@ func (cp *tendermintRpcChainProxy) FetchBlockHashByNum(ctx context.Context, bloc
 
 	_, _, _, err = nodeMsg.Send(ctx, nil)
 	if err != nil {
-		return "", utils.LavaFormatError("Error On Send FetchBlockHashByNum", err, &map[string]string{"nodeUrl": cp.nodeUrl})
+		return "", utils.LavaFormatError("Error On Send FetchBlockHashByNum", err, &map[string]string{"nodes": cp.nodes.String()})
 	}
 
 	msg, ok := nodeMsg.GetMsg().(*JsonrpcMessage)
@@ -108,7 +115,7 @@ func (cp *tendermintRpcChainProxy) FetchBlockHashByNum(ctx context.Context, bloc
 	blockData, err := parser.ParseMessageResponse(msg, serviceApi.Parsing.ResultParsing)
 	if err != nil {
 		return "", utils.LavaFormatError("Failed To Parse FetchLatestBlockNum", err, &map[string]string{
-			"nodeUrl":  cp.nodeUrl,
+			"nodes":    cp.nodes.String(),
 			"Method":   msg.Method,
 			"Response": string(msg.Result),
 		})
@@ -124,11 +131,13 @@ func (cp *tendermintRpcChainProxy) FetchBlockHashByNum(ctx context.Context, bloc
 	return hash, nil
 }
 
-func NewtendermintRpcChainProxy(nodeUrl string, nConns uint, sentry *sentry.Sentry, csm *lavasession.ConsumerSessionManager, pLogs *PortalLogs) ChainProxy {
+func NewtendermintRpcChainProxy(nodes *NodeSet, nConns uint, maxConns uint, sentry *sentry.Sentry, csm *lavasession.ConsumerSessionManager, pLogs *PortalLogs) ChainProxy {
 	return &tendermintRpcChainProxy{
 		JrpcChainProxy: JrpcChainProxy{
-			nodeUrl:    nodeUrl,
+			conns:      map[string]*Connector{},
+			nodes:      nodes,
 			nConns:     nConns,
+			maxConns:   maxConns,
 			sentry:     sentry,
 			portalLogs: pLogs,
 			csm:        csm,
@@ -136,6 +145,15 @@ func NewtendermintRpcChainProxy(nodeUrl string, nConns uint, sentry *sentry.Sent
 	}
 }
 
+func (cp *tendermintRpcChainProxy) Start(ctx context.Context) error {
+	err := cp.startConnectors(ctx)
+	if err != nil {
+		return err
+	}
+	go cp.nodes.HealthCheckLoop(ctx, getNodeHealthInterval(cp.sentry.GetAverageBlockTime()), cp.fetchLatestBlockNumFromNode)
+	return nil
+}
+
 func (cp *tendermintRpcChainProxy) newMessage(serviceApi *spectypes.ServiceApi, requestedBlock int64, params []interface{}, connectionType string) (*TendemintRpcMessage, error) {
 	var apiInterface *spectypes.ApiInterface = nil
 	for i := range serviceApi.ApiInterfaces {
@@ -165,7 +183,7 @@ func (cp *tendermintRpcChainProxy) newMessage(serviceApi *spectypes.ServiceApi,
 	return nodeMsg, nil
 }
 
-func (cp *tendermintRpcChainProxy) ParseMsg(path string, data []byte, connectionType string) (NodeMessage, error) {
+func (cp *tendermintRpcChainProxy) ParseMsg(path string, data []byte, connectionType string, metadata []pairingtypes.Metadata) (NodeMessage, error) {
 	// connectionType is currently only used only in rest api
 	// Unmarshal request
 	var msg JsonrpcMessage
@@ -233,13 +251,14 @@ func (cp *tendermintRpcChainProxy) ParseMsg(path string, data []byte, connection
 			serviceApi:   serviceApi,
 			apiInterface: apiInterface,
 			msg:          &msg, requestedBlock: requestedBlock,
+			metadata: requestMetadata(serviceApi, metadata),
 		},
 		cp: cp,
 	}
 	return nodeMsg, nil
 }
 
-func (cp *tendermintRpcChainProxy) PortalStart(ctx context.Context, privKey *btcec.PrivateKey, listenAddr string) {
+func (cp *tendermintRpcChainProxy) PortalStart(ctx context.Context, signer sigs.Signer, listenAddr string) {
 	//
 	// Setup HTTP Server
 	app := fiber.New(fiber.Config{})
@@ -272,44 +291,66 @@ func (cp *tendermintRpcChainProxy) PortalStart(ctx context.Context, privKey *btc
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel() // incase there's a problem make sure to cancel the connection
-			dappID := ExtractDappIDFromWebsocketConnection(c)
-			reply, replyServer, err := SendRelay(ctx, cp, privKey, "", string(msg), http.MethodGet, dappID)
+			dappID, err := authorizeDappRelay(cp, extractApiKeyFromWebsocketConnection(c), ExtractDappIDFromWebsocketConnection(c), "", string(msg), http.MethodGet)
 			if err != nil {
+				c.WriteMessage(mt, jsonRpcDappError(err))
+				continue
+			}
+			selection, envelope := extractProvenanceOptionsFromWebsocketConnection(c)
+			streamCtx, cancelStream := context.WithCancel(ctx) // a failed over subscription closes only its stream
+			relayCtx, span := StartPortalSpan(streamCtx, cp, "tendermint-WebSocket")
+			reply, replyServer, provenance, err := SendRelay(relayCtx, cp, signer, "", string(msg), http.MethodGet, dappID, c.RemoteAddr().String(), nil, selection)
+			tracing.RecordError(span, err)
+			span.End()
+			if err != nil {
+				cancelStream()
 				cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, "tendermint")
 				continue
 			}
 
 			// If subscribe the first reply would contain the RPC ID that can be used for disconnect.
 			if replyServer != nil {
-				var reply pairingtypes.RelayReply
-				err = (*replyServer).RecvMsg(&reply) // this reply contains the RPC ID
+				// the subscription is resubscribed with another provider when its stream fails
+				subscription := newFailoverSubscription(ctx, cp, signer, string(msg), dappID, c.RemoteAddr().String(), selection, *replyServer, cancelStream, provenance)
+				reply, err := subscription.Recv() // this reply contains the RPC ID
 				if err != nil {
+					subscription.Close()
 					cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, "tendermint")
 					continue
 				}
 
-				if err = c.WriteMessage(mt, reply.Data); err != nil {
+				if err = c.WriteMessage(mt, websocketReplyData(reply.Data, subscription.Provenance(), envelope)); err != nil {
+					subscription.Close()
 					cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, "tendermint")
 					continue
 				}
 				cp.portalLogs.LogRequestAndResponse("tendermint ws", false, "ws", c.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, nil)
+				// the compute units the subscription accrues are charged on the dapp limits as well
+				dappCharge := newDappSubscriptionCharge(cp, extractApiKeyFromWebsocketConnection(c), string(msg), http.MethodGet)
 				for {
-					err = (*replyServer).RecvMsg(&reply)
+					reply, err = subscription.Recv()
 					if err != nil {
 						cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, "tendermint")
 						break
 					}
+					if err = dappCharge.onMessage(); err != nil {
+						c.WriteMessage(mt, jsonRpcDappError(err))
+						break
+					}
 
 					// If portal cant write to the client
-					if err = c.WriteMessage(mt, reply.Data); err != nil {
+					if err = c.WriteMessage(mt, websocketReplyData(reply.Data, subscription.Provenance(), envelope)); err != nil {
 						cancel()
 						cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, "tendermint")
 						// break
 					}
 					cp.portalLogs.LogRequestAndResponse("tendermint ws", false, "ws", c.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, nil)
 				}
+				subscription.Close()
+				dappCharge.charge() // the time since the last message
 			} else {
-				if err = c.WriteMessage(mt, reply.Data); err != nil {
+				cancelStream()
+				if err = c.WriteMessage(mt, websocketReplyData(reply.Data, provenance, envelope)); err != nil {
 					cp.portalLogs.AnalyzeWebSocketErrorAndWriteMessage(c, mt, err, msgSeed, msg, "tendermint")
 					continue
 				}
@@ -317,16 +358,23 @@ func (cp *tendermintRpcChainProxy) PortalStart(ctx context.Context, privKey *btc
 			}
 		}
 	})
-	websocketCallbackWithDappID := ConstructFiberCallbackWithDappIDExtraction(webSocketCallback)
+	websocketCallbackWithDappID := ConstructFiberCallbackWithDappIDExtraction(webSocketCallback, cp.dapps)
 	app.Get("/ws/:dappId", websocketCallbackWithDappID)
 	app.Get("/:dappId/websocket", websocketCallbackWithDappID) // catching http://ip:port/1/websocket requests.
 
 	app.Post("/:dappId/*", func(c *fiber.Ctx) error {
 		cp.portalLogs.LogStartTransaction("tendermint-WebSocket")
 		msgSeed := cp.portalLogs.GetMessageSeed()
+		ctx, span := StartPortalSpan(ctx, cp, "tendermint-http post")
+		defer span.End()
 		dappID := ExtractDappIDFromFiberContext(c)
 		utils.LavaFormatInfo("in <<<", &map[string]string{"seed": msgSeed, "msg": string(c.Body()), "dappID": dappID})
-		reply, _, err := SendRelay(ctx, cp, privKey, "", string(c.Body()), http.MethodGet, dappID)
+		dappID, err := authorizeDappRelay(cp, c.Params("dappId"), dappID, "", string(c.Body()), http.MethodGet)
+		if err != nil {
+			return sendJsonRpcDappError(c, err)
+		}
+		reply, _, provenance, err := SendRelay(ctx, cp, signer, "", string(c.Body()), http.MethodGet, dappID, c.IP(), metadataFromFiberRequest(c), providerSelectionFromFiberRequest(c, cp, c.Params("dappId")))
+		tracing.RecordError(span, err)
 		if err != nil {
 			errMasking := cp.portalLogs.GetUniqueGuidResponseForError(err, msgSeed)
 			cp.portalLogs.LogRequestAndResponse("tendermint http in/out", true, "POST", c.Request().URI().String(), string(c.Body()), errMasking, msgSeed, err)
@@ -334,6 +382,8 @@ func (cp *tendermintRpcChainProxy) PortalStart(ctx context.Context, privKey *btc
 			return c.SendString(fmt.Sprintf(`{"error": "unsupported api","more_information": %s}`, errMasking))
 		}
 		cp.portalLogs.LogRequestAndResponse("tendermint http in/out", false, "POST", c.Request().URI().String(), string(c.Body()), string(reply.Data), msgSeed, nil)
+		setFiberReplyHeaders(c, reply.Metadata)
+		setFiberProvenanceHeaders(c, provenance)
 		return c.SendString(string(reply.Data))
 	})
 
@@ -348,8 +398,15 @@ func (cp *tendermintRpcChainProxy) PortalStart(ctx context.Context, privKey *btc
 			dappID = strings.ReplaceAll(dappID, "*", "")
 		}
 		msgSeed := cp.portalLogs.GetMessageSeed()
+		ctx, span := StartPortalSpan(ctx, cp, "tendermint-uri")
+		defer span.End()
 		utils.LavaFormatInfo("urirpc in <<<", &map[string]string{"seed": msgSeed, "msg": path, "dappID": dappID})
-		reply, _, err := SendRelay(ctx, cp, privKey, path+query, "", http.MethodGet, dappID)
+		dappID, err := authorizeDappRelay(cp, c.Params("dappId"), dappID, path+query, "", http.MethodGet)
+		if err != nil {
+			return sendJsonRpcDappError(c, err)
+		}
+		reply, _, provenance, err := SendRelay(ctx, cp, signer, path+query, "", http.MethodGet, dappID, c.IP(), metadataFromFiberRequest(c), providerSelectionFromFiberRequest(c, cp, c.Params("dappId")))
+		tracing.RecordError(span, err)
 		if err != nil {
 			errMasking := cp.portalLogs.GetUniqueGuidResponseForError(err, msgSeed)
 			cp.portalLogs.LogRequestAndResponse("tendermint http in/out", true, "GET", c.Request().URI().String(), "", errMasking, msgSeed, err)
@@ -360,6 +417,8 @@ func (cp *tendermintRpcChainProxy) PortalStart(ctx context.Context, privKey *btc
 			return c.SendString(fmt.Sprintf(`{"error": "unsupported api","more_information": %s}`, errMasking))
 		}
 		cp.portalLogs.LogRequestAndResponse("tendermint http in/out", false, "GET", c.Request().URI().String(), "", string(reply.Data), msgSeed, nil)
+		setFiberReplyHeaders(c, reply.Metadata)
+		setFiberProvenanceHeaders(c, provenance)
 		return c.SendString(string(reply.Data))
 	})
 	//
@@ -371,12 +430,44 @@ func (cp *tendermintRpcChainProxy) PortalStart(ctx context.Context, privKey *btc
 }
 
 func (nm *TendemintRpcMessage) Send(ctx context.Context, ch chan interface{}) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	err = errors.New("no nodes to send the message to")
+	for _, nodeUrl := range nm.cp.nodeUrlsForMessage(nm.nodeUrl, nm.requestedBlock) {
+		var nodeErr error
+		relayReply, subscriptionID, relayReplyServer, nodeErr, err = nm.sendToNode(ctx, ch, nodeUrl)
+		nm.cp.nodes.OnNodeResult(nodeUrl, nodeErr)
+		if nodeErr == nil {
+			return relayReply, subscriptionID, relayReplyServer, err
+		}
+		if !canRetryOnAnotherNode(nm.apiInterface) {
+			break
+		}
+		utils.LavaFormatWarning("node failed to respond, trying the next node", nodeErr, &map[string]string{"nodeUrl": nodeUrl})
+	}
+	return relayReply, subscriptionID, relayReplyServer, err
+}
+
+func (nm *TendemintRpcMessage) sendToNode(ctx context.Context, ch chan interface{}, nodeUrl string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, nodeErr error, err error) {
 	// Get node
-	rpc, err := nm.cp.conn.GetRpc(true)
+	conn, err := nm.cp.getConnector(nodeUrl)
+	if err != nil {
+		return nil, "", nil, err, err
+	}
+	connectCtx, cancel := context.WithTimeout(ctx, getTimePerCu(nm.serviceApi.ComputeUnits))
+	defer cancel()
+	rpc, err := conn.GetRpc(connectCtx, true)
 	if err != nil {
-		return nil, "", nil, err
+		if ctx.Err() == nil {
+			nodeErr = err
+		}
+		return nil, "", nil, nodeErr, err
 	}
-	defer nm.cp.conn.ReturnRpc(rpc)
+	defer func() {
+		if nodeErr != nil {
+			conn.DiscardRpc(rpc)
+			return
+		}
+		conn.ReturnRpc(rpc)
+	}()
 
 	params := nm.msg.Params
 
@@ -384,12 +475,15 @@ func (nm *TendemintRpcMessage) Send(ctx context.Context, ch chan interface{}) (r
 	var rpcMessage *rpcclient.JsonrpcMessage
 	var replyMessage *JsonrpcMessage
 	var sub *rpcclient.ClientSubscription
+	// headers only apply to http nodes
+	callCtx, replyHeaders := rpcclient.NewContextWithReplyHeaders(rpcclient.NewContextWithHeaders(connectCtx, metadataToHttpHeader(nm.metadata)))
 	if ch != nil {
 		sub, rpcMessage, err = rpc.Subscribe(context.Background(), nm.msg.ID, nm.msg.Method, ch, nm.msg.Params)
 	} else {
-		connectCtx, cancel := context.WithTimeout(ctx, getTimePerCu(nm.serviceApi.ComputeUnits))
-		defer cancel()
-		rpcMessage, err = rpc.CallContext(connectCtx, nm.msg.ID, nm.msg.Method, nm.msg.Params)
+		rpcMessage, err = rpc.CallContext(callCtx, nm.msg.ID, nm.msg.Method, nm.msg.Params)
+	}
+	if isJrpcNodeFailure(ctx, err) {
+		nodeErr = err
 	}
 
 	var replyMsg JsonrpcMessage
@@ -406,7 +500,7 @@ func (nm *TendemintRpcMessage) Send(ctx context.Context, ch chan interface{}) (r
 	} else {
 		replyMessage, err = convertMsg(rpcMessage)
 		if err != nil {
-			return nil, "", nil, utils.LavaFormatError("tendermingRPC error", err, nil)
+			return nil, "", nil, nodeErr, utils.LavaFormatError("tendermingRPC error", err, nil)
 		}
 
 		nm.msg = replyMessage
@@ -416,26 +510,27 @@ func (nm *TendemintRpcMessage) Send(ctx context.Context, ch chan interface{}) (r
 	data, err := json.Marshal(replyMsg)
 	if err != nil {
 		nm.msg.Result = []byte(fmt.Sprintf("%s", err))
-		return nil, "", nil, err
+		return nil, "", nil, nodeErr, err
 	}
 
 	reply := &pairingtypes.RelayReply{
-		Data: data,
+		Data:     data,
+		Metadata: replyMetadata(nm.serviceApi, replyHeaders),
 	}
 
 	if ch != nil {
 		paramsMap, ok := params.(map[string]interface{})
 		if !ok {
-			return nil, "", nil, utils.LavaFormatError("unknown params type on tendermint subscribe", nil, nil)
+			return nil, "", nil, nodeErr, utils.LavaFormatError("unknown params type on tendermint subscribe", nil, nil)
 		}
 		subscriptionID, ok = paramsMap["query"].(string)
 		if !ok {
-			return nil, "", nil, utils.LavaFormatError("unknown subscriptionID type on tendermint subscribe", nil, nil)
+			return nil, "", nil, nodeErr, utils.LavaFormatError("unknown subscriptionID type on tendermint subscribe", nil, nil)
 		}
 	}
 	if replyMsg.Error != nil {
-		return reply, "", nil, utils.LavaFormatError(replyMsg.Error.Message, nil, nil)
+		return reply, "", nil, nodeErr, utils.LavaFormatError(replyMsg.Error.Message, nil, nil)
 	}
 
-	return reply, subscriptionID, sub, err
+	return reply, subscriptionID, sub, nodeErr, err
 }
@@ -1,76 +0,0 @@
-package cosmos_thirdparty
-
-import (
-	"context"
-	"encoding/json"
-
-	pb_pkg "cosmossdk.io/api/cosmos/authz/v1beta1"
-	"github.com/golang/protobuf/proto"
-	"github.com/lavanet/lava/utils"
-)
-
-type implementedCosmosAuthzV1beta1 struct {
-	pb_pkg.UnimplementedQueryServer
-	cb func(ctx context.Context, method string, reqBody []byte) ([]byte, error)
-}
-
-// this line is used by grpc_scaffolder #implementedCosmosAuthzV1beta1
-
-func (is *implementedCosmosAuthzV1beta1) Grants(ctx context.Context, req *pb_pkg.QueryGrantsRequest) (*pb_pkg.QueryGrantsResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.authz.v1beta1.Query/Grants", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryGrantsResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosAuthzV1beta1) GranteeGrants(ctx context.Context, req *pb_pkg.QueryGranteeGrantsRequest) (*pb_pkg.QueryGranteeGrantsResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.authz.v1beta1.Query/GranteeGrants", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryGranteeGrantsResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosAuthzV1beta1) GranterGrants(ctx context.Context, req *pb_pkg.QueryGranterGrantsRequest) (*pb_pkg.QueryGranterGrantsResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.authz.v1beta1.Query/GranterGrants", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryGranterGrantsResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-// this line is used by grpc_scaffolder #Methods
@@ -1,190 +0,0 @@
-package cosmos_thirdparty
-
-import (
-	"context"
-	"encoding/json"
-
-	pb_pkg "cosmossdk.io/api/cosmos/distribution/v1beta1"
-	"github.com/golang/protobuf/proto"
-	"github.com/lavanet/lava/utils"
-)
-
-type implementedCosmosDistributionV1beta1 struct {
-	pb_pkg.UnimplementedQueryServer
-	cb func(ctx context.Context, method string, reqBody []byte) ([]byte, error)
-}
-
-// this line is used by grpc_scaffolder #implementedCosmosDistributionV1beta1
-
-func (is *implementedCosmosDistributionV1beta1) CommunityPool(ctx context.Context, req *pb_pkg.QueryCommunityPoolRequest) (*pb_pkg.QueryCommunityPoolResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.distribution.v1beta1.Query/CommunityPool", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryCommunityPoolResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosDistributionV1beta1) DelegationRewards(ctx context.Context, req *pb_pkg.QueryDelegationRewardsRequest) (*pb_pkg.QueryDelegationRewardsResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.distribution.v1beta1.Query/DelegationRewards", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryDelegationRewardsResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosDistributionV1beta1) DelegationTotalRewards(ctx context.Context, req *pb_pkg.QueryDelegationTotalRewardsRequest) (*pb_pkg.QueryDelegationTotalRewardsResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.distribution.v1beta1.Query/DelegationTotalRewards", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryDelegationTotalRewardsResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosDistributionV1beta1) DelegatorValidators(ctx context.Context, req *pb_pkg.QueryDelegatorValidatorsRequest) (*pb_pkg.QueryDelegatorValidatorsResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.distribution.v1beta1.Query/DelegatorValidators", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryDelegatorValidatorsResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosDistributionV1beta1) DelegatorWithdrawAddress(ctx context.Context, req *pb_pkg.QueryDelegatorWithdrawAddressRequest) (*pb_pkg.QueryDelegatorWithdrawAddressResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryDelegatorWithdrawAddressResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosDistributionV1beta1) Params(ctx context.Context, req *pb_pkg.QueryParamsRequest) (*pb_pkg.QueryParamsResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.distribution.v1beta1.Query/Params", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryParamsResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosDistributionV1beta1) ValidatorCommission(ctx context.Context, req *pb_pkg.QueryValidatorCommissionRequest) (*pb_pkg.QueryValidatorCommissionResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.distribution.v1beta1.Query/ValidatorCommission", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryValidatorCommissionResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosDistributionV1beta1) ValidatorOutstandingRewards(ctx context.Context, req *pb_pkg.QueryValidatorOutstandingRewardsRequest) (*pb_pkg.QueryValidatorOutstandingRewardsResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryValidatorOutstandingRewardsResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-func (is *implementedCosmosDistributionV1beta1) ValidatorSlashes(ctx context.Context, req *pb_pkg.QueryValidatorSlashesRequest) (*pb_pkg.QueryValidatorSlashesResponse, error) {
-	reqMarshaled, err := json.Marshal(req)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Marshal(req)", err, nil)
-	}
-	res, err := is.cb(ctx, "cosmos.distribution.v1beta1.Query/ValidatorSlashes", reqMarshaled)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to SendRelay cb", err, nil)
-	}
-	result := &pb_pkg.QueryValidatorSlashesResponse{}
-	err = proto.Unmarshal(res, result)
-	if err != nil {
-		return nil, utils.LavaFormatError("Failed to proto.Unmarshal", err, nil)
-	}
-	return result, nil
-}
-
-// this line is used by grpc_scaffolder #Method
-
-// this line is used by grpc_scaffolder #Methods
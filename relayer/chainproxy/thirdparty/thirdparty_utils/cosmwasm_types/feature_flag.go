@@ -1,4 +0,0 @@
-package types
-
-// Tests should not fail on gas consumption
-const EnableGasVerification = true
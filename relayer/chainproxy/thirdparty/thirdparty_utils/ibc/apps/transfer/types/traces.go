@@ -1,3 +0,0 @@
-package types
-
-type Traces []DenomTrace
@@ -1,6 +0,0 @@
-package types
-
-type (
-	IdentifiedClientStates []IdentifiedClientState
-	ClientsConsensusStates []ClientConsensusStates
-)
@@ -1,13 +0,0 @@
-package types
-
-import (
-	"github.com/cosmos/cosmos-sdk/codec"
-	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
-)
-
-var amino = codec.NewLegacyAmino()
-
-func init() {
-	cryptocodec.RegisterCrypto(amino)
-	amino.Seal()
-}
@@ -1,9 +0,0 @@
-package types
-
-// Minting module event types
-const (
-	EventTypeMint = ModuleName
-
-	AttributeKeyInflation        = "inflation"
-	AttributeKeyAnnualProvisions = "annual_provisions"
-)
@@ -1,702 +0,0 @@
-// Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: juno/mint/mint.proto
-
-package types
-
-import (
-	fmt "fmt"
-	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/gogo/protobuf/gogoproto"
-	proto "github.com/gogo/protobuf/proto"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
-
-// Minter represents the minting state.
-type Minter struct {
-	// current annual inflation rate
-	Inflation       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
-	Phase           uint64                                 `protobuf:"varint,2,opt,name=phase,proto3" json:"phase,omitempty"`
-	StartPhaseBlock uint64                                 `protobuf:"varint,3,opt,name=start_phase_block,json=startPhaseBlock,proto3" json:"start_phase_block,omitempty" yaml:"start_phase_block"`
-	// current annual expected provisions
-	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions" yaml:"annual_provisions"`
-	TargetSupply     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=target_supply,json=targetSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_supply" yaml:"target_supply"`
-}
-
-func (m *Minter) Reset()         { *m = Minter{} }
-func (m *Minter) String() string { return proto.CompactTextString(m) }
-func (*Minter) ProtoMessage()    {}
-func (*Minter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0bccce3b583aa44, []int{0}
-}
-func (m *Minter) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Minter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Minter.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Minter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Minter.Merge(m, src)
-}
-func (m *Minter) XXX_Size() int {
-	return m.Size()
-}
-func (m *Minter) XXX_DiscardUnknown() {
-	xxx_messageInfo_Minter.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Minter proto.InternalMessageInfo
-
-func (m *Minter) GetPhase() uint64 {
-	if m != nil {
-		return m.Phase
-	}
-	return 0
-}
-
-func (m *Minter) GetStartPhaseBlock() uint64 {
-	if m != nil {
-		return m.StartPhaseBlock
-	}
-	return 0
-}
-
-// Params holds parameters for the mint module.
-type Params struct {
-	// type of coin to mint
-	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
-	// expected blocks per year
-	BlocksPerYear uint64 `protobuf:"varint,2,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
-}
-
-func (m *Params) Reset()      { *m = Params{} }
-func (*Params) ProtoMessage() {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0bccce3b583aa44, []int{1}
-}
-func (m *Params) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
-}
-func (m *Params) XXX_Size() int {
-	return m.Size()
-}
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Params proto.InternalMessageInfo
-
-func (m *Params) GetMintDenom() string {
-	if m != nil {
-		return m.MintDenom
-	}
-	return ""
-}
-
-func (m *Params) GetBlocksPerYear() uint64 {
-	if m != nil {
-		return m.BlocksPerYear
-	}
-	return 0
-}
-
-func init() {
-	proto.RegisterType((*Minter)(nil), "juno.mint.Minter")
-	proto.RegisterType((*Params)(nil), "juno.mint.Params")
-}
-
-func init() { proto.RegisterFile("juno/mint/mint.proto", fileDescriptor_e0bccce3b583aa44) }
-
-var fileDescriptor_e0bccce3b583aa44 = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0xae, 0xd3, 0x30,
-	0x14, 0x86, 0x13, 0x6e, 0x6f, 0xa5, 0x58, 0x5c, 0x95, 0x5a, 0x11, 0x8a, 0x2a, 0x48, 0xaa, 0x0c,
-	0xa8, 0x03, 0x24, 0x03, 0x5b, 0xc7, 0xb4, 0xaa, 0x00, 0x81, 0x14, 0x85, 0x09, 0x96, 0xc8, 0x4d,
-	0x4d, 0x1a, 0x9a, 0xd8, 0xc1, 0x76, 0x80, 0xbc, 0x05, 0x23, 0x03, 0x03, 0x8f, 0xd3, 0xb1, 0x23,
-	0x62, 0x88, 0x50, 0xfb, 0x06, 0x7d, 0x02, 0x64, 0x1b, 0x51, 0xb8, 0x9d, 0xba, 0x24, 0xfe, 0x3f,
-	0xfd, 0x3a, 0xbf, 0x8f, 0xcf, 0x01, 0xf6, 0xfb, 0x86, 0xd0, 0xb0, 0x2a, 0x88, 0x50, 0x9f, 0xa0,
-	0x66, 0x54, 0x50, 0x68, 0x49, 0x1a, 0x48, 0x30, 0xb2, 0x73, 0x9a, 0x53, 0x45, 0x43, 0x79, 0xd2,
-	0x06, 0xff, 0xdb, 0x15, 0xe8, 0xbf, 0x2a, 0x88, 0xc0, 0x0c, 0xbe, 0x04, 0x56, 0x41, 0xde, 0x95,
-	0x48, 0x14, 0x94, 0x38, 0xe6, 0xd8, 0x9c, 0x58, 0x51, 0xb0, 0xed, 0x3c, 0xe3, 0x67, 0xe7, 0x3d,
-	0xca, 0x0b, 0xb1, 0x6e, 0x96, 0x41, 0x46, 0xab, 0x30, 0xa3, 0xbc, 0xa2, 0xfc, 0xcf, 0xef, 0x09,
-	0x5f, 0x6d, 0x42, 0xd1, 0xd6, 0x98, 0x07, 0x73, 0x9c, 0x25, 0xa7, 0x02, 0xd0, 0x06, 0xd7, 0xf5,
-	0x1a, 0x71, 0xec, 0xdc, 0x19, 0x9b, 0x93, 0x5e, 0xa2, 0x05, 0x7c, 0x06, 0x86, 0x5c, 0x20, 0x26,
-	0x52, 0x25, 0xd3, 0x65, 0x49, 0xb3, 0x8d, 0x73, 0x25, 0x1d, 0xd1, 0x83, 0x63, 0xe7, 0x39, 0x2d,
-	0xaa, 0xca, 0xa9, 0x7f, 0x66, 0xf1, 0x93, 0x81, 0x62, 0xb1, 0x44, 0x91, 0x24, 0xf0, 0x13, 0x18,
-	0x22, 0x42, 0x1a, 0x54, 0xa6, 0x35, 0xa3, 0x1f, 0x0b, 0x5e, 0x50, 0xc2, 0x9d, 0x9e, 0xba, 0xf5,
-	0x8b, 0xcb, 0x6e, 0x7d, 0xca, 0x3d, 0x2b, 0xe8, 0x27, 0xf7, 0x34, 0x8b, 0xff, 0x22, 0xb8, 0x01,
-	0x37, 0x02, 0xb1, 0x1c, 0x8b, 0x94, 0x37, 0x75, 0x5d, 0xb6, 0xce, 0xb5, 0x0a, 0x5d, 0x5c, 0x10,
-	0xfa, 0x9c, 0x88, 0x63, 0xe7, 0xd9, 0x3a, 0xf4, 0xbf, 0x62, 0x7e, 0x72, 0x57, 0xeb, 0xd7, 0x5a,
-	0x7e, 0x00, 0xfd, 0x18, 0x31, 0x54, 0x71, 0xf8, 0x10, 0x00, 0x39, 0xc6, 0x74, 0x85, 0x09, 0xad,
-	0xf4, 0x78, 0x12, 0x4b, 0x92, 0xb9, 0x04, 0x30, 0x02, 0x03, 0xf5, 0x52, 0x3c, 0xad, 0x31, 0x4b,
-	0x5b, 0x8c, 0x98, 0x7e, 0xf8, 0x68, 0x74, 0xec, 0xbc, 0xfb, 0x3a, 0xe9, 0x96, 0xc1, 0x4f, 0x6e,
-	0x34, 0x89, 0x31, 0x7b, 0x83, 0x11, 0x9b, 0xf6, 0xbe, 0x7e, 0xf7, 0x8c, 0x68, 0xb1, 0xdd, 0xbb,
-	0xe6, 0x6e, 0xef, 0x9a, 0xbf, 0xf6, 0xae, 0xf9, 0xe5, 0xe0, 0x1a, 0xbb, 0x83, 0x6b, 0xfc, 0x38,
-	0xb8, 0xc6, 0xdb, 0xc7, 0xff, 0xb4, 0x36, 0x53, 0x3d, 0xcd, 0x28, 0x11, 0x0c, 0x65, 0x82, 0x87,
-	0x6a, 0xfb, 0x3e, 0xeb, 0xfd, 0x53, 0x4d, 0x2e, 0xfb, 0x6a, 0xc1, 0x9e, 0xfe, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xcf, 0xa2, 0xd3, 0xd4, 0x99, 0x02, 0x00, 0x00,
-}
-
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TargetSupply.Size()
-		i -= size
-		if _, err := m.TargetSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.AnnualProvisions.Size()
-		i -= size
-		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.StartPhaseBlock != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.StartPhaseBlock))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Phase != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.Phase))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlocksPerYear != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.Phase != 0 {
-		n += 1 + sovMint(uint64(m.Phase))
-	}
-	if m.StartPhaseBlock != 0 {
-		n += 1 + sovMint(uint64(m.StartPhaseBlock))
-	}
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.TargetSupply.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
-	}
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Minter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMint
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			m.Phase = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Phase |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartPhaseBlock", wireType)
-			}
-			m.StartPhaseBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartPhaseBlock |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMint(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMint
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMint
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
-			}
-			m.BlocksPerYear = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMint(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMint
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func skipMint(dAtA []byte) (n int, err error) {
-	l := len(dAtA)
-	iNdEx := 0
-	depth := 0
-	for iNdEx < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return 0, ErrIntOverflowMint
-			}
-			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-		case 1:
-			iNdEx += 8
-		case 2:
-			var length int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowMint
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				length |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if length < 0 {
-				return 0, ErrInvalidLengthMint
-			}
-			iNdEx += length
-		case 3:
-			depth++
-		case 4:
-			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroupMint
-			}
-			depth--
-		case 5:
-			iNdEx += 4
-		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
-		}
-		if iNdEx < 0 {
-			return 0, ErrInvalidLengthMint
-		}
-		if depth == 0 {
-			return iNdEx, nil
-		}
-	}
-	return 0, io.ErrUnexpectedEOF
-}
-
-var (
-	ErrInvalidLengthMint        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowMint          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroupMint = fmt.Errorf("proto: unexpected end of group")
-)
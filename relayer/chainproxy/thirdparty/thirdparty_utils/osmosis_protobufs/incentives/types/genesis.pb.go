@@ -1,498 +0,0 @@
-// Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: osmosis/incentives/genesis.proto
-
-package types
-
-import (
-	fmt "fmt"
-	_ "github.com/gogo/protobuf/gogoproto"
-	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
-	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-	time "time"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-var _ = time.Kitchen
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
-
-// GenesisState defines the incentives module's various parameters when first
-// initialized
-type GenesisState struct {
-	// params are all the parameters of the module
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	// gauges are all gauges that should exist at genesis
-	Gauges []Gauge `protobuf:"bytes,2,rep,name=gauges,proto3" json:"gauges"`
-	// lockable_durations are all lockup durations that gauges can be locked for
-	// in order to recieve incentives
-	LockableDurations []time.Duration `protobuf:"bytes,3,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
-	// last_gauge_id is what the gauge number will increment from when creating
-	// the next gauge after genesis
-	LastGaugeId uint64 `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
-}
-
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a288ccc95d977d2d, []int{0}
-}
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
-}
-func (m *GenesisState) XXX_Size() int {
-	return m.Size()
-}
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
-
-func (m *GenesisState) GetParams() Params {
-	if m != nil {
-		return m.Params
-	}
-	return Params{}
-}
-
-func (m *GenesisState) GetGauges() []Gauge {
-	if m != nil {
-		return m.Gauges
-	}
-	return nil
-}
-
-func (m *GenesisState) GetLockableDurations() []time.Duration {
-	if m != nil {
-		return m.LockableDurations
-	}
-	return nil
-}
-
-func (m *GenesisState) GetLastGaugeId() uint64 {
-	if m != nil {
-		return m.LastGaugeId
-	}
-	return 0
-}
-
-func init() {
-	//proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
-}
-
-func init() { }//proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
-
-var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x6e, 0xfa, 0x30,
-	0x10, 0xc6, 0x13, 0x40, 0x0c, 0xe1, 0xff, 0x1f, 0x6a, 0x75, 0x08, 0x0c, 0x4e, 0x14, 0xa9, 0x12,
-	0x4b, 0x6d, 0x15, 0xa4, 0xb6, 0xea, 0x88, 0x2a, 0xa1, 0x6e, 0x88, 0x6e, 0x5d, 0x90, 0x03, 0xae,
-	0x6b, 0x35, 0x89, 0x11, 0xe7, 0xa0, 0xf2, 0x16, 0x9d, 0xaa, 0x3e, 0x12, 0x23, 0x63, 0x27, 0x5a,
-	0xc1, 0x1b, 0xf4, 0x09, 0xaa, 0x38, 0xb1, 0x5a, 0x09, 0x36, 0x9f, 0xbf, 0xdf, 0x7d, 0xf7, 0xdd,
-	0x79, 0xa1, 0x82, 0x54, 0x81, 0x04, 0x2a, 0xb3, 0x29, 0xcf, 0xb4, 0x5c, 0x72, 0xa0, 0x82, 0x67,
-	0x1c, 0x24, 0x90, 0xf9, 0x42, 0x69, 0x85, 0x50, 0x45, 0x90, 0x5f, 0xa2, 0x73, 0x2a, 0x94, 0x50,
-	0x46, 0xa6, 0xc5, 0xab, 0x24, 0x3b, 0x58, 0x28, 0x25, 0x12, 0x4e, 0x4d, 0x15, 0xe7, 0x8f, 0x74,
-	0x96, 0x2f, 0x98, 0x96, 0x2a, 0xab, 0xf4, 0xe0, 0xc8, 0xac, 0x39, 0x5b, 0xb0, 0x14, 0xac, 0xc1,
-	0xb1, 0x30, 0x2c, 0x17, 0xbc, 0xd4, 0xa3, 0xb7, 0x9a, 0xf7, 0x6f, 0x58, 0x86, 0xbb, 0xd7, 0x4c,
-	0x73, 0x74, 0xed, 0x35, 0x4b, 0x03, 0xdf, 0x0d, 0xdd, 0x6e, 0xab, 0xd7, 0x21, 0x87, 0x61, 0xc9,
-	0xc8, 0x10, 0x83, 0xc6, 0x7a, 0x1b, 0x38, 0xe3, 0x8a, 0x47, 0x57, 0x5e, 0xd3, 0x38, 0x83, 0x5f,
-	0x0b, 0xeb, 0xdd, 0x56, 0xaf, 0x7d, 0xac, 0x73, 0x58, 0x10, 0xb6, 0xb1, 0xc4, 0x91, 0xf2, 0x50,
-	0xa2, 0xa6, 0xcf, 0x2c, 0x4e, 0xf8, 0xc4, 0xee, 0x07, 0x7e, 0xbd, 0x32, 0x29, 0x2f, 0x40, 0xec,
-	0x05, 0xc8, 0x6d, 0x45, 0x0c, 0xce, 0x0a, 0x93, 0xef, 0x6d, 0xd0, 0x5e, 0xb1, 0x34, 0xb9, 0x89,
-	0x0e, 0x2d, 0xa2, 0xf7, 0xcf, 0xc0, 0x1d, 0x9f, 0x58, 0xc1, 0x36, 0x02, 0x8a, 0xbc, 0xff, 0x09,
-	0x03, 0x3d, 0x31, 0xf3, 0x27, 0x72, 0xe6, 0x37, 0x42, 0xb7, 0xdb, 0x18, 0xb7, 0x8a, 0x4f, 0x13,
-	0xf0, 0x6e, 0x36, 0x18, 0xad, 0x77, 0xd8, 0xdd, 0xec, 0xb0, 0xfb, 0xb5, 0xc3, 0xee, 0xeb, 0x1e,
-	0x3b, 0x9b, 0x3d, 0x76, 0x3e, 0xf6, 0xd8, 0x79, 0xb8, 0x14, 0x52, 0x3f, 0xe5, 0x31, 0x99, 0xaa,
-	0x94, 0x56, 0x1b, 0x9e, 0x27, 0x2c, 0x06, 0x5b, 0xd0, 0xe5, 0x45, 0x9f, 0xbe, 0xfc, 0x3d, 0xb8,
-	0x5e, 0xcd, 0x39, 0xc4, 0x4d, 0xb3, 0x42, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x77, 0xb3, 0x0e,
-	0x48, 0x20, 0x02, 0x00, 0x00,
-}
-
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastGaugeId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.LockableDurations) > 0 {
-		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
-			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
-			if err != nil {
-				return 0, err
-			}
-			i -= n
-			i = encodeVarintGenesis(dAtA, i, uint64(n))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Gauges) > 0 {
-		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Gauges) > 0 {
-		for _, e := range m.Gauges {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LockableDurations) > 0 {
-		for _, e := range m.LockableDurations {
-			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.LastGaugeId != 0 {
-		n += 1 + sovGenesis(uint64(m.LastGaugeId))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Gauges = append(m.Gauges, Gauge{})
-			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastGaugeId", wireType)
-			}
-			m.LastGaugeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastGaugeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func skipGenesis(dAtA []byte) (n int, err error) {
-	l := len(dAtA)
-	iNdEx := 0
-	depth := 0
-	for iNdEx < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return 0, ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-		case 1:
-			iNdEx += 8
-		case 2:
-			var length int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				length |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if length < 0 {
-				return 0, ErrInvalidLengthGenesis
-			}
-			iNdEx += length
-		case 3:
-			depth++
-		case 4:
-			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroupGenesis
-			}
-			depth--
-		case 5:
-			iNdEx += 4
-		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
-		}
-		if iNdEx < 0 {
-			return 0, ErrInvalidLengthGenesis
-		}
-		if depth == 0 {
-			return iNdEx, nil
-		}
-	}
-	return 0, io.ErrUnexpectedEOF
-}
-
-var (
-	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
-)
@@ -1,342 +0,0 @@
-// Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: osmosis/tokenfactory/v1beta1/params.proto
-
-package types
-
-import (
-	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
-	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/gogo/protobuf/gogoproto"
-	proto "github.com/gogo/protobuf/proto"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
-
-// Params defines the parameters for the tokenfactory module.
-type Params struct {
-	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee" yaml:"denom_creation_fee"`
-}
-
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc8299d306f3ff47, []int{0}
-}
-func (m *Params) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
-}
-func (m *Params) XXX_Size() int {
-	return m.Size()
-}
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Params proto.InternalMessageInfo
-
-func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.DenomCreationFee
-	}
-	return nil
-}
-
-func init() {
-	//proto.RegisterType((*Params)(nil), "osmosis.tokenfactory.v1beta1.Params")
-}
-
-func init() {
-	//proto.RegisterFile("osmosis/tokenfactory/v1beta1/params.proto", fileDescriptor_cc8299d306f3ff47)
-}
-
-var fileDescriptor_cc8299d306f3ff47 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0x63, 0x7d, 0x52, 0x87, 0x7e, 0x0b, 0xaa, 0x18, 0x68, 0x85, 0x1c, 0x94, 0xa9, 0x0c,
-	0xb5, 0x15, 0xca, 0x80, 0x18, 0x5b, 0x89, 0xad, 0x12, 0xea, 0xc8, 0x12, 0xdd, 0x24, 0x6e, 0x6a,
-	0xb5, 0xc9, 0x8d, 0x62, 0xb7, 0x22, 0x6f, 0xc1, 0xc4, 0xce, 0xca, 0x93, 0x74, 0xec, 0xc8, 0x54,
-	0x50, 0xf2, 0x06, 0x3c, 0x01, 0xaa, 0xe3, 0xa2, 0x22, 0x24, 0x26, 0xfb, 0xe8, 0x9e, 0xf3, 0xbb,
-	0x7f, 0xda, 0x97, 0xa8, 0x52, 0x54, 0x52, 0x71, 0x8d, 0x0b, 0x91, 0xcd, 0x20, 0xd2, 0x58, 0x94,
-	0x7c, 0xed, 0x87, 0x42, 0x83, 0xcf, 0x73, 0x28, 0x20, 0x55, 0x2c, 0x2f, 0x50, 0x63, 0xe7, 0xdc,
-	0x5a, 0xd9, 0xb1, 0x95, 0x59, 0x6b, 0xef, 0x34, 0xc1, 0x04, 0x8d, 0x91, 0xef, 0x7f, 0x4d, 0xa6,
-	0x77, 0xfd, 0x27, 0x1e, 0x56, 0x7a, 0x8e, 0x85, 0xd4, 0xe5, 0x44, 0x68, 0x88, 0x41, 0x83, 0x4d,
-	0x75, 0x23, 0x13, 0x0b, 0x1a, 0x5c, 0x23, 0x6c, 0x89, 0x36, 0x8a, 0x87, 0xa0, 0xc4, 0x37, 0x27,
-	0x42, 0x99, 0x35, 0x75, 0xef, 0x85, 0xb4, 0x5b, 0xf7, 0x66, 0xea, 0xce, 0x33, 0x69, 0x77, 0x62,
-	0x91, 0x61, 0x1a, 0x44, 0x85, 0x00, 0x2d, 0x31, 0x0b, 0x66, 0x42, 0x9c, 0x91, 0x8b, 0x7f, 0xfd,
-	0xff, 0x57, 0x5d, 0x66, 0xb1, 0x7b, 0xd0, 0x61, 0x09, 0x36, 0x46, 0x99, 0x8d, 0x26, 0x9b, 0x9d,
-	0xeb, 0x7c, 0xee, 0xdc, 0x6e, 0x09, 0xe9, 0xf2, 0xd6, 0xfb, 0x8d, 0xf0, 0x5e, 0xdf, 0xdd, 0x7e,
-	0x22, 0xf5, 0x7c, 0x15, 0xb2, 0x08, 0x53, 0x3b, 0xa0, 0x7d, 0x06, 0x2a, 0x5e, 0x70, 0x5d, 0xe6,
-	0x42, 0x19, 0x9a, 0x9a, 0x9e, 0x18, 0xc0, 0xd8, 0xe6, 0xef, 0x84, 0x18, 0x4d, 0x37, 0x15, 0x25,
-	0xdb, 0x8a, 0x92, 0x8f, 0x8a, 0x92, 0xa7, 0x9a, 0x3a, 0xdb, 0x9a, 0x3a, 0x6f, 0x35, 0x75, 0x1e,
-	0x6e, 0x8e, 0xa8, 0xf6, 0x72, 0x83, 0x25, 0x84, 0xea, 0x20, 0xf8, 0xda, 0x1f, 0xf2, 0xc7, 0x9f,
-	0xc7, 0x34, 0xbd, 0xc2, 0x96, 0x59, 0x7f, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x6a, 0xd8,
-	0xe6, 0xd0, 0x01, 0x00, 0x00,
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DenomCreationFee) > 0 {
-		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DenomCreationFee) > 0 {
-		for _, e := range m.DenomCreationFee {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
-			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func skipParams(dAtA []byte) (n int, err error) {
-	l := len(dAtA)
-	iNdEx := 0
-	depth := 0
-	for iNdEx < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return 0, ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-		case 1:
-			iNdEx += 8
-		case 2:
-			var length int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				length |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if length < 0 {
-				return 0, ErrInvalidLengthParams
-			}
-			iNdEx += length
-		case 3:
-			depth++
-		case 4:
-			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroupParams
-			}
-			depth--
-		case 5:
-			iNdEx += 4
-		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
-		}
-		if iNdEx < 0 {
-			return 0, ErrInvalidLengthParams
-		}
-		if depth == 0 {
-			return iNdEx, nil
-		}
-	}
-	return 0, io.ErrUnexpectedEOF
-}
-
-var (
-	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
-)
@@ -1,1672 +0,0 @@
-// Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: osmosis/txfees/v1beta1/query.proto
-
-package types
-
-import (
-	context "context"
-	fmt "fmt"
-	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/gogo/protobuf/gogoproto"
-	grpc1 "github.com/gogo/protobuf/grpc"
-	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/gogo/protobuf/types"
-	_ "google.golang.org/genproto/googleapis/api/annotations"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
-
-type QueryFeeTokensRequest struct {
-}
-
-func (m *QueryFeeTokensRequest) Reset()         { *m = QueryFeeTokensRequest{} }
-func (m *QueryFeeTokensRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFeeTokensRequest) ProtoMessage()    {}
-func (*QueryFeeTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{0}
-}
-func (m *QueryFeeTokensRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryFeeTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryFeeTokensRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryFeeTokensRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeeTokensRequest.Merge(m, src)
-}
-func (m *QueryFeeTokensRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryFeeTokensRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeeTokensRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryFeeTokensRequest proto.InternalMessageInfo
-
-type QueryFeeTokensResponse struct {
-	FeeTokens []FeeToken `protobuf:"bytes,1,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens" yaml:"fee_tokens"`
-}
-
-func (m *QueryFeeTokensResponse) Reset()         { *m = QueryFeeTokensResponse{} }
-func (m *QueryFeeTokensResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFeeTokensResponse) ProtoMessage()    {}
-func (*QueryFeeTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{1}
-}
-func (m *QueryFeeTokensResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryFeeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryFeeTokensResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryFeeTokensResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeeTokensResponse.Merge(m, src)
-}
-func (m *QueryFeeTokensResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryFeeTokensResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeeTokensResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryFeeTokensResponse proto.InternalMessageInfo
-
-func (m *QueryFeeTokensResponse) GetFeeTokens() []FeeToken {
-	if m != nil {
-		return m.FeeTokens
-	}
-	return nil
-}
-
-// QueryDenomSpotPriceRequest defines grpc request structure for querying spot
-// price for the specified tx fee denom
-type QueryDenomSpotPriceRequest struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
-}
-
-func (m *QueryDenomSpotPriceRequest) Reset()         { *m = QueryDenomSpotPriceRequest{} }
-func (m *QueryDenomSpotPriceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDenomSpotPriceRequest) ProtoMessage()    {}
-func (*QueryDenomSpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{2}
-}
-func (m *QueryDenomSpotPriceRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryDenomSpotPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryDenomSpotPriceRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryDenomSpotPriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDenomSpotPriceRequest.Merge(m, src)
-}
-func (m *QueryDenomSpotPriceRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryDenomSpotPriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDenomSpotPriceRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryDenomSpotPriceRequest proto.InternalMessageInfo
-
-func (m *QueryDenomSpotPriceRequest) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
-
-// QueryDenomSpotPriceRequest defines grpc response structure for querying spot
-// price for the specified tx fee denom
-type QueryDenomSpotPriceResponse struct {
-	PoolID    uint64                                 `protobuf:"varint,1,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
-	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
-}
-
-func (m *QueryDenomSpotPriceResponse) Reset()         { *m = QueryDenomSpotPriceResponse{} }
-func (m *QueryDenomSpotPriceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDenomSpotPriceResponse) ProtoMessage()    {}
-func (*QueryDenomSpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{3}
-}
-func (m *QueryDenomSpotPriceResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryDenomSpotPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryDenomSpotPriceResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryDenomSpotPriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDenomSpotPriceResponse.Merge(m, src)
-}
-func (m *QueryDenomSpotPriceResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryDenomSpotPriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDenomSpotPriceResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryDenomSpotPriceResponse proto.InternalMessageInfo
-
-func (m *QueryDenomSpotPriceResponse) GetPoolID() uint64 {
-	if m != nil {
-		return m.PoolID
-	}
-	return 0
-}
-
-type QueryDenomPoolIdRequest struct {
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
-}
-
-func (m *QueryDenomPoolIdRequest) Reset()         { *m = QueryDenomPoolIdRequest{} }
-func (m *QueryDenomPoolIdRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDenomPoolIdRequest) ProtoMessage()    {}
-func (*QueryDenomPoolIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{4}
-}
-func (m *QueryDenomPoolIdRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryDenomPoolIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryDenomPoolIdRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryDenomPoolIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDenomPoolIdRequest.Merge(m, src)
-}
-func (m *QueryDenomPoolIdRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryDenomPoolIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDenomPoolIdRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryDenomPoolIdRequest proto.InternalMessageInfo
-
-func (m *QueryDenomPoolIdRequest) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
-
-type QueryDenomPoolIdResponse struct {
-	PoolID uint64 `protobuf:"varint,1,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
-}
-
-func (m *QueryDenomPoolIdResponse) Reset()         { *m = QueryDenomPoolIdResponse{} }
-func (m *QueryDenomPoolIdResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDenomPoolIdResponse) ProtoMessage()    {}
-func (*QueryDenomPoolIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{5}
-}
-func (m *QueryDenomPoolIdResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryDenomPoolIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryDenomPoolIdResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryDenomPoolIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDenomPoolIdResponse.Merge(m, src)
-}
-func (m *QueryDenomPoolIdResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryDenomPoolIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDenomPoolIdResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryDenomPoolIdResponse proto.InternalMessageInfo
-
-func (m *QueryDenomPoolIdResponse) GetPoolID() uint64 {
-	if m != nil {
-		return m.PoolID
-	}
-	return 0
-}
-
-type QueryBaseDenomRequest struct {
-}
-
-func (m *QueryBaseDenomRequest) Reset()         { *m = QueryBaseDenomRequest{} }
-func (m *QueryBaseDenomRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseDenomRequest) ProtoMessage()    {}
-func (*QueryBaseDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{6}
-}
-func (m *QueryBaseDenomRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryBaseDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryBaseDenomRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryBaseDenomRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBaseDenomRequest.Merge(m, src)
-}
-func (m *QueryBaseDenomRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryBaseDenomRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBaseDenomRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryBaseDenomRequest proto.InternalMessageInfo
-
-type QueryBaseDenomResponse struct {
-	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
-}
-
-func (m *QueryBaseDenomResponse) Reset()         { *m = QueryBaseDenomResponse{} }
-func (m *QueryBaseDenomResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBaseDenomResponse) ProtoMessage()    {}
-func (*QueryBaseDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{7}
-}
-func (m *QueryBaseDenomResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryBaseDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryBaseDenomResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryBaseDenomResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBaseDenomResponse.Merge(m, src)
-}
-func (m *QueryBaseDenomResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryBaseDenomResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBaseDenomResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryBaseDenomResponse proto.InternalMessageInfo
-
-func (m *QueryBaseDenomResponse) GetBaseDenom() string {
-	if m != nil {
-		return m.BaseDenom
-	}
-	return ""
-}
-
-func init() {
-	//proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
-	//proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
-	//proto.RegisterType((*QueryDenomSpotPriceRequest)(nil), "osmosis.txfees.v1beta1.QueryDenomSpotPriceRequest")
-	//proto.RegisterType((*QueryDenomSpotPriceResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomSpotPriceResponse")
-	//proto.RegisterType((*QueryDenomPoolIdRequest)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdRequest")
-	//proto.RegisterType((*QueryDenomPoolIdResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdResponse")
-	//proto.RegisterType((*QueryBaseDenomRequest)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomRequest")
-	//proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
-}
-
-func init() {
-	//proto.RegisterFile("osmosis/txfees/v1beta1/query.proto", fileDescriptor_6cbc1b48c44dfdd6)
-}
-
-var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0x12, 0x41,
-	0x18, 0xc7, 0x99, 0x2a, 0x4d, 0x98, 0x9a, 0x46, 0x27, 0xb6, 0xc5, 0xd5, 0x2c, 0x64, 0xa2, 0x4d,
-	0x53, 0xc3, 0x8e, 0x80, 0x5e, 0xbc, 0x89, 0xa4, 0x89, 0x89, 0x31, 0x75, 0xf5, 0xd4, 0xcb, 0x66,
-	0x17, 0x3e, 0x70, 0x53, 0x60, 0xb6, 0xcc, 0xd0, 0x94, 0x18, 0x2f, 0x3e, 0x81, 0x89, 0xc6, 0x57,
-	0xf0, 0xa4, 0xcf, 0xd1, 0x63, 0x13, 0x2f, 0xc6, 0x03, 0x31, 0xe0, 0x13, 0xf0, 0x04, 0x66, 0x67,
-	0x67, 0x59, 0xda, 0x82, 0x94, 0x13, 0xec, 0x7c, 0xff, 0xf9, 0x7f, 0xff, 0x6f, 0xe7, 0x37, 0x8b,
-	0x29, 0x17, 0x6d, 0x2e, 0x7c, 0xc1, 0xe4, 0x49, 0x03, 0x40, 0xb0, 0xe3, 0xa2, 0x07, 0xd2, 0x2d,
-	0xb2, 0xa3, 0x1e, 0x74, 0xfb, 0x56, 0xd0, 0xe5, 0x92, 0x93, 0x4d, 0xad, 0xb1, 0x22, 0x8d, 0xa5,
-	0x35, 0xc6, 0xed, 0x26, 0x6f, 0x72, 0x25, 0x61, 0xe1, 0xbf, 0x48, 0x6d, 0xdc, 0x6b, 0x72, 0xde,
-	0x6c, 0x01, 0x73, 0x03, 0x9f, 0xb9, 0x9d, 0x0e, 0x97, 0xae, 0xf4, 0x79, 0x47, 0xe8, 0xaa, 0xa9,
-	0xab, 0xea, 0xc9, 0xeb, 0x35, 0x58, 0xbd, 0xd7, 0x55, 0x02, 0x5d, 0x7f, 0x30, 0x27, 0x4f, 0x03,
-	0x40, 0xf2, 0x43, 0xd0, 0x32, 0xba, 0x85, 0x37, 0x5e, 0x87, 0x09, 0xf7, 0x00, 0xde, 0x86, 0xcb,
-	0xc2, 0x86, 0xa3, 0x1e, 0x08, 0x49, 0x25, 0xde, 0xbc, 0x58, 0x10, 0x01, 0xef, 0x08, 0x20, 0x07,
-	0x18, 0x37, 0x00, 0x1c, 0xe5, 0x22, 0xb2, 0x28, 0x7f, 0x6d, 0x67, 0xad, 0x94, 0xb7, 0x66, 0x8f,
-	0x66, 0xc5, 0xdb, 0x2b, 0x77, 0x4e, 0x07, 0xb9, 0xd4, 0x78, 0x90, 0xbb, 0xd5, 0x77, 0xdb, 0xad,
-	0xa7, 0x34, 0x71, 0xa0, 0x76, 0xa6, 0x11, 0xf7, 0xa0, 0x55, 0x6c, 0xa8, 0xae, 0x55, 0xe8, 0xf0,
-	0xf6, 0x9b, 0x80, 0xcb, 0xfd, 0xae, 0x5f, 0x03, 0x9d, 0x89, 0x6c, 0xe3, 0x74, 0x3d, 0x2c, 0x64,
-	0x51, 0x1e, 0xed, 0x64, 0x2a, 0x37, 0xc7, 0x83, 0xdc, 0x8d, 0xc8, 0x4e, 0x2d, 0x53, 0x3b, 0x2a,
-	0xd3, 0xef, 0x08, 0xdf, 0x9d, 0x69, 0xa3, 0x27, 0xd8, 0xc5, 0xab, 0x01, 0xe7, 0xad, 0x17, 0x55,
-	0x65, 0x74, 0xbd, 0x42, 0xc6, 0x83, 0xdc, 0x7a, 0x64, 0x14, 0xae, 0x3b, 0x7e, 0x9d, 0xda, 0x5a,
-	0x41, 0x3c, 0x8c, 0x45, 0xc0, 0xa5, 0x13, 0x84, 0x0e, 0xd9, 0x15, 0xd5, 0xf8, 0x79, 0x38, 0xcb,
-	0xef, 0x41, 0x6e, 0xbb, 0xe9, 0xcb, 0x77, 0x3d, 0xcf, 0xaa, 0xf1, 0x36, 0xab, 0xa9, 0x17, 0xa0,
-	0x7f, 0x0a, 0xa2, 0x7e, 0xc8, 0x64, 0x3f, 0x00, 0x61, 0x55, 0xa1, 0x96, 0x4c, 0x9d, 0x38, 0x51,
-	0x3b, 0x23, 0xe2, 0x5c, 0xf4, 0x19, 0xde, 0x4a, 0xe2, 0xee, 0x87, 0x7d, 0xeb, 0xcb, 0x8e, 0xbc,
-	0x87, 0xb3, 0x97, 0x2d, 0x96, 0x1f, 0x77, 0xc2, 0x43, 0xc5, 0x15, 0xa0, 0xbc, 0x62, 0x1e, 0x5e,
-	0x69, 0x1e, 0xa6, 0x0a, 0xda, 0xfe, 0x31, 0xc6, 0x9e, 0x2b, 0xc0, 0x99, 0xce, 0xb9, 0x91, 0xcc,
-	0x9c, 0xd4, 0xa8, 0x9d, 0xf1, 0xe2, 0xdd, 0xa5, 0x2f, 0x69, 0x9c, 0x56, 0x86, 0xe4, 0x2b, 0xc2,
-	0x99, 0x09, 0x65, 0xa4, 0x30, 0x8f, 0xa4, 0x99, 0x98, 0x1a, 0xd6, 0x55, 0xe5, 0x51, 0x58, 0xba,
-	0xfb, 0xf1, 0xe7, 0xdf, 0xcf, 0x2b, 0xf7, 0x09, 0x65, 0xf3, 0xef, 0x87, 0x06, 0x93, 0xfc, 0x40,
-	0x78, 0xfd, 0x3c, 0x41, 0xa4, 0xf4, 0xdf, 0x76, 0x33, 0xa9, 0x35, 0xca, 0x4b, 0xed, 0xd1, 0x39,
-	0xcb, 0x2a, 0x67, 0x81, 0x3c, 0x9c, 0x97, 0x33, 0x41, 0xc9, 0xf1, 0xfa, 0xd1, 0xfb, 0x25, 0xdf,
-	0x10, 0x5e, 0x9b, 0x02, 0x80, 0xb0, 0xc5, 0x9d, 0xcf, 0xd1, 0x66, 0x3c, 0xba, 0xfa, 0x06, 0x9d,
-	0xf3, 0x89, 0xca, 0xc9, 0x48, 0x61, 0x5e, 0x4e, 0x95, 0xcc, 0xd1, 0x9c, 0xb1, 0xf7, 0xea, 0xf1,
-	0x83, 0x3a, 0xf3, 0x09, 0x49, 0x0b, 0xce, 0xfc, 0x22, 0x8a, 0x0b, 0xce, 0xfc, 0x12, 0xa0, 0x8b,
-	0xcf, 0x3c, 0x41, 0xb4, 0xf2, 0xf2, 0x74, 0x68, 0xa2, 0xb3, 0xa1, 0x89, 0xfe, 0x0c, 0x4d, 0xf4,
-	0x69, 0x64, 0xa6, 0xce, 0x46, 0x66, 0xea, 0xd7, 0xc8, 0x4c, 0x1d, 0x94, 0xa6, 0x2e, 0xbb, 0xf6,
-	0x29, 0xb4, 0x5c, 0x4f, 0x4c, 0x4c, 0x8f, 0x8b, 0x65, 0x76, 0x12, 0x5b, 0xab, 0xcb, 0xef, 0xad,
-	0xaa, 0x8f, 0x6c, 0xf9, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x78, 0x6b, 0xa3, 0x1d, 0x06,
-	0x00, 0x00,
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// FeeTokens returns a list of all the whitelisted fee tokens and their
-	// corresponding pools. It does not include the BaseDenom, which has its own
-	// query endpoint
-	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
-	// DenomSpotPrice returns all spot prices by each registered token denom.
-	DenomSpotPrice(ctx context.Context, in *QueryDenomSpotPriceRequest, opts ...grpc.CallOption) (*QueryDenomSpotPriceResponse, error)
-	// Returns the poolID for a specified denom input.
-	DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error)
-	// Returns a list of all base denom tokens and their corresponding pools.
-	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
-}
-
-type queryClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
-}
-
-func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
-	out := new(QueryFeeTokensResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/FeeTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *queryClient) DenomSpotPrice(ctx context.Context, in *QueryDenomSpotPriceRequest, opts ...grpc.CallOption) (*QueryDenomSpotPriceResponse, error) {
-	out := new(QueryDenomSpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/DenomSpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *queryClient) DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error) {
-	out := new(QueryDenomPoolIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/DenomPoolId", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *queryClient) BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error) {
-	out := new(QueryBaseDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/BaseDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// FeeTokens returns a list of all the whitelisted fee tokens and their
-	// corresponding pools. It does not include the BaseDenom, which has its own
-	// query endpoint
-	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
-	// DenomSpotPrice returns all spot prices by each registered token denom.
-	DenomSpotPrice(context.Context, *QueryDenomSpotPriceRequest) (*QueryDenomSpotPriceResponse, error)
-	// Returns the poolID for a specified denom input.
-	DenomPoolId(context.Context, *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error)
-	// Returns a list of all base denom tokens and their corresponding pools.
-	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
-
-func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
-}
-func (*UnimplementedQueryServer) DenomSpotPrice(ctx context.Context, req *QueryDenomSpotPriceRequest) (*QueryDenomSpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomSpotPrice not implemented")
-}
-func (*UnimplementedQueryServer) DenomPoolId(ctx context.Context, req *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomPoolId not implemented")
-}
-func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BaseDenom not implemented")
-}
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
-
-func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeeTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeeTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.txfees.v1beta1.Query/FeeTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Query_DenomSpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomSpotPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomSpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.txfees.v1beta1.Query/DenomSpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomSpotPrice(ctx, req.(*QueryDenomSpotPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Query_DenomPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomPoolIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomPoolId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.txfees.v1beta1.Query/DenomPoolId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomPoolId(ctx, req.(*QueryDenomPoolIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Query_BaseDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBaseDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BaseDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.txfees.v1beta1.Query/BaseDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BaseDenom(ctx, req.(*QueryBaseDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.txfees.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FeeTokens",
-			Handler:    _Query_FeeTokens_Handler,
-		},
-		{
-			MethodName: "DenomSpotPrice",
-			Handler:    _Query_DenomSpotPrice_Handler,
-		},
-		{
-			MethodName: "DenomPoolId",
-			Handler:    _Query_DenomPoolId_Handler,
-		},
-		{
-			MethodName: "BaseDenom",
-			Handler:    _Query_BaseDenom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/txfees/v1beta1/query.proto",
-}
-
-func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeTokens) > 0 {
-		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryDenomSpotPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDenomSpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDenomSpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryDenomSpotPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDenomSpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDenomSpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SpotPrice.Size()
-		i -= size
-		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.PoolID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryDenomPoolIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDenomPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDenomPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryDenomPoolIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDenomPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDenomPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBaseDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBaseDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBaseDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBaseDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBaseDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBaseDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FeeTokens) > 0 {
-		for _, e := range m.FeeTokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *QueryDenomSpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryDenomSpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
-	}
-	l = m.SpotPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryDenomPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryDenomPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
-	}
-	return n
-}
-
-func (m *QueryBaseDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryBaseDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeeTokens = append(m.FeeTokens, FeeToken{})
-			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
-			}
-			m.PoolID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomPoolIdRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomPoolIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
-			}
-			m.PoolID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseDenomRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func skipQuery(dAtA []byte) (n int, err error) {
-	l := len(dAtA)
-	iNdEx := 0
-	depth := 0
-	for iNdEx < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return 0, ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-		case 1:
-			iNdEx += 8
-		case 2:
-			var length int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				length |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if length < 0 {
-				return 0, ErrInvalidLengthQuery
-			}
-			iNdEx += length
-		case 3:
-			depth++
-		case 4:
-			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroupQuery
-			}
-			depth--
-		case 5:
-			iNdEx += 4
-		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
-		}
-		if iNdEx < 0 {
-			return 0, ErrInvalidLengthQuery
-		}
-		if depth == 0 {
-			return iNdEx, nil
-		}
-	}
-	return 0, io.ErrUnexpectedEOF
-}
-
-var (
-	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
-)
@@ -22,8 +22,23 @@ type ChainSentry struct {
 
 	quit chan bool
 	// Spec blockQueueMu (rw mutex)
-	blockQueueMu utils.LavaMutex
-	blocksQueue  []string // holds all past hashes up until latest block
+	blockQueueMu  utils.LavaMutex
+	blocksQueue   []string // holds all past hashes up until latest block
+	reorgHandlers []func(ReorgEvent)
+}
+
+// ReorgEvent holds the blocks whose hashes changed on a chain reorg, FromBlock to ToBlock inclusive.
+// ToBlock is the latest block before the reorg
+type ReorgEvent struct {
+	FromBlock int64
+	ToBlock   int64
+}
+
+// AddReorgHandler registers a handler called on every reorg the sentry detects, handlers are called from its polling routine
+func (cs *ChainSentry) AddReorgHandler(handler func(ReorgEvent)) {
+	cs.blockQueueMu.Lock()
+	defer cs.blockQueueMu.Unlock()
+	cs.reorgHandlers = append(cs.reorgHandlers, handler)
 }
 
 func (cs *ChainSentry) GetLatestBlockNum() int64 {
@@ -104,14 +119,49 @@ func (cs *ChainSentry) fetchAllPreviousBlocks(ctx context.Context, latestBlock i
 		tmpArr = append(tmpArr, result) // save entire block data for now
 	}
 	cs.blockQueueMu.Lock()
+	reorg, reorged := findReorgedBlocks(cs.GetLatestBlockNum(), cs.blocksQueue, latestBlock, tmpArr)
 	cs.SetLatestBlockNum(latestBlock)
 	cs.blocksQueue = tmpArr
 	blocksQueueLen := int64(len(cs.blocksQueue))
+	reorgHandlers := cs.reorgHandlers
 	cs.blockQueueMu.Unlock()
 	utils.LavaFormatInfo("ChainSentry Updated latest block", &map[string]string{"block": strconv.FormatInt(latestBlock, 10), "latestHash": cs.GetLatestBlockHash(), "blocksQueueLen": strconv.FormatInt(blocksQueueLen, 10)})
+	if reorged {
+		utils.LavaFormatWarning("ChainSentry detected a reorg", nil, &map[string]string{"fromBlock": strconv.FormatInt(reorg.FromBlock, 10), "toBlock": strconv.FormatInt(reorg.ToBlock, 10), "ChainID": cs.ChainID})
+		for _, handler := range reorgHandlers {
+			handler(reorg)
+		}
+	}
 	return nil
 }
 
+// findReorgedBlocks compares the hashes of the blocks both queues hold, the reorg starts at the lowest block whose hash
+// changed. blocks the chain dropped (the latest block went back) are reorged too. each queue ends at its latest block
+func findReorgedBlocks(oldLatestBlock int64, oldQueue []string, newLatestBlock int64, newQueue []string) (ReorgEvent, bool) {
+	if len(oldQueue) == 0 {
+		return ReorgEvent{}, false
+	}
+	fromBlock := oldLatestBlock + 1
+	if newLatestBlock < oldLatestBlock {
+		fromBlock = newLatestBlock + 1
+	}
+	oldFirstBlock := oldLatestBlock - int64(len(oldQueue)) + 1
+	newFirstBlock := newLatestBlock - int64(len(newQueue)) + 1
+	for block := oldFirstBlock; block <= oldLatestBlock && block <= newLatestBlock; block++ {
+		if block < newFirstBlock {
+			continue
+		}
+		if oldQueue[block-oldFirstBlock] != newQueue[block-newFirstBlock] {
+			fromBlock = block
+			break
+		}
+	}
+	if fromBlock > oldLatestBlock {
+		return ReorgEvent{}, false
+	}
+	return ReorgEvent{FromBlock: fromBlock, ToBlock: oldLatestBlock}, true
+}
+
 func (cs *ChainSentry) forkChangedOrGotNewBlock(ctx context.Context, latestBlock int64) (bool, error) {
 	if cs.latestBlockNum != latestBlock {
 		return true, nil
@@ -180,10 +230,15 @@ func NewChainSentry(
 	cp chainproxy.ChainProxy,
 	chainID string,
 ) *ChainSentry {
+	numFinalBlocks := int(cp.GetSentry().GetSpecBlocksInFinalizationProof())
+	if numFinalBlocks < 1 {
+		// specs without data reliability still track the latest block hash
+		numFinalBlocks = 1
+	}
 	return &ChainSentry{
 		chainProxy:             cp,
 		ChainID:                chainID,
-		numFinalBlocks:         int(cp.GetSentry().GetSpecBlocksInFinalizationProof()),
+		numFinalBlocks:         numFinalBlocks,
 		finalizedBlockDistance: int(cp.GetSentry().GetSpecBlockDistanceForFinalizedData()),
 		quit:                   make(chan bool),
 	}
@@ -0,0 +1,35 @@
+package chainsentry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindReorgedBlocks(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldLatestBlock int64
+		oldQueue       []string
+		newLatestBlock int64
+		newQueue       []string
+		reorged        bool
+		reorg          ReorgEvent
+	}{
+		{"first fetch", 0, nil, 10, []string{"a", "b", "c"}, false, ReorgEvent{}},
+		{"same blocks", 10, []string{"a", "b", "c"}, 10, []string{"a", "b", "c"}, false, ReorgEvent{}},
+		{"new blocks", 10, []string{"a", "b", "c"}, 12, []string{"c", "d", "e"}, false, ReorgEvent{}},
+		{"latest block changed", 10, []string{"a", "b", "c"}, 10, []string{"a", "b", "x"}, true, ReorgEvent{FromBlock: 10, ToBlock: 10}},
+		{"reorg with new blocks", 10, []string{"a", "b", "c"}, 11, []string{"b", "x", "y"}, true, ReorgEvent{FromBlock: 10, ToBlock: 10}},
+		{"deep reorg", 10, []string{"a", "b", "c"}, 10, []string{"a", "x", "y"}, true, ReorgEvent{FromBlock: 9, ToBlock: 10}},
+		{"latest block went back", 10, []string{"a", "b", "c"}, 9, []string{"z", "a", "b"}, true, ReorgEvent{FromBlock: 10, ToBlock: 10}},
+		{"no overlap", 10, []string{"a", "b", "c"}, 20, []string{"x", "y", "z"}, false, ReorgEvent{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reorg, reorged := findReorgedBlocks(tt.oldLatestBlock, tt.oldQueue, tt.newLatestBlock, tt.newQueue)
+			require.Equal(t, tt.reorged, reorged)
+			require.Equal(t, tt.reorg, reorg)
+		})
+	}
+}
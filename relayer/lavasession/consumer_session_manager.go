@@ -29,6 +29,9 @@ type ConsumerSessionManager struct {
 	// pairingPurge - contains all pairings that are unwanted this epoch, keeps them in memory in order to avoid release.
 	// (if a consumer session still uses one of them or we want to report it.)
 	pairingPurge map[string]*ConsumerSessionsWithProvider
+
+	// stickySessions - the provider each client's stateful node objects live on.
+	stickySessions stickySessions
 }
 
 // Update the provider pairing list for the ConsumerSessionManager
@@ -41,6 +44,7 @@ func (csm *ConsumerSessionManager) UpdateAllProviders(ctx context.Context, epoch
 	if epoch <= csm.atomicReadCurrentEpoch() { // sentry shouldn't update an old epoch or current epoch
 		return utils.LavaFormatError("trying to update provider list for older epoch", nil, &map[string]string{"epoch": strconv.FormatUint(epoch, 10), "currentEpoch": strconv.FormatUint(csm.atomicReadCurrentEpoch(), 10)})
 	}
+	csm.removeStaleStickyProviders(csm.atomicReadCurrentEpoch())
 	// Update Epoch.
 	csm.atomicWriteCurrentEpoch(epoch)
 
@@ -424,6 +428,14 @@ func (csm *ConsumerSessionManager) GetReportedProviders(epoch uint64) ([]byte, e
 	return bytes, err
 }
 
+// Returns whether the provider is paired with the consumer in the current epoch.
+func (csm *ConsumerSessionManager) IsProviderInPairing(providerAddress string) bool {
+	csm.lock.RLock()
+	defer csm.lock.RUnlock()
+	_, ok := csm.pairing[providerAddress]
+	return ok
+}
+
 // Data Reliability Section:
 
 // Atomically read csm.pairingAddressesLength for data reliability.
@@ -531,6 +543,42 @@ func (csm *ConsumerSessionManager) OnSessionDoneIncreaseRelayAndCu(consumerSessi
 	return nil
 }
 
+// On a billed subscription the session stays locked while it is open, so the payments relay numbers are consecutive.
+// counts the subscribe relay like OnSessionDoneIncreaseRelayAndCu without unlocking.
+func (csm *ConsumerSessionManager) OnSubscriptionStarted(consumerSession *SingleConsumerSession) error {
+	if err := csm.verifyLock(consumerSession); err != nil {
+		return sdkerrors.Wrapf(err, "OnSubscriptionStarted consumerSession.lock must be locked before accessing this method")
+	}
+	consumerSession.CuSum += consumerSession.LatestRelayCu // add CuSum to current cu usage.
+	consumerSession.LatestRelayCu = 0                      // reset cu just in case
+	consumerSession.RelayNum += RelayNumberIncrement       // increase relayNum
+	consumerSession.ConsecutiveNumberOfFailures = 0        // reset failures.
+	return nil
+}
+
+// Add the compute units a subscription accrued since its last payment, fails when the provider allowance is used up.
+func (csm *ConsumerSessionManager) OnSubscriptionCharge(consumerSession *SingleConsumerSession, computeUnits uint64) error {
+	if err := csm.verifyLock(consumerSession); err != nil {
+		return sdkerrors.Wrapf(err, "OnSubscriptionCharge consumerSession.lock must be locked before accessing this method")
+	}
+	err := consumerSession.Client.addUsedComputeUnits(computeUnits)
+	if err != nil {
+		return err
+	}
+	consumerSession.CuSum += computeUnits
+	consumerSession.RelayNum += RelayNumberIncrement
+	return nil
+}
+
+// Release the session of a billed subscription once it was closed.
+func (csm *ConsumerSessionManager) OnSubscriptionDone(consumerSession *SingleConsumerSession) error {
+	if err := csm.verifyLock(consumerSession); err != nil {
+		return sdkerrors.Wrapf(err, "OnSubscriptionDone consumerSession.lock must be locked before accessing this method")
+	}
+	consumerSession.lock.Unlock()
+	return nil
+}
+
 // On a failed DataReliability session we don't decrease the cu unlike a normal session, we just unlock and verify if we need to block this session or provider.
 func (csm *ConsumerSessionManager) OnDataReliabilitySessionFailure(consumerSession *SingleConsumerSession, errorReceived error) error {
 	// consumerSession must be locked when getting here.
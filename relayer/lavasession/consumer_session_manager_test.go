@@ -83,6 +83,52 @@ func TestHappyFlow(t *testing.T) {
 	require.Equal(t, cs.LatestBlock, servicedBlockNumber)
 }
 
+func TestIsProviderInPairing(t *testing.T) {
+	csm := CreateConsumerSessionManager()
+	err := csm.UpdateAllProviders(context.Background(), firstEpochHeight, createPairingList())
+	require.Nil(t, err)
+	require.True(t, csm.IsProviderInPairing("provider0"))
+	require.False(t, csm.IsProviderInPairing("provider"+strconv.Itoa(numberOfProviders)))
+
+	err = csm.UpdateAllProviders(context.Background(), secondEpochHeight, createPairingList()[1:])
+	require.Nil(t, err)
+	require.False(t, csm.IsProviderInPairing("provider0"))
+	require.True(t, csm.IsProviderInPairing("provider1"))
+}
+
+// Test the accounting of a billed subscription, the session stays locked until it is done
+func TestSubscriptionCharges(t *testing.T) {
+	s := createGRPCServer(t)
+	defer s.Stop()
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList()
+	err := csm.UpdateAllProviders(ctx, firstEpochHeight, pairingList)
+	require.Nil(t, err)
+	cs, _, _, _, err := csm.GetSession(ctx, cuForFirstRequest, nil)
+	require.Nil(t, err)
+	err = csm.OnSubscriptionStarted(cs)
+	require.Nil(t, err)
+	require.Equal(t, cuForFirstRequest, cs.CuSum)
+	require.Equal(t, relayNumberAfterFirstCall, cs.RelayNum)
+
+	err = csm.OnSubscriptionCharge(cs, 50)
+	require.Nil(t, err)
+	require.Equal(t, cuForFirstRequest+50, cs.CuSum)
+	require.Equal(t, relayNumberAfterFirstCall+1, cs.RelayNum)
+	require.Equal(t, cuForFirstRequest+50, cs.Client.UsedComputeUnits)
+
+	// more than the provider allowance is left untouched
+	err = csm.OnSubscriptionCharge(cs, cs.Client.MaxComputeUnits)
+	require.True(t, MaxComputeUnitsExceededError.Is(err))
+	require.Equal(t, cuForFirstRequest+50, cs.CuSum)
+
+	err = csm.OnSubscriptionDone(cs)
+	require.Nil(t, err)
+	err = csm.OnSubscriptionDone(cs)
+	require.True(t, LockMisUseDetectedError.Is(err))
+}
+
 // Test the basic functionality of the consumerSessionManager
 func TestSuccessAndFailureOfSessionWithUpdatePairingsInTheMiddle(t *testing.T) {
 	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
@@ -398,3 +444,58 @@ func TestGetSession(t *testing.T) {
 	require.Equal(t, epoch, csm.currentEpoch)
 	require.Equal(t, cs.LatestRelayCu, uint64(cuForFirstRequest))
 }
+
+// Test pinning a client to a provider and getting sessions only from it
+func TestStickySessions(t *testing.T) {
+	s := createGRPCServer(t) // create a grpcServer so we can connect to its endpoint and validate everything works.
+	defer s.Stop()           // stop the server when finished.
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	err := csm.UpdateAllProviders(ctx, firstEpochHeight, createPairingList()) // update the providers.
+	require.Nil(t, err)
+
+	_, pinned := csm.GetStickyProvider("dapp/client", true, "")
+	require.False(t, pinned)
+	csm.SetStickyProvider("dapp/client", "provider3", "0x1f")
+
+	providerAddress, pinned := csm.GetStickyProvider("dapp/client", true, "")
+	require.True(t, pinned)
+	require.Equal(t, "provider3", providerAddress)
+	_, pinned = csm.GetStickyProvider("dapp/client", false, "0x1f")
+	require.True(t, pinned)
+	_, pinned = csm.GetStickyProvider("dapp/client", false, "0x1f00")
+	require.False(t, pinned)
+	_, pinned = csm.GetStickyProvider("dapp/client", false, "")
+	require.False(t, pinned)
+	// removed ids don't pin, the client stays pinned for its local relays
+	csm.RemoveStickyId("dapp/client", "0x1f")
+	_, pinned = csm.GetStickyProvider("dapp/client", false, "0x1f")
+	require.False(t, pinned)
+	_, pinned = csm.GetStickyProvider("dapp/client", true, "")
+	require.True(t, pinned)
+	_, pinned = csm.GetStickyProvider("dapp/other", true, "")
+	require.False(t, pinned)
+
+	for i := 0; i < numberOfProviders; i++ {
+		cs, _, providerAddress, _, err := csm.GetSessionFromProvider(ctx, "provider3", cuForFirstRequest)
+		require.Nil(t, err)
+		require.Equal(t, "provider3", providerAddress)
+		require.Nil(t, csm.OnSessionUnUsed(cs))
+	}
+
+	// the provider left the pairing
+	pairingList := createPairingList()
+	pairingList = append(pairingList[:3], pairingList[4:]...)
+	err = csm.UpdateAllProviders(ctx, secondEpochHeight, pairingList)
+	require.Nil(t, err)
+	_, pinned = csm.GetStickyProvider("dapp/client", true, "")
+	require.True(t, pinned)
+	_, _, _, _, err = csm.GetSessionFromProvider(ctx, "provider3", cuForFirstRequest)
+	require.True(t, StickyProviderUnavailableError.Is(err))
+
+	// pins unused for an epoch are forgotten
+	err = csm.UpdateAllProviders(ctx, secondEpochHeight+firstEpochHeight, pairingList)
+	require.Nil(t, err)
+	_, pinned = csm.GetStickyProvider("dapp/client", true, "")
+	require.False(t, pinned)
+}
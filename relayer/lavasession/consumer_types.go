@@ -11,6 +11,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/tracing"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"google.golang.org/grpc"
@@ -55,6 +56,7 @@ type Endpoint struct {
 type ConsumerSessionsWithProvider struct {
 	Lock             utils.LavaMutex
 	Acc              string // public lava address // change at the end to PublicLavaAddress
+	Operator         string // signs the provider replies when set, doesn't change after creation
 	Endpoints        []*Endpoint
 	Sessions         map[int64]*SingleConsumerSession
 	MaxComputeUnits  uint64
@@ -139,7 +141,7 @@ func (cswp *ConsumerSessionsWithProvider) connectRawClientWithTimeout(ctx contex
 	connectCtx, cancel := context.WithTimeout(ctx, TimeoutForEstablishingAConnection)
 	defer cancel()
 
-	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock(), grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()), grpc.WithStreamInterceptor(tracing.StreamClientInterceptor()))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,124 @@
+package lavasession
+
+import (
+	"context"
+	"sync"
+
+	"github.com/lavanet/lava/utils"
+)
+
+// stickySessions pins the relays that create or use a state on a provider node (i.e filters) to that provider,
+// per dappID and client. key == sticky key
+type stickySessions struct {
+	lock      sync.Mutex
+	providers map[string]*stickyProvider
+}
+
+type stickyProvider struct {
+	providerAddress string
+	ids             map[string]struct{} // ids of node objects created on the provider, relays referencing them are pinned
+	lastUsedEpoch   uint64
+}
+
+// GetStickyProvider returns the provider a relay has to be sent to. local relays are pinned to the provider of the
+// client's earlier local relays, other relays are pinned only when they take an id the provider created (i.e a filter id)
+func (csm *ConsumerSessionManager) GetStickyProvider(stickyKey string, local bool, id string) (providerAddress string, pinned bool) {
+	csm.stickySessions.lock.Lock()
+	defer csm.stickySessions.lock.Unlock()
+	sticky, ok := csm.stickySessions.providers[stickyKey]
+	if !ok {
+		return "", false
+	}
+	if local {
+		return sticky.providerAddress, true
+	}
+	if _, ok := sticky.ids[id]; ok && id != "" {
+		return sticky.providerAddress, true
+	}
+	return "", false
+}
+
+// SetStickyProvider pins the client to the provider that served its relay, id is the node object the relay created if any
+func (csm *ConsumerSessionManager) SetStickyProvider(stickyKey string, providerAddress string, id string) {
+	epoch := csm.atomicReadCurrentEpoch()
+	csm.stickySessions.lock.Lock()
+	defer csm.stickySessions.lock.Unlock()
+	if csm.stickySessions.providers == nil {
+		csm.stickySessions.providers = map[string]*stickyProvider{}
+	}
+	sticky, ok := csm.stickySessions.providers[stickyKey]
+	if !ok || sticky.providerAddress != providerAddress {
+		sticky = &stickyProvider{providerAddress: providerAddress, ids: map[string]struct{}{}}
+		csm.stickySessions.providers[stickyKey] = sticky
+	}
+	if id != "" {
+		sticky.ids[id] = struct{}{}
+	}
+	sticky.lastUsedEpoch = epoch
+}
+
+// RemoveStickyId forgets a node object the client removed (i.e an uninstalled filter), relays taking its id aren't pinned
+func (csm *ConsumerSessionManager) RemoveStickyId(stickyKey string, id string) {
+	csm.stickySessions.lock.Lock()
+	defer csm.stickySessions.lock.Unlock()
+	if sticky, ok := csm.stickySessions.providers[stickyKey]; ok {
+		delete(sticky.ids, id)
+	}
+}
+
+// RemoveStickyProvider forgets the provider a client is pinned to, the next local relay pins it again
+func (csm *ConsumerSessionManager) RemoveStickyProvider(stickyKey string) {
+	csm.stickySessions.lock.Lock()
+	defer csm.stickySessions.lock.Unlock()
+	delete(csm.stickySessions.providers, stickyKey)
+}
+
+// removeStaleStickyProviders forgets the clients that didn't relay during the epoch that ended. clients pinned to a provider
+// that left the pairing are kept, so their next relay fails instead of reaching a provider without their node objects
+func (csm *ConsumerSessionManager) removeStaleStickyProviders(endedEpoch uint64) {
+	csm.stickySessions.lock.Lock()
+	defer csm.stickySessions.lock.Unlock()
+	for stickyKey, sticky := range csm.stickySessions.providers {
+		if sticky.lastUsedEpoch < endedEpoch {
+			delete(csm.stickySessions.providers, stickyKey)
+		}
+	}
+}
+
+// GetSessionFromProvider returns a session with a specific provider of the current pairing, for relays that depend on
+// a state created on that provider's node. returns StickyProviderUnavailableError when the provider can't be used
+func (csm *ConsumerSessionManager) GetSessionFromProvider(ctx context.Context, providerAddress string, cuNeededForSession uint64) (
+	consumerSession *SingleConsumerSession, epoch uint64, providerPublicAddress string, reportedProviders []byte, err error,
+) {
+	csm.lock.RLock()
+	found := false
+	unwantedProviders := make(map[string]struct{}, len(csm.validAddresses))
+	for _, address := range csm.validAddresses {
+		if address == providerAddress {
+			found = true
+			continue
+		}
+		unwantedProviders[address] = struct{}{}
+	}
+	csm.lock.RUnlock()
+	if !found {
+		return nil, 0, "", nil, StickyProviderUnavailableError
+	}
+
+	consumerSession, epoch, providerPublicAddress, reportedProviders, err = csm.GetSession(ctx, cuNeededForSession, unwantedProviders)
+	if err != nil {
+		if PairingListEmptyError.Is(err) {
+			// the provider was blocked, failed to connect or has no compute units left
+			return nil, 0, "", nil, StickyProviderUnavailableError
+		}
+		return nil, 0, "", nil, err
+	}
+	if providerPublicAddress != providerAddress {
+		// the epoch changed while getting the session and the unwanted providers were reset
+		if errUnused := csm.OnSessionUnUsed(consumerSession); errUnused != nil {
+			utils.LavaFormatError("failed releasing a session of a different provider", errUnused, &map[string]string{"provider": providerPublicAddress, "stickyProvider": providerAddress})
+		}
+		return nil, 0, "", nil, StickyProviderUnavailableError
+	}
+	return consumerSession, epoch, providerPublicAddress, reportedProviders, nil
+}
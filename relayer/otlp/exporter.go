@@ -0,0 +1,184 @@
+package otlp
+
+//
+// Batching exporter of otlp/http json payloads, shared by the signals the relayer exports (portal relay logs and relay
+// traces). items are queued without blocking the relay path, and posted in batches every second or when a batch fills
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	LogsPath = "/v1/logs"
+
+	batchSize     = 512
+	flushInterval = time.Second
+	exportTimeout = 10 * time.Second
+)
+
+// Encoder returns the otlp json payload of a batch
+type Encoder func(batch []interface{}) (interface{}, error)
+
+type Exporter struct {
+	url     string
+	headers map[string]string
+	encode  Encoder
+	client  *http.Client
+	lock    sync.RWMutex
+	closed  bool
+	items   chan interface{}
+	done    chan struct{}
+}
+
+// NewExporter posts the batches to the signal path (i.e LogsPath) of the collector at endpoint,
+// i.e http://localhost:4318
+func NewExporter(endpoint string, signalPath string, headers map[string]string, encode Encoder) (*Exporter, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("otlp exporter requires an endpoint")
+	}
+	exporter := &Exporter{
+		url:     endpoint + signalPath,
+		headers: headers,
+		encode:  encode,
+		client:  &http.Client{Timeout: exportTimeout},
+		items:   make(chan interface{}, batchSize*4),
+		done:    make(chan struct{}),
+	}
+	go exporter.exportLoop()
+	return exporter, nil
+}
+
+// Export queues an item, it returns false when the item is dropped because the collector isn't keeping up or the
+// exporter was shut down
+func (e *Exporter) Export(item interface{}) bool {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	if e.closed {
+		return false
+	}
+	select {
+	case e.items <- item:
+		return true
+	default:
+		// items aren't held for the collector
+		return false
+	}
+}
+
+// Shutdown exports the queued items and stops the exporter
+func (e *Exporter) Shutdown() error {
+	e.lock.Lock()
+	if !e.closed {
+		e.closed = true
+		close(e.items)
+	}
+	e.lock.Unlock()
+	<-e.done
+	return nil
+}
+
+func (e *Exporter) exportLoop() {
+	defer close(e.done)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	batch := make([]interface{}, 0, batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := e.post(batch); err != nil {
+			utils.LavaFormatWarning("failed exporting to the otlp collector", err, &map[string]string{"url": e.url, "items": strconv.Itoa(len(batch))})
+		}
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case item, ok := <-e.items:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, item)
+			if len(batch) >= batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (e *Exporter) post(batch []interface{}) error {
+	payload, err := e.encode(batch)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range e.headers {
+		request.Header.Set(key, value)
+	}
+	response, err := e.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, response.Body)
+	if response.StatusCode/100 != 2 {
+		return fmt.Errorf("otlp collector replied %s", response.Status)
+	}
+	return nil
+}
+
+type KeyValue struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+// Value is the otlp json any value, int64 values are strings in otlp json
+func Value(value interface{}) map[string]interface{} {
+	switch v := value.(type) {
+	case string:
+		return map[string]interface{}{"stringValue": v}
+	case bool:
+		return map[string]interface{}{"boolValue": v}
+	case int:
+		return map[string]interface{}{"intValue": strconv.FormatInt(int64(v), 10)}
+	case int64:
+		return map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}
+	case uint64:
+		return map[string]interface{}{"intValue": strconv.FormatUint(v, 10)}
+	case float64:
+		return map[string]interface{}{"doubleValue": v}
+	default:
+		return map[string]interface{}{"stringValue": fmt.Sprint(v)}
+	}
+}
+
+func Attribute(key string, value interface{}) KeyValue {
+	return KeyValue{Key: key, Value: Value(value)}
+}
+
+// Resource is the otlp resource of the service exporting
+func Resource(serviceName string) map[string]interface{} {
+	return map[string]interface{}{"attributes": []KeyValue{Attribute("service.name", serviceName)}}
+}
@@ -0,0 +1,47 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// NormalizeJsonRpcData returns json rpc requests without their id, other data is returned as is
+func NormalizeJsonRpcData(data []byte) []byte {
+	msg := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return data
+	}
+	if _, ok := msg["jsonrpc"]; !ok {
+		return data
+	}
+	delete(msg, "id")
+	// map keys are marshaled sorted and the values compacted
+	normalized, err := json.Marshal(msg)
+	if err != nil {
+		return data
+	}
+	return normalized
+}
+
+// RestoreJsonRpcId sets the id of the request on a json rpc reply, the reply is returned as is when the ids match
+func RestoreJsonRpcId(replyData []byte, requestData []byte) []byte {
+	var request struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(requestData, &request); err != nil || request.ID == nil {
+		return replyData
+	}
+	reply := map[string]json.RawMessage{}
+	if err := json.Unmarshal(replyData, &reply); err != nil {
+		return replyData
+	}
+	if replyId, ok := reply["id"]; !ok || bytes.Equal(replyId, request.ID) {
+		return replyData
+	}
+	reply["id"] = request.ID
+	restored, err := json.Marshal(reply)
+	if err != nil {
+		return replyData
+	}
+	return restored
+}
@@ -0,0 +1,21 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreJsonRpcId(t *testing.T) {
+	reply := []byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`)
+	require.Equal(t, string(reply), string(RestoreJsonRpcId(reply, []byte(`{"jsonrpc":"2.0","id":1}`))))
+	require.Equal(t, `{"id":"abc","jsonrpc":"2.0","result":"0x10"}`, string(RestoreJsonRpcId(reply, []byte(`{"jsonrpc":"2.0","id":"abc"}`))))
+	// rest requests have no id
+	require.Equal(t, "block", string(RestoreJsonRpcId([]byte("block"), []byte("?height=5"))))
+}
+
+func TestNormalizeJsonRpcData(t *testing.T) {
+	require.Equal(t, `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[]}`, string(NormalizeJsonRpcData([]byte(`{"jsonrpc":"2.0","id":7,"method":"eth_blockNumber","params":[]}`))))
+	require.Equal(t, string(NormalizeJsonRpcData([]byte(`{"id":1,"jsonrpc":"2.0","method":"eth_chainId"}`))), string(NormalizeJsonRpcData([]byte(`{"jsonrpc":"2.0","method":"eth_chainId","id":"x"}`))))
+	require.Equal(t, "?height=5", string(NormalizeJsonRpcData([]byte("?height=5"))))
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/lavanet/lava/relayer/parser"
+	"github.com/lavanet/lava/relayer/tracing"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -12,6 +16,7 @@ import (
 type Cache struct {
 	client  pairingtypes.RelayerCacheClient
 	address string
+	local   *localCache // L1 in front of the cache service, nil when not embedded
 }
 
 func ConnectGRPCConnectionToRelayerCacheService(ctx context.Context, addr string) (*pairingtypes.RelayerCacheClient, error) {
@@ -36,16 +41,55 @@ func InitCache(ctx context.Context, addr string) (*Cache, error) {
 	return &cache, nil
 }
 
+// InitLocalCache returns a cache kept in memory up to maxSize bytes, used without a cache service. replies of blocks
+// that aren't finalized expire after blockTime
+func InitLocalCache(maxSize uint64, blockTime time.Duration) *Cache {
+	return &Cache{local: newLocalCache(maxSize, blockTime)}
+}
+
+// ConnectCacheService layers the cache service under the local cache, as L2
+func (cache *Cache) ConnectCacheService(ctx context.Context, addr string) error {
+	relayerCacheClient, err := ConnectGRPCConnectionToRelayerCacheService(ctx, addr)
+	if err != nil {
+		return err
+	}
+	cache.client = *relayerCacheClient
+	cache.address = addr
+	return nil
+}
+
 func (cache *Cache) GetEntry(ctx context.Context, request *pairingtypes.RelayRequest, apiInterface string, blockHash []byte, chainID string, finalized bool) (reply *pairingtypes.RelayReply, err error) {
 	if cache == nil {
 		// TODO: try to connect again once in a while
 		return nil, NotInitialisedError
 	}
+	ctx, span := tracing.StartSpan(ctx, "cache.GetEntry", trace.SpanKindClient)
+	defer func() {
+		span.SetAttributes(attribute.Bool("cache.hit", reply != nil))
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+	var key string
+	if cache.local != nil {
+		key = CacheKey(request, apiInterface, blockHash, chainID)
+		if reply = cache.local.get(key); reply != nil {
+			reply.Data = parser.RestoreJsonRpcId(reply.Data, request.Data)
+			return reply, nil
+		}
+		if cache.address == "" {
+			return nil, nil
+		}
+	}
 	if cache.client == nil {
 		return nil, NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
 	}
 	// TODO: handle disconnections and error types here
-	return cache.client.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: request, ApiInterface: apiInterface, BlockHash: blockHash, ChainID: chainID, Finalized: finalized})
+	reply, err = cache.client.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: request, ApiInterface: apiInterface, BlockHash: blockHash, ChainID: chainID, Finalized: finalized})
+	if err == nil && reply != nil && cache.local != nil {
+		// it isn't known if the service reply is of a finalized block, so it expires like a latest block
+		cache.local.set(key, request, apiInterface, chainID, reply, false)
+	}
+	return reply, err
 }
 
 func (cache *Cache) SetEntry(ctx context.Context, request *pairingtypes.RelayRequest, apiInterface string, blockHash []byte, chainID string, bucketID string, reply *pairingtypes.RelayReply, finalized bool) error {
@@ -53,7 +97,35 @@ func (cache *Cache) SetEntry(ctx context.Context, request *pairingtypes.RelayReq
 		// TODO: try to connect again once in a while
 		return NotInitialisedError
 	}
+	if cache.local != nil {
+		cache.local.set(CacheKey(request, apiInterface, blockHash, chainID), request, apiInterface, chainID, reply, finalized)
+		if cache.address == "" {
+			return nil
+		}
+	}
+	if cache.client == nil {
+		return NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
+	}
 	// TODO: handle disconnections and SetRelay error types here
 	_, err := cache.client.SetRelay(ctx, &pairingtypes.RelayCacheSet{Request: request, ApiInterface: apiInterface, BlockHash: blockHash, ChainID: chainID, Response: reply, Finalized: finalized, BucketID: bucketID})
 	return err
 }
+
+// InvalidateRange removes the entries of the blocks fromBlock to toBlock of a chain and of its latest block, from the
+// local cache and the cache service. called when these blocks were reorged
+func (cache *Cache) InvalidateRange(ctx context.Context, chainID string, apiInterface string, fromBlock int64, toBlock int64) error {
+	if cache == nil {
+		return NotInitialisedError
+	}
+	if cache.local != nil {
+		cache.local.invalidateRange(chainID, apiInterface, fromBlock, toBlock)
+		if cache.address == "" {
+			return nil
+		}
+	}
+	if cache.client == nil {
+		return NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
+	}
+	_, err := cache.client.InvalidateRange(ctx, &pairingtypes.RelayCacheInvalidateRange{ChainID: chainID, ApiInterface: apiInterface, FromBlock: fromBlock, ToBlock: toBlock})
+	return err
+}
@@ -1,3 +1,7 @@
 package performance
 
-const CacheFlagName = "cache-be"
+const (
+	CacheFlagName          = "cache-be"
+	LocalCacheSizeFlagName = "local-cache-size" // in MB, 0 disables the local cache
+	DefaultLocalCacheSize  = 64
+)
@@ -0,0 +1,117 @@
+package performance
+
+import (
+	"container/list"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/lavanet/lava/relayer/parser"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// CacheKey is the key of a relay reply in the local cache, built only from the request fields that select the reply
+// so relays of different sessions share it. json rpc ids are left out, the reply gets the id of its request on a hit
+func CacheKey(request *pairingtypes.RelayRequest, apiInterface string, blockHash []byte, chainID string) string {
+	var key strings.Builder
+	key.WriteString(chainID + "\n" + apiInterface + "\n" + request.ConnectionType + "\n" + request.ApiUrl + "\n")
+	key.Write(parser.NormalizeJsonRpcData(request.Data))
+	key.WriteString("\n" + strconv.FormatInt(request.RequestBlock, 10) + "\n")
+	key.Write(blockHash)
+	for _, header := range request.Metadata {
+		key.WriteString("\n" + header.Name + ":" + header.Value)
+	}
+	return key.String()
+}
+
+type localCacheEntry struct {
+	key          string
+	chainID      string
+	apiInterface string
+	requestBlock int64 // negative for the latest block
+	reply        *pairingtypes.RelayReply
+	size         uint64
+	expiry       time.Time // zero for finalized replies, they are kept until evicted
+}
+
+// localCache is an in memory lru cache of relay replies bounded by the size of the entries
+type localCache struct {
+	lock      sync.Mutex
+	maxSize   uint64
+	size      uint64
+	blockTime time.Duration // replies of blocks that aren't finalized expire after it
+	entries   map[string]*list.Element
+	order     *list.List // front is the most recently used
+}
+
+func newLocalCache(maxSize uint64, blockTime time.Duration) *localCache {
+	return &localCache{
+		maxSize:   maxSize,
+		blockTime: blockTime,
+		entries:   map[string]*list.Element{},
+		order:     list.New(),
+	}
+}
+
+func (lc *localCache) get(key string) *pairingtypes.RelayReply {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+	element, ok := lc.entries[key]
+	if !ok {
+		return nil
+	}
+	entry := element.Value.(*localCacheEntry)
+	if !entry.expiry.IsZero() && time.Now().After(entry.expiry) {
+		lc.remove(element)
+		return nil
+	}
+	lc.order.MoveToFront(element)
+	// callers change the reply they get (i.e signatures)
+	return proto.Clone(entry.reply).(*pairingtypes.RelayReply)
+}
+
+func (lc *localCache) set(key string, request *pairingtypes.RelayRequest, apiInterface string, chainID string, reply *pairingtypes.RelayReply, finalized bool) {
+	entry := &localCacheEntry{key: key, chainID: chainID, apiInterface: apiInterface, requestBlock: request.RequestBlock, reply: proto.Clone(reply).(*pairingtypes.RelayReply)}
+	entry.size = uint64(len(key) + entry.reply.Size())
+	if !finalized {
+		entry.expiry = time.Now().Add(lc.blockTime)
+	}
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+	if entry.size > lc.maxSize {
+		return
+	}
+	if element, ok := lc.entries[key]; ok {
+		lc.remove(element)
+	}
+	lc.entries[key] = lc.order.PushFront(entry)
+	lc.size += entry.size
+	for lc.size > lc.maxSize {
+		lc.remove(lc.order.Back())
+	}
+}
+
+// invalidateRange removes the entries of the blocks fromBlock to toBlock and the entries of the latest block of a chain,
+// an empty apiInterface matches all the interfaces
+func (lc *localCache) invalidateRange(chainID string, apiInterface string, fromBlock int64, toBlock int64) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+	for element := lc.order.Front(); element != nil; {
+		next := element.Next()
+		entry := element.Value.(*localCacheEntry)
+		if entry.chainID == chainID && (apiInterface == "" || entry.apiInterface == apiInterface) &&
+			(entry.requestBlock < 0 || (entry.requestBlock >= fromBlock && entry.requestBlock <= toBlock)) {
+			lc.remove(element)
+		}
+		element = next
+	}
+}
+
+// lc.lock must be locked
+func (lc *localCache) remove(element *list.Element) {
+	entry := lc.order.Remove(element).(*localCacheEntry)
+	delete(lc.entries, entry.key)
+	lc.size -= entry.size
+}
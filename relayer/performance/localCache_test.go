@@ -0,0 +1,113 @@
+package performance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheKey(t *testing.T) {
+	request := &pairingtypes.RelayRequest{ApiUrl: "/blocks/latest", RequestBlock: 10, SessionId: 1, RelayNum: 1, CuSum: 10}
+	otherSession := &pairingtypes.RelayRequest{ApiUrl: "/blocks/latest", RequestBlock: 10, SessionId: 2, RelayNum: 5, CuSum: 50}
+	require.Equal(t, CacheKey(request, "rest", nil, "LAV1"), CacheKey(otherSession, "rest", nil, "LAV1"))
+	otherBlock := &pairingtypes.RelayRequest{ApiUrl: "/blocks/latest", RequestBlock: 11}
+	require.NotEqual(t, CacheKey(request, "rest", nil, "LAV1"), CacheKey(otherBlock, "rest", nil, "LAV1"))
+	require.NotEqual(t, CacheKey(request, "rest", nil, "LAV1"), CacheKey(request, "rest", nil, "COS3"))
+}
+
+func TestLocalCacheEviction(t *testing.T) {
+	reply := &pairingtypes.RelayReply{Data: make([]byte, 100)}
+	entrySize := uint64(len("a") + reply.Size())
+	cache := newLocalCache(2*entrySize, time.Hour)
+	cache.set("a", &pairingtypes.RelayRequest{}, "rest", "LAV1", reply, true)
+	cache.set("b", &pairingtypes.RelayRequest{}, "rest", "LAV1", reply, true)
+	require.NotNil(t, cache.get("a")) // b is now the least recently used
+	cache.set("c", &pairingtypes.RelayRequest{}, "rest", "LAV1", reply, true)
+	require.Nil(t, cache.get("b"))
+	require.NotNil(t, cache.get("a"))
+	require.NotNil(t, cache.get("c"))
+	require.Equal(t, 2*entrySize, cache.size)
+
+	// an entry larger than the cache isn't kept
+	cache.set("d", &pairingtypes.RelayRequest{}, "rest", "LAV1", &pairingtypes.RelayReply{Data: make([]byte, 1000)}, true)
+	require.Nil(t, cache.get("d"))
+	require.NotNil(t, cache.get("a"))
+}
+
+func TestLocalCacheExpiry(t *testing.T) {
+	cache := newLocalCache(1024, 50*time.Millisecond)
+	cache.set("latest", &pairingtypes.RelayRequest{}, "rest", "LAV1", &pairingtypes.RelayReply{Data: []byte("latest")}, false)
+	cache.set("finalized", &pairingtypes.RelayRequest{}, "rest", "LAV1", &pairingtypes.RelayReply{Data: []byte("finalized")}, true)
+	require.NotNil(t, cache.get("latest"))
+	time.Sleep(100 * time.Millisecond)
+	require.Nil(t, cache.get("latest"))
+	require.NotNil(t, cache.get("finalized"))
+	require.Equal(t, uint64(len("finalized")+(&pairingtypes.RelayReply{Data: []byte("finalized")}).Size()), cache.size)
+}
+
+func TestLocalCacheWithoutService(t *testing.T) {
+	ctx := context.Background()
+	cache := InitLocalCache(1024, time.Hour)
+	request := &pairingtypes.RelayRequest{ApiUrl: "/blocks/5", RequestBlock: 5}
+	reply, err := cache.GetEntry(ctx, request, "rest", nil, "LAV1", false)
+	require.Nil(t, err)
+	require.Nil(t, reply)
+
+	err = cache.SetEntry(ctx, request, "rest", nil, "LAV1", "dapp", &pairingtypes.RelayReply{Data: []byte("block 5")}, true)
+	require.Nil(t, err)
+	reply, err = cache.GetEntry(ctx, &pairingtypes.RelayRequest{ApiUrl: "/blocks/5", RequestBlock: 5, SessionId: 3}, "rest", nil, "LAV1", false)
+	require.Nil(t, err)
+	require.Equal(t, []byte("block 5"), reply.Data)
+	// the reply is a copy, changing it doesn't change the cache
+	reply.Data = []byte("changed")
+	reply, err = cache.GetEntry(ctx, request, "rest", nil, "LAV1", false)
+	require.Nil(t, err)
+	require.Equal(t, []byte("block 5"), reply.Data)
+}
+
+func TestLocalCacheJsonRpcId(t *testing.T) {
+	ctx := context.Background()
+	cache := InitLocalCache(1024, time.Hour)
+	request := &pairingtypes.RelayRequest{Data: []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x5",false]}`), RequestBlock: 5}
+	err := cache.SetEntry(ctx, request, "jsonrpc", nil, "ETH1", "dapp", &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","id":1,"result":{}}`)}, true)
+	require.Nil(t, err)
+	// the same request with another id hits the entry and gets its own id back
+	otherId := &pairingtypes.RelayRequest{Data: []byte(`{"jsonrpc":"2.0","id":"abc","method":"eth_getBlockByNumber","params":["0x5",false]}`), RequestBlock: 5}
+	require.Equal(t, CacheKey(request, "jsonrpc", nil, "ETH1"), CacheKey(otherId, "jsonrpc", nil, "ETH1"))
+	reply, err := cache.GetEntry(ctx, otherId, "jsonrpc", nil, "ETH1", false)
+	require.Nil(t, err)
+	require.Equal(t, `{"id":"abc","jsonrpc":"2.0","result":{}}`, string(reply.Data))
+	reply, err = cache.GetEntry(ctx, request, "jsonrpc", nil, "ETH1", false)
+	require.Nil(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":{}}`, string(reply.Data))
+}
+
+func TestLocalCacheInvalidateRange(t *testing.T) {
+	ctx := context.Background()
+	cache := InitLocalCache(4096, time.Hour)
+	setBlock := func(block int64, chainID string) *pairingtypes.RelayRequest {
+		request := &pairingtypes.RelayRequest{ApiUrl: "/blocks", RequestBlock: block}
+		err := cache.SetEntry(ctx, request, "rest", nil, chainID, "dapp", &pairingtypes.RelayReply{Data: []byte("block")}, block < 10)
+		require.Nil(t, err)
+		return request
+	}
+	finalized := setBlock(5, "LAV1")
+	reorged := setBlock(11, "LAV1")
+	latest := setBlock(spectypes.LATEST_BLOCK, "LAV1")
+	otherChain := setBlock(11, "COS3")
+
+	err := cache.InvalidateRange(ctx, "LAV1", "", 10, 12)
+	require.Nil(t, err)
+	for request, cached := range map[*pairingtypes.RelayRequest]bool{finalized: true, reorged: false, latest: false} {
+		reply, err := cache.GetEntry(ctx, request, "rest", nil, "LAV1", false)
+		require.Nil(t, err)
+		require.Equal(t, cached, reply != nil, request.RequestBlock)
+	}
+	reply, err := cache.GetEntry(ctx, otherChain, "rest", nil, "COS3", false)
+	require.Nil(t, err)
+	require.NotNil(t, reply)
+}
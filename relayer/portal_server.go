@@ -14,6 +14,7 @@ import (
 	"github.com/lavanet/lava/relayer/performance"
 	"github.com/lavanet/lava/relayer/sentry"
 	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
 	"github.com/lavanet/lava/utils"
 	"github.com/spf13/pflag"
 )
@@ -30,12 +31,22 @@ func PortalServer(
 	//
 	utils.LavaFormatInfo("lavad Binary Version: "+version.Version, nil)
 	rand.Seed(time.Now().UnixNano())
-	sk, _, err := utils.GetOrCreateVRFKey(clientCtx)
+	vrfSks, err := utils.GetOrCreateVRFKeys(clientCtx)
 	if err != nil {
-		log.Fatalln("error: GetOrCreateVRFKey", err)
+		log.Fatalln("error: GetOrCreateVRFKeys", err)
 	}
+	remoteSigner, err := flagSet.GetString(sigs.RemoteSignerFlagName)
+	if err != nil {
+		log.Fatalln("error: reading remote signer flag", err)
+	}
+	signer, err := sigs.NewSigner(clientCtx, remoteSigner)
+	if err != nil {
+		log.Fatalln("error: NewSigner", err)
+	}
+	// conflict detections are signed by the signer too
+	clientCtx, txFactory = sigs.WithTxSigner(clientCtx, txFactory, signer)
 	// Start sentry
-	sentry := sentry.NewSentry(clientCtx, txFactory, chainID, true, nil, nil, apiInterface, sk, flagSet, 0)
+	sentry := sentry.NewSentry(clientCtx, txFactory, chainID, true, nil, nil, apiInterface, vrfSks, flagSet, 0)
 	err = sentry.Init(ctx)
 	if err != nil {
 		log.Fatalln("error sentry.Init", err)
@@ -48,14 +59,35 @@ func PortalServer(
 	g_serverChainID = chainID
 
 	// Node
-	pLogs, err := chainproxy.NewPortalLogs()
+	tracingEndpoint, err := flagSet.GetString(tracing.TracingEndpointFlagName)
+	if err != nil {
+		log.Fatalln("error: reading tracing endpoint flag", err)
+	}
+	err = tracing.Init(tracingEndpoint, "lava-portal")
+	if err != nil {
+		log.Fatalln("error: tracing.Init", err)
+	}
+	defer tracing.Shutdown()
+	portalLogsConfig, err := flagSet.GetString(chainproxy.PortalLogsConfigFlagName)
 	if err != nil {
-		log.Fatalln("error: NewPortalLogs", err)
+		log.Fatalln("error: reading portal logs config flag", err)
 	}
-	chainProxy, err := chainproxy.GetChainProxy("", 1, sentry, pLogs)
+	pLogs, err := chainproxy.LoadPortalLogs(portalLogsConfig)
+	if err != nil {
+		log.Fatalln("error: LoadPortalLogs", err)
+	}
+	defer pLogs.Close()
+	chainProxy, err := chainproxy.GetChainProxy(nil, nil, 1, chainproxy.DefaultMaxConnsPerNode, sentry, pLogs)
 	if err != nil {
 		log.Fatalln("error: GetChainProxy", err)
 	}
+	if grpcChainProxy, ok := chainProxy.(*chainproxy.GrpcChainProxy); ok {
+		descriptorSets, err := flagSet.GetStringSlice(chainproxy.GrpcDescriptorSetFlagName)
+		if err != nil {
+			log.Fatalln("error: reading grpc descriptor set flag", err)
+		}
+		grpcChainProxy.SetDescriptorSetFiles(descriptorSets)
+	}
 	// Setting up the sentry callback
 	err = sentry.SetupConsumerSessionManager(ctx, chainProxy.GetConsumerSessionManager())
 	if err != nil {
@@ -64,30 +96,53 @@ func PortalServer(
 	//
 	// Set up a connection to the server.
 	utils.LavaFormatInfo("PortalServer"+apiInterface, nil)
-	keyName, err := sigs.GetKeyName(clientCtx)
-	if err != nil {
-		log.Fatalln("error: getKeyName", err)
-	}
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
-	if err != nil {
-		log.Fatalln("error: getPrivKey", err)
-	}
-	clientKey, _ := clientCtx.Keyring.Key(keyName)
 
-	utils.LavaFormatInfo("Client pubkey: "+fmt.Sprintf("%s", clientKey.GetPubKey().Address()), nil)
+	utils.LavaFormatInfo("Client pubkey: "+fmt.Sprintf("%s", signer.PubKey().Address()), &map[string]string{"remoteSigner": remoteSigner})
 
+	localCacheSize, err := flagSet.GetUint64(performance.LocalCacheSizeFlagName)
+	if err != nil {
+		utils.LavaFormatError("Failed To Get Local Cache Size flag", err, &map[string]string{"flags": fmt.Sprintf("%v", flagSet)})
+	} else if localCacheSize > 0 {
+		// the local cache is L1, a cache service is layered under it as L2
+		chainProxy.SetCache(performance.InitLocalCache(localCacheSize*1024*1024, time.Millisecond*time.Duration(sentry.GetAverageBlockTime())))
+	}
 	cacheAddr, err := flagSet.GetString(performance.CacheFlagName)
 	if err != nil {
 		utils.LavaFormatError("Failed To Get Cache Address flag", err, &map[string]string{"flags": fmt.Sprintf("%v", flagSet)})
 	} else if cacheAddr != "" {
-		cache, err := performance.InitCache(ctx, cacheAddr)
+		if cache := chainProxy.GetCache(); cache != nil {
+			err = cache.ConnectCacheService(ctx, cacheAddr)
+		} else {
+			cache, err = performance.InitCache(ctx, cacheAddr)
+			if err == nil {
+				chainProxy.SetCache(cache)
+			}
+		}
 		if err != nil {
 			utils.LavaFormatError("Failed To Connect to cache at address", err, &map[string]string{"address": cacheAddr})
 		} else {
 			utils.LavaFormatInfo("cache service connected", &map[string]string{"address": cacheAddr})
-			chainProxy.SetCache(cache)
 		}
 	}
 
-	chainProxy.PortalStart(ctx, privKey, listenAddr)
+	dappsConfig, err := flagSet.GetString(chainproxy.DappsConfigFlagName)
+	if err != nil {
+		log.Fatalln("error: reading dapps config flag", err)
+	}
+	if dappsConfig != "" {
+		dapps, err := chainproxy.LoadDappRegistry(dappsConfig)
+		if err != nil {
+			log.Fatalln("error: LoadDappRegistry", err)
+		}
+		chainProxy.SetDappRegistry(dapps)
+		usageAddr, err := flagSet.GetString(chainproxy.DappsUsageAddressFlagName)
+		if err != nil {
+			log.Fatalln("error: reading dapps usage address flag", err)
+		}
+		if usageAddr != "" {
+			chainproxy.StartDappsUsageServer(usageAddr, dapps)
+		}
+	}
+
+	chainProxy.PortalStart(ctx, signer, listenAddr)
 }
@@ -0,0 +1,204 @@
+package rewards
+
+//
+// Reward reconciliation: relays the provider claimed are tracked until their payment shows on chain. claims that didn't
+// land, i.e their transaction was dropped from the mempool, are resubmitted while the chain still saves their epoch, and
+// the totals of every epoch are reported once all of its claims settled
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/txsender"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MaxResubmits       = 3
+	confirmationBlocks = 5 // blocks a submitted claim has to land before it is checked on chain
+)
+
+// SubmitFunc sends the payments of relays, returning the outcome of every relay
+type SubmitFunc func(relays []*pairingtypes.RelayRequest) []txsender.ItemResult
+
+// PaymentQuerier checks the chain for the payment of a relay
+type PaymentQuerier interface {
+	IsPaid(ctx context.Context, consumer sdk.AccAddress, relay *pairingtypes.RelayRequest) (bool, error)
+}
+
+// EpochReport is the compute units of the claims of an epoch by their outcome
+type EpochReport struct {
+	Epoch   uint64
+	Relays  int
+	Claimed uint64
+	Paid    uint64
+	Lost    uint64 // the chain rejected them, or they couldn't be sent
+	Expired uint64 // the chain stopped saving their epoch before they were paid
+}
+
+type claim struct {
+	relay       *pairingtypes.RelayRequest
+	consumer    sdk.AccAddress
+	submittedAt int64 // block of the last submission
+	resubmits   int
+	lastErr     error // of the last submission
+}
+
+type Reconciler struct {
+	querier PaymentQuerier
+	submit  SubmitFunc
+	lock    sync.Mutex
+	claims  map[string]*claim
+	reports map[uint64]*EpochReport
+	pending map[uint64]int // unsettled claims per epoch
+}
+
+func NewReconciler(querier PaymentQuerier, submit SubmitFunc) *Reconciler {
+	return &Reconciler{querier: querier, submit: submit, claims: map[string]*claim{}, reports: map[uint64]*EpochReport{}, pending: map[uint64]int{}}
+}
+
+func claimKey(consumer sdk.AccAddress, sessionID uint64, chainID string) string {
+	return consumer.String() + "/" + strconv.FormatUint(sessionID, 10) + "/" + chainID
+}
+
+// Submitted tracks relays submitted for payment at blockHeight, results are the outcomes of their submission
+func (r *Reconciler) Submitted(blockHeight int64, relays []*pairingtypes.RelayRequest, results []txsender.ItemResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for idx, relay := range relays {
+		pubKey, err := sigs.RecoverPubKeyFromRelay(*relay)
+		if err != nil {
+			utils.LavaFormatError("failed recovering consumer of claimed relay", err, &map[string]string{"sessionID": strconv.FormatUint(relay.SessionId, 10)})
+			continue
+		}
+		consumer := sdk.AccAddress(pubKey.Address())
+		key := claimKey(consumer, relay.SessionId, relay.ChainID)
+		if _, ok := r.claims[key]; ok {
+			continue
+		}
+		epoch := uint64(relay.BlockHeight)
+		r.claims[key] = &claim{relay: relay, consumer: consumer, submittedAt: blockHeight, lastErr: results[idx].Err}
+		report, ok := r.reports[epoch]
+		if !ok {
+			report = &EpochReport{Epoch: epoch}
+			r.reports[epoch] = report
+		}
+		report.Relays++
+		report.Claimed += relay.CuSum
+		r.pending[epoch]++
+	}
+}
+
+// PaymentReceived settles the claim of a payment event
+func (r *Reconciler) PaymentReceived(consumer sdk.AccAddress, sessionID uint64, chainID string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if c, ok := r.claims[claimKey(consumer, sessionID, chainID)]; ok {
+		r.settle(c, func(report *EpochReport) { report.Paid += c.relay.CuSum })
+	}
+}
+
+// settle removes a claim adding it to the totals of its epoch, must be called with the lock held
+func (r *Reconciler) settle(c *claim, count func(report *EpochReport)) {
+	delete(r.claims, claimKey(c.consumer, c.relay.SessionId, c.relay.ChainID))
+	epoch := uint64(c.relay.BlockHeight)
+	count(r.reports[epoch])
+	r.pending[epoch]--
+}
+
+// Reconcile checks the chain for the payments of the claims that had time to land, resubmits the ones that didn't
+// while their epoch is saved from earliestSavedBlock, and returns the reports of the epochs whose claims all settled
+func (r *Reconciler) Reconcile(ctx context.Context, blockHeight int64, earliestSavedBlock uint64) []EpochReport {
+	r.lock.Lock()
+	unsettled := []*claim{}
+	for _, c := range r.claims {
+		if c.submittedAt+confirmationBlocks <= blockHeight {
+			unsettled = append(unsettled, c)
+		}
+	}
+	r.lock.Unlock()
+
+	resubmit := []*claim{}
+	for _, c := range unsettled {
+		// the chain is checked even for failed submissions, their transaction may have landed anyway
+		paid, err := r.querier.IsPaid(ctx, c.consumer, c.relay)
+		if err != nil {
+			utils.LavaFormatWarning("failed checking relay payment on chain", err, &map[string]string{"sessionID": strconv.FormatUint(c.relay.SessionId, 10), "consumer": c.consumer.String()})
+			continue
+		}
+		r.lock.Lock()
+		if r.claims[claimKey(c.consumer, c.relay.SessionId, c.relay.ChainID)] != c {
+			// settled by a payment event meanwhile
+			r.lock.Unlock()
+			continue
+		}
+		switch {
+		case paid:
+			r.settle(c, func(report *EpochReport) { report.Paid += c.relay.CuSum })
+		case uint64(c.relay.BlockHeight) < earliestSavedBlock:
+			utils.LavaFormatWarning("relay payment expired", c.lastErr, &map[string]string{"sessionID": strconv.FormatUint(c.relay.SessionId, 10), "epoch": strconv.FormatInt(c.relay.BlockHeight, 10), "cuSum": strconv.FormatUint(c.relay.CuSum, 10)})
+			r.settle(c, func(report *EpochReport) { report.Expired += c.relay.CuSum })
+		case c.resubmits >= MaxResubmits:
+			utils.LavaFormatError("relay payment lost", c.lastErr, &map[string]string{"sessionID": strconv.FormatUint(c.relay.SessionId, 10), "epoch": strconv.FormatInt(c.relay.BlockHeight, 10), "cuSum": strconv.FormatUint(c.relay.CuSum, 10)})
+			r.settle(c, func(report *EpochReport) { report.Lost += c.relay.CuSum })
+		default:
+			resubmit = append(resubmit, c)
+		}
+		r.lock.Unlock()
+	}
+
+	if len(resubmit) > 0 {
+		utils.LavaFormatInfo("resubmitting relay payments that didn't land", &map[string]string{"relays": strconv.Itoa(len(resubmit))})
+		relays := make([]*pairingtypes.RelayRequest, len(resubmit))
+		for idx, c := range resubmit {
+			relays[idx] = c.relay
+		}
+		results := r.submit(relays)
+		r.lock.Lock()
+		for idx, c := range resubmit {
+			c.resubmits++
+			c.submittedAt = blockHeight
+			c.lastErr = results[idx].Err
+		}
+		r.lock.Unlock()
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	reports := []EpochReport{}
+	for epoch, report := range r.reports {
+		if r.pending[epoch] == 0 {
+			reports = append(reports, *report)
+			delete(r.reports, epoch)
+			delete(r.pending, epoch)
+		}
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Epoch < reports[j].Epoch })
+	return reports
+}
+
+// ChainPaymentQuerier finds relay payments in the unique payment storage of the chain
+type ChainPaymentQuerier struct {
+	queryClient pairingtypes.QueryClient
+	provider    string
+}
+
+func NewChainPaymentQuerier(queryClient pairingtypes.QueryClient, provider string) *ChainPaymentQuerier {
+	return &ChainPaymentQuerier{queryClient: queryClient, provider: provider}
+}
+
+func (cpq *ChainPaymentQuerier) IsPaid(ctx context.Context, consumer sdk.AccAddress, relay *pairingtypes.RelayRequest) (bool, error) {
+	index := pairingtypes.UniquePaymentIndex(consumer.String(), cpq.provider, pairingtypes.UniquePaymentIdentifier(relay.SessionId), relay.ChainID)
+	_, err := cpq.queryClient.UniquePaymentStorageClientProvider(ctx, &pairingtypes.QueryGetUniquePaymentStorageClientProviderRequest{Index: index})
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,128 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/txsender"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChainID = "LAV1"
+	epoch       = 100
+)
+
+// fakeChain pays the relays submitted to it unless they are dropped
+type fakeChain struct {
+	lock      sync.Mutex
+	paid      map[uint64]bool // by session id
+	drop      map[uint64]int  // submissions of the session that are dropped
+	submitted map[uint64]int
+}
+
+func newFakeChain() *fakeChain {
+	return &fakeChain{paid: map[uint64]bool{}, drop: map[uint64]int{}, submitted: map[uint64]int{}}
+}
+
+func (fc *fakeChain) IsPaid(ctx context.Context, consumer sdk.AccAddress, relay *pairingtypes.RelayRequest) (bool, error) {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	return fc.paid[relay.SessionId], nil
+}
+
+func (fc *fakeChain) submit(relays []*pairingtypes.RelayRequest) []txsender.ItemResult {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	results := make([]txsender.ItemResult, len(relays))
+	for idx, relay := range relays {
+		fc.submitted[relay.SessionId]++
+		if fc.drop[relay.SessionId] > 0 {
+			// accepted to the mempool but never included
+			fc.drop[relay.SessionId]--
+			results[idx] = txsender.ItemResult{TxHash: "dropped"}
+			continue
+		}
+		fc.paid[relay.SessionId] = true
+		results[idx] = txsender.ItemResult{TxHash: "included"}
+	}
+	return results
+}
+
+func signedRelays(t *testing.T, count int, blockHeight int64) ([]*pairingtypes.RelayRequest, sdk.AccAddress) {
+	sk, consumer := sigs.GenerateFloatingKey()
+	relays := make([]*pairingtypes.RelayRequest, count)
+	for idx := range relays {
+		relay := &pairingtypes.RelayRequest{ChainID: testChainID, SessionId: uint64(idx + 1), CuSum: 10, BlockHeight: blockHeight, RelayNum: 1}
+		sig, err := sigs.SignRelay(sk, *relay)
+		require.NoError(t, err)
+		relay.Sig = sig
+		relays[idx] = relay
+	}
+	return relays, consumer
+}
+
+func TestReconcileResubmitsDroppedClaims(t *testing.T) {
+	chain := newFakeChain()
+	chain.drop[2] = 1                // dropped once
+	chain.drop[3] = MaxResubmits + 1 // never lands
+	reconciler := NewReconciler(chain, chain.submit)
+	relays, consumer := signedRelays(t, 4, epoch)
+	reconciler.Submitted(epoch+60, relays, chain.submit(relays))
+
+	// a payment event settles a claim
+	chain.lock.Lock()
+	delete(chain.paid, 4)
+	chain.lock.Unlock()
+	reconciler.PaymentReceived(consumer, 4, testChainID)
+
+	// claims have time to land before they are checked
+	require.Empty(t, reconciler.Reconcile(context.Background(), epoch+61, epoch))
+	require.Equal(t, 1, chain.submitted[2])
+
+	block := int64(epoch + 60)
+	for round := 0; round < MaxResubmits; round++ {
+		block += confirmationBlocks
+		require.Empty(t, reconciler.Reconcile(context.Background(), block, epoch))
+	}
+	require.Equal(t, 2, chain.submitted[2])
+	require.Equal(t, 1+MaxResubmits, chain.submitted[3])
+
+	reports := reconciler.Reconcile(context.Background(), block+confirmationBlocks, epoch)
+	require.Equal(t, []EpochReport{{Epoch: epoch, Relays: 4, Claimed: 40, Paid: 30, Lost: 10}}, reports)
+	// settled epochs are reported once
+	require.Empty(t, reconciler.Reconcile(context.Background(), block+2*confirmationBlocks, epoch))
+}
+
+func TestReconcileExpiredClaims(t *testing.T) {
+	chain := newFakeChain()
+	chain.drop[1] = 1
+	reconciler := NewReconciler(chain, chain.submit)
+	relays, _ := signedRelays(t, 2, epoch)
+	reconciler.Submitted(epoch+60, relays, chain.submit(relays))
+
+	// the epoch is no longer saved by the chain
+	reports := reconciler.Reconcile(context.Background(), epoch+100, epoch+20)
+	require.Equal(t, []EpochReport{{Epoch: epoch, Relays: 2, Claimed: 20, Paid: 10, Expired: 10}}, reports)
+	require.Equal(t, 1, chain.submitted[1])
+}
+
+type failingQuerier struct{}
+
+func (fq failingQuerier) IsPaid(ctx context.Context, consumer sdk.AccAddress, relay *pairingtypes.RelayRequest) (bool, error) {
+	return false, errors.New("node unavailable")
+}
+
+func TestReconcileKeepsClaimsWhenChainUnavailable(t *testing.T) {
+	chain := newFakeChain()
+	reconciler := NewReconciler(failingQuerier{}, chain.submit)
+	relays, _ := signedRelays(t, 1, epoch)
+	reconciler.Submitted(epoch+60, relays, []txsender.ItemResult{{Err: errors.New("broadcast failed")}})
+	require.Empty(t, reconciler.Reconcile(context.Background(), epoch+100, epoch+20))
+	require.Empty(t, chain.submitted)
+}
@@ -21,6 +21,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/relayer/lavasession"
 	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
 	"github.com/lavanet/lava/utils"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
@@ -37,11 +38,12 @@ import (
 )
 
 const (
-	maxRetries             = 10
-	providerWasntFound     = -1
-	findPairingFailedIndex = -1
-	supportedNumberOfVRFs  = 2
-	GeolocationFlag        = "geolocation"
+	maxRetries               = 10
+	providerWasntFound       = -1
+	findPairingFailedIndex   = -1
+	supportedNumberOfVRFs    = 2
+	GeolocationFlag          = "geolocation"
+	StatefulRelayTargetsFlag = "stateful-relay-targets"
 )
 
 type VoteParams struct {
@@ -111,6 +113,7 @@ type Sentry struct {
 	NewBlockEvents          <-chan ctypes.ResultEvent
 	isUser                  bool
 	Acc                     string // account address (bech32)
+	ProviderAcc             string // staked provider address, Acc or the provider it operates
 	voteInitiationCb        func(ctx context.Context, voteID string, voteDeadline uint64, voteParams *VoteParams)
 	newEpochCb              func(epochHeight int64)
 	ApiInterface            string
@@ -120,6 +123,7 @@ type Sentry struct {
 	authorizationCacheMutex sync.RWMutex
 	txFactory               tx.Factory
 	geolocation             uint64
+	statefulRelayTargets    uint64 // number of providers stateful relays are sent to, 0 is all of the pairing
 	//
 	// expected payments storage
 	PaymentsMu       sync.RWMutex
@@ -127,6 +131,7 @@ type Sentry struct {
 	receivedPayments []PaymentRequest
 	totalCUServiced  uint64
 	totalCUPaid      uint64
+	paymentHandlers  []func(PaymentRequest)
 
 	// server Blocks To Save (atomic)
 	earliestSavedBlock uint64
@@ -145,8 +150,9 @@ type Sentry struct {
 	serverApis map[string]spectypes.ServiceApi
 	taggedApis map[string]spectypes.ServiceApi
 
-	VrfSkMu utils.LavaMutex
-	VrfSk   vrf.PrivateKey
+	VrfSkMu      utils.LavaMutex
+	vrfSks       []vrf.PrivateKey          // the current vrf key first, then the one it replaced
+	vrfSkByEpoch map[uint64]vrf.PrivateKey // the key of the consumer vrf pk on chain for each epoch
 
 	// every entry in providerHashesConsensus is conflicted with the other entries
 	providerHashesConsensus          []ProviderHashesConsensus
@@ -278,6 +284,7 @@ func (s *Sentry) getPairing(ctx context.Context) ([]*lavasession.ConsumerSession
 
 		pairing = append(pairing, &lavasession.ConsumerSessionsWithProvider{
 			Acc:             provider.Address,
+			Operator:        provider.Operator,
 			Endpoints:       pairingEndpoints,
 			Sessions:        map[int64]*lavasession.SingleConsumerSession{},
 			MaxComputeUnits: maxcu,
@@ -429,6 +436,13 @@ func (s *Sentry) Init(ctx context.Context) error {
 		utils.LavaFormatFatal("geolocation flag needs to set only one geolocation, 1<<X where X is the geolocation i.e 1,2,4,8 etc..", err, &map[string]string{"Geolocation": strconv.FormatUint(geolocation, 10)})
 	}
 
+	if s.isUser {
+		s.statefulRelayTargets, err = s.cmdFlags.GetUint64(StatefulRelayTargetsFlag)
+		if err != nil {
+			return utils.LavaFormatError("failed to read stateful relay targets flag", err, nil)
+		}
+	}
+
 	// Sanity
 	if !s.isUser {
 		providers, err := s.pairingQueryClient.Providers(ctx, &pairingtypes.QueryProvidersRequest{
@@ -440,7 +454,9 @@ func (s *Sentry) Init(ctx context.Context) error {
 		found := false
 	endpointsLoop:
 		for _, provider := range providers.GetStakeEntry() {
-			if provider.Address == s.Acc {
+			if provider.Address == s.Acc || provider.Operator == s.Acc {
+				// an operator serves the relays of its provider, they are paid to the provider
+				s.ProviderAcc = provider.Address
 				for _, endpoint := range provider.Endpoints {
 					if endpoint.Geolocation == s.geolocation && endpoint.UseType == s.ApiInterface {
 						found = true
@@ -466,7 +482,7 @@ func (s *Sentry) ListenForTXEvents(ctx context.Context) {
 			// got new TX event
 			if providerAddrList, ok := e.Events["lava_relay_payment.provider"]; ok {
 				for idx, providerAddr := range providerAddrList {
-					if s.Acc == providerAddr && s.ChainID == e.Events["lava_relay_payment.chainID"][idx] {
+					if s.ProviderAcc == providerAddr && s.ChainID == e.Events["lava_relay_payment.chainID"][idx] {
 						utils.LavaFormatInfo("Received relay payment",
 							&map[string]string{
 								"Amount": e.Events["lava_relay_payment.Mint"][idx],
@@ -503,6 +519,12 @@ func (s *Sentry) ListenForTXEvents(ctx context.Context) {
 							s.UpdatePaidCU(paidCU)
 							receivedPayment := PaymentRequest{CU: paidCU, BlockHeightDeadline: data.Height, Amount: coin, Client: clientAddr, UniqueIdentifier: uniqueID}
 							s.AppendToReceivedPayments(receivedPayment)
+							s.PaymentsMu.RLock()
+							paymentHandlers := s.paymentHandlers
+							s.PaymentsMu.RUnlock()
+							for _, handler := range paymentHandlers {
+								handler(receivedPayment)
+							}
 							found := s.RemoveExpectedPayment(paidCU, clientAddr, data.Height, uniqueID)
 							if !found {
 								utils.LavaFormatError("payment received, did not find matching expectancy from correct client", nil, &map[string]string{"expected payments": fmt.Sprintf("%v", s.PrintExpectedPayments()), "received payment": fmt.Sprintf("%v", receivedPayment)})
@@ -588,6 +610,13 @@ func (s *Sentry) AppendToReceivedPayments(paymentReq PaymentRequest) {
 	s.receivedPayments = append(s.receivedPayments, paymentReq)
 }
 
+// AddPaymentHandler registers a handler called on every payment of this provider server, from the tx events routine
+func (s *Sentry) AddPaymentHandler(handler func(PaymentRequest)) {
+	s.PaymentsMu.Lock()
+	defer s.PaymentsMu.Unlock()
+	s.paymentHandlers = append(s.paymentHandlers, handler)
+}
+
 func (s *Sentry) PrintExpectedPayments() string {
 	s.PaymentsMu.Lock()
 	defer s.PaymentsMu.Unlock()
@@ -774,7 +803,7 @@ func (s *Sentry) AddExpectedPayment(expectedPay PaymentRequest) {
 func (s *Sentry) connectRawClient(ctx context.Context, addr string) (*pairingtypes.RelayerClient, error) {
 	connectCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
-	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	conn, err := grpc.DialContext(connectCtx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock(), grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()), grpc.WithStreamInterceptor(tracing.StreamClientInterceptor()))
 	if err != nil {
 		return nil, err
 	}
@@ -1013,9 +1042,8 @@ func (s *Sentry) SendRelay(
 			var dataReliabilitySessions []*DataReliabilitySession
 
 			// handle data reliability
-			s.VrfSkMu.Lock()
-			vrfRes0, vrfRes1 := utils.CalculateVrfOnRelay(request, reply, s.VrfSk, sessionEpoch)
-			s.VrfSkMu.Unlock()
+			vrfSk := s.vrfSkForEpoch(ctx, sessionEpoch)
+			vrfRes0, vrfRes1 := utils.CalculateVrfOnRelay(request, reply, vrfSk, sessionEpoch)
 			// get two indexesMap for data reliability.
 			indexesMap := s.DataReliabilityThresholdToSession([][]byte{vrfRes0, vrfRes1}, []bool{false, true})
 			utils.LavaFormatDebug("DataReliability Randomized Values", &map[string]string{"vrf0": strconv.FormatUint(uint64(binary.LittleEndian.Uint32(vrfRes0)), 10), "vrf1": strconv.FormatUint(uint64(binary.LittleEndian.Uint32(vrfRes1)), 10), "decisionMap": fmt.Sprintf("%+v", indexesMap)})
@@ -1047,9 +1075,7 @@ func (s *Sentry) SendRelay(
 
 			sendReliabilityRelay := func(singleConsumerSession *lavasession.SingleConsumerSession, providerAddress string, differentiator bool) (relay_rep *pairingtypes.RelayReply, relay_req *pairingtypes.RelayRequest, err error) {
 				var dataReliabilityLatency time.Duration
-				s.VrfSkMu.Lock()
-				vrf_res, vrf_proof := utils.ProveVrfOnRelay(request, reply, s.VrfSk, differentiator, sessionEpoch)
-				s.VrfSkMu.Unlock()
+				vrf_res, vrf_proof := utils.ProveVrfOnRelay(request, reply, vrfSk, differentiator, sessionEpoch)
 				dataReliability := &pairingtypes.VRFData{
 					Differentiator: differentiator,
 					VrfValue:       vrf_res,
@@ -1241,7 +1267,7 @@ func (s *Sentry) IsAuthorizedConsumer(ctx context.Context, consumer string, bloc
 	res, err := s.pairingQueryClient.VerifyPairing(context.Background(), &pairingtypes.QueryVerifyPairingRequest{
 		ChainID:  s.ChainID,
 		Client:   consumer,
-		Provider: s.Acc,
+		Provider: s.ProviderAcc,
 		Block:    blockHeight,
 	})
 	if err != nil {
@@ -1307,6 +1333,15 @@ func (s *Sentry) GetAverageBlockTime() int64 {
 	return s.serverSpec.AverageBlockTime
 }
 
+func (s *Sentry) GetAllowedBlockLagForQosSync() int64 {
+	return s.serverSpec.AllowedBlockLagForQosSync
+}
+
+// GetStatefulRelayTargets returns the number of providers a stateful relay is sent to, 0 means all of the pairing
+func (s *Sentry) GetStatefulRelayTargets() uint64 {
+	return s.statefulRelayTargets
+}
+
 func (s *Sentry) MatchSpecApiByName(name string) (spectypes.ServiceApi, bool) {
 	s.specMu.RLock()
 	defer s.specMu.RUnlock()
@@ -1332,6 +1367,17 @@ func (s *Sentry) GetSpecApiByName(name string) (spectypes.ServiceApi, bool) {
 	return val, ok
 }
 
+func (s *Sentry) GetSpecApiNames() []string {
+	s.specMu.RLock()
+	defer s.specMu.RUnlock()
+
+	names := make([]string, 0, len(s.serverApis))
+	for name := range s.serverApis {
+		names = append(names, name)
+	}
+	return names
+}
+
 func (s *Sentry) GetSpecApiByTag(tag string) (spectypes.ServiceApi, bool) {
 	s.specMu.RLock()
 	defer s.specMu.RUnlock()
@@ -1348,6 +1394,11 @@ func (s *Sentry) SetBlockHeight(blockHeight int64) {
 	atomic.StoreInt64(&s.blockHeight, blockHeight)
 }
 
+// GetEarliestSavedBlock returns the earliest block whose epoch the chain still saves
+func (s *Sentry) GetEarliestSavedBlock() uint64 {
+	return atomic.LoadUint64(&s.earliestSavedBlock)
+}
+
 func (s *Sentry) GetCurrentEpochHeight() uint64 {
 	return atomic.LoadUint64(&s.currentEpoch)
 }
@@ -1405,6 +1456,47 @@ func (s *Sentry) GetVrfPkAndMaxCuForUser(ctx context.Context, address string, ch
 	return vrfPk, UserEntryRes.GetMaxCU(), err
 }
 
+// vrfSkForEpoch returns the vrf key of the consumer vrf pk on chain at epoch, after a rotation the relays of the
+// previous epoch are still proven with the replaced key
+func (s *Sentry) vrfSkForEpoch(ctx context.Context, epoch uint64) vrf.PrivateKey {
+	s.VrfSkMu.Lock()
+	defer s.VrfSkMu.Unlock()
+	if vrfSk, ok := s.vrfSkByEpoch[epoch]; ok {
+		return vrfSk
+	}
+	if len(s.vrfSks) == 0 {
+		return nil
+	}
+	UserEntryRes, err := s.pairingQueryClient.UserEntry(ctx, &pairingtypes.QueryUserEntryRequest{ChainID: s.ChainID, Address: s.Acc, Block: epoch})
+	if err != nil {
+		// not cached, the next relay of the epoch asks again
+		utils.LavaFormatWarning("failed querying the consumer vrf pk, using the current vrf key", err, &map[string]string{"epoch": strconv.FormatUint(epoch, 10)})
+		return s.vrfSks[0]
+	}
+	vrfSk := s.vrfSks[0]
+	found := false
+	for _, candidate := range s.vrfSks {
+		vrfPk, err := utils.VrfPublicKey(candidate)
+		if err == nil && vrfPk.String() == UserEntryRes.GetConsumer().Vrfpk {
+			vrfSk, found = candidate, true
+			break
+		}
+	}
+	if !found {
+		utils.LavaFormatWarning("no vrf key in the keyring matches the consumer vrf pk, using the current vrf key", nil, &map[string]string{"epoch": strconv.FormatUint(epoch, 10), "vrfpk": UserEntryRes.GetConsumer().Vrfpk})
+	}
+	// sessions only use the current and previous epochs
+	if len(s.vrfSkByEpoch) >= 2 {
+		for cachedEpoch := range s.vrfSkByEpoch {
+			if cachedEpoch < epoch {
+				delete(s.vrfSkByEpoch, cachedEpoch)
+			}
+		}
+	}
+	s.vrfSkByEpoch[epoch] = vrfSk
+	return vrfSk
+}
+
 func (s *Sentry) ExpectedBlockHeight() (int64, int) {
 	averageBlockTime_ms := s.serverSpec.AverageBlockTime
 	listExpectedBlockHeights := []int64{}
@@ -1467,7 +1559,7 @@ func NewSentry(
 	voteInitiationCb func(ctx context.Context, voteID string, voteDeadline uint64, voteParams *VoteParams),
 	newEpochCb func(epochHeight int64),
 	apiInterface string,
-	vrf_sk vrf.PrivateKey,
+	vrf_sks []vrf.PrivateKey,
 	flagSet *pflag.FlagSet,
 	serverID uint64,
 ) *Sentry {
@@ -1491,9 +1583,11 @@ func NewSentry(
 		txFactory:               txFactory,
 		isUser:                  isUser,
 		Acc:                     acc,
+		ProviderAcc:             acc,
 		newEpochCb:              newEpochCb,
 		ApiInterface:            apiInterface,
-		VrfSk:                   vrf_sk,
+		vrfSks:                  vrf_sks,
+		vrfSkByEpoch:            map[uint64]vrf.PrivateKey{},
 		blockHeight:             currentBlock,
 		specHash:                nil,
 		cmdFlags:                flagSet,
@@ -6,18 +6,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/txsender"
 	"github.com/lavanet/lava/utils"
 )
 
-const (
-	defaultGasPrice      = "0.000000001ulava"
-	defaultGasAdjustment = 1.5
-	maximumGasAllowed    = 5000000
-)
-
 func SimulateAndBroadCastTx(clientCtx client.Context, txf tx.Factory, msg sdk.Msg) error {
-	txf = txf.WithGasPrices(defaultGasPrice)
-	txf = txf.WithGasAdjustment(defaultGasAdjustment)
+	txf = txf.WithGasPrices(txsender.DefaultGasPrice)
+	txf = txf.WithGasAdjustment(txsender.DefaultGasAdjustment)
 	if err := msg.ValidateBasic(); err != nil {
 		return err
 	}
@@ -73,35 +68,19 @@ func prepareFactory(clientCtx client.Context, txf tx.Factory) (tx.Factory, error
 }
 
 func validateGas(gas uint64) error {
-	if gas > maximumGasAllowed {
+	if gas > txsender.MaximumGasAllowed {
 		return utils.LavaFormatError("SimulateAndBroadCastTx - Maximum gas allowed Reached", nil, &map[string]string{
-			"maximumGasAllowed": strconv.FormatUint(maximumGasAllowed, 10),
+			"maximumGasAllowed": strconv.FormatUint(txsender.MaximumGasAllowed, 10),
 			"gasUsed":           strconv.FormatUint(gas, 10),
 		})
 	}
 	return nil
 }
 
-func CheckProfitabilityAndBroadCastTx(clientCtx client.Context, txf tx.Factory, msg sdk.Msg) error {
-	txf = txf.WithGasPrices(defaultGasPrice)
-	txf = txf.WithGasAdjustment(defaultGasAdjustment)
-	if err := msg.ValidateBasic(); err != nil {
-		return err
-	}
-
-	txf, err := prepareFactory(clientCtx, txf)
-	if err != nil {
-		return err
-	}
-
-	simResult, gasUsed, err := tx.CalculateGas(clientCtx, txf, msg)
-	if err != nil {
-		return err
-	}
-
-	txEvents := simResult.GetResult().Events
+// CheckRelayPaymentProfitability logs relay payments whose gas fees exceed the rewards they claim
+func CheckRelayPaymentProfitability(simulation *txsender.Simulation) error {
 	lavaReward := sdk.NewCoin("ulava", sdk.NewInt(0))
-	for _, txEvent := range txEvents {
+	for _, txEvent := range simulation.Events {
 		if txEvent.Type == "lava_relay_payment" {
 			for _, attribute := range txEvent.Attributes {
 				if string(attribute.Key) == "BasePay" {
@@ -116,23 +95,10 @@ func CheckProfitabilityAndBroadCastTx(clientCtx client.Context, txf tx.Factory,
 		}
 	}
 
-	if err := validateGas(gasUsed); err != nil {
-		return err
-	}
-
-	txf = txf.WithGas(gasUsed)
-
-	gasFee := txf.GasPrices()[0]
-	gasFee.Amount = gasFee.Amount.MulInt64(int64(gasUsed))
-	lavaRewardDec := sdk.NewDecCoinFromCoin(lavaReward)
-
-	if gasFee.IsGTE(lavaRewardDec) {
-		utils.LavaFormatError("lava_relay_payment claim is not profitable", nil, &map[string]string{"gasFee": gasFee.String(), "lavareward:": lavaRewardDec.String(), "msg": msg.String()})
-	}
-
-	err = tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
-	if err != nil {
-		return err
+	gasFee := simulation.Fees.AmountOf(lavaReward.Denom)
+	lavaRewardDec := sdk.NewDecFromInt(lavaReward.Amount)
+	if gasFee.GTE(lavaRewardDec) {
+		utils.LavaFormatError("lava_relay_payment claim is not profitable", nil, &map[string]string{"gasFee": gasFee.String(), "lavareward:": lavaRewardDec.String()})
 	}
 	return nil
 }
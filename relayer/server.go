@@ -23,49 +23,54 @@ import (
 
 	"golang.org/x/exp/slices"
 
-	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/lavanet/lava/relayer/chainproxy"
-	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
 	"github.com/lavanet/lava/relayer/chainsentry"
 	"github.com/lavanet/lava/relayer/lavasession"
 	"github.com/lavanet/lava/relayer/performance"
+	"github.com/lavanet/lava/relayer/rewards"
 	"github.com/lavanet/lava/relayer/sentry"
 	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
+	"github.com/lavanet/lava/relayer/txsender"
 	"github.com/lavanet/lava/utils"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/spf13/pflag"
 	tenderbytes "github.com/tendermint/tendermint/libs/bytes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	grpc "google.golang.org/grpc"
 )
 
 const (
-	RETRY_INCORRECT_SEQUENCE      = 3
 	TimeWaitInitializeChainSentry = 10
 	RetryInitAttempts             = 10
 )
 
 var (
-	g_privKey               *btcSecp256k1.PrivateKey
+	g_signer                sigs.Signer
 	g_sessions              map[string]*UserSessions
 	g_sessions_mutex        utils.LavaMutex
 	g_votes                 map[string]*voteData
 	g_votes_mutex           utils.LavaMutex
 	g_sentry                *sentry.Sentry
 	g_serverChainID         string
-	g_txFactory             tx.Factory
+	g_txSender              txsender.Sender // shared with the servers of the other chains through a tx sender daemon
+	g_rewardsReconciler     *rewards.Reconciler
 	g_chainProxy            chainproxy.ChainProxy
 	g_chainSentry           *chainsentry.ChainSentry
 	g_rewardsSessions       map[uint64][]*RelaySession // map[epochHeight][]*rewardableSessions
 	g_rewardsSessions_mutex utils.LavaMutex
 	g_serverID              uint64
 	g_askForRewards_mutex   sync.Mutex
+	g_relayCoalescer        chainproxy.RelayCoalescer
+	g_subscriptionMuxer     chainproxy.SubscriptionMultiplexer
 )
 
 type UserSessionsEpochData struct {
@@ -95,12 +100,16 @@ type RelaySession struct {
 }
 
 type subscription struct {
-	id                   string
-	sub                  *rpcclient.ClientSubscription
-	subscribeRepliesChan chan interface{}
+	id  string
+	sub *chainproxy.MultiplexedSubscriber // the node subscription is shared by identical subscriptions
+	// billing of subscriptions charged while open, guarded by the UserSessions lock
+	sessionID    uint64
+	pairingEpoch uint64
+	billedCu     uint64
+	paidCu       uint64
+	lastPayment  time.Time
 }
 
-// TODO Perform payment stuff here
 func (s *subscription) disconnect() {
 	s.sub.Unsubscribe()
 }
@@ -125,6 +134,7 @@ type relayServer struct {
 func askForRewards(staleEpochHeight int64) {
 	g_askForRewards_mutex.Lock()
 	defer g_askForRewards_mutex.Unlock()
+	reconcileRewards()
 	staleEpochs := []uint64{uint64(staleEpochHeight)}
 	g_rewardsSessions_mutex.Lock()
 	if len(g_rewardsSessions) > sentry.StaleEpochDistance+1 {
@@ -245,78 +255,57 @@ func askForRewards(staleEpochHeight int64) {
 		"reliability": fmt.Sprintf("%t", reliability),
 	})
 
-	myWriter := bytes.Buffer{}
-	hasSequenceError := false
-	success := false
-	idx := -1
-	summarizedTransactionResult := ""
-	for ; idx < RETRY_INCORRECT_SEQUENCE && !success; idx++ {
-		msg := pairingtypes.NewMsgRelayPayment(g_sentry.Acc, relays, strconv.FormatUint(g_serverID, 10))
-		g_sentry.ClientCtx.Output = &myWriter
-		if hasSequenceError { // a retry
-			_, seq, err := g_sentry.ClientCtx.AccountRetriever.GetAccountNumberSequence(g_sentry.ClientCtx, g_sentry.ClientCtx.GetFromAddress())
-			if err != nil {
-				utils.LavaFormatError("failed to get correct sequence number for account, give up", err, nil)
-				break // give up
-			}
-			g_txFactory = g_txFactory.WithSequence(seq)
-			myWriter.Reset()
-			utils.LavaFormatInfo("Retrying with sequence number:", &map[string]string{
-				"SeqNum": strconv.FormatUint(seq, 10),
-			})
-		}
-		err := sentry.CheckProfitabilityAndBroadCastTx(g_sentry.ClientCtx, g_txFactory, msg)
-		if err != nil {
-			utils.LavaFormatError("Sending CheckProfitabilityAndBroadCastTx failed", err, &map[string]string{
-				"msg": fmt.Sprintf("%+v", msg),
-			})
-		}
-
-		transactionResult := myWriter.String()
-		summarized, transactionResults := summarizeTransactionResult(transactionResult)
-		summarizedTransactionResult = summarized
-
-		var returnCode uint64
-		splitted := strings.Split(transactionResults[0], ":")
-		if len(splitted) < 2 {
-			utils.LavaFormatError("Failed to parse transaction result", err, &map[string]string{
-				"parsing data": transactionResult,
+	results := sendRelayPayments(relays)
+	g_rewardsReconciler.Submitted(g_sentry.GetBlockHeight(), relays, results)
+	txHashes := map[string]struct{}{}
+	failed := 0
+	for idx, result := range results {
+		if result.Err != nil {
+			failed++
+			utils.LavaFormatError("relay payment failed", result.Err, &map[string]string{
+				"sessionID": strconv.FormatUint(relays[idx].SessionId, 10),
+				"epoch":     strconv.FormatInt(relays[idx].BlockHeight, 10),
+				"cuSum":     strconv.FormatUint(relays[idx].CuSum, 10),
 			})
-			returnCode = 1 // just not zero
-		} else {
-			returnCode, err = strconv.ParseUint(splitted[1], 10, 32)
-			if err != nil {
-				utils.LavaFormatError("Failed to parse transaction result", err, &map[string]string{
-					"parsing data": transactionResult,
-				})
-				returnCode = 1 // just not zero
-			}
-		}
-
-		if returnCode == 0 { // if we get some other error which isnt then keep retrying
-			success = true
-		} else if strings.Contains(summarized, "incorrect account sequence") {
-			hasSequenceError = true
+			continue
 		}
+		txHashes[result.TxHash] = struct{}{}
 	}
-
-	if !success {
-		utils.LavaFormatError(fmt.Sprintf("askForRewards ERROR, transaction results: \n%s\n", summarizedTransactionResult), nil, nil)
-	} else {
-		utils.LavaFormatInfo(fmt.Sprintf("askForRewards SUCCESS!, transaction results: %s\n", summarizedTransactionResult), nil)
+	if failed == len(relays) {
+		utils.LavaFormatError("askForRewards ERROR", nil, &map[string]string{"relays": strconv.Itoa(len(relays))})
+		return
 	}
+	utils.LavaFormatInfo("askForRewards SUCCESS!", &map[string]string{
+		"paid":         strconv.Itoa(len(relays) - failed),
+		"failed":       strconv.Itoa(failed),
+		"transactions": strconv.Itoa(len(txHashes)),
+	})
 }
 
-func summarizeTransactionResult(transactionResult string) (string, []string) {
-	transactionResult = strings.ReplaceAll(transactionResult, ": ", ":")
-	transactionResults := strings.Split(transactionResult, "\n")
-	summarizedResult := ""
-	for _, str := range transactionResults {
-		if strings.Contains(str, "raw_log:") || strings.Contains(str, "txhash:") || strings.Contains(str, "code:") {
-			summarizedResult = summarizedResult + str + ", "
+// sendRelayPayments sends relays in chunks that fit in a transaction, a relay the chain rejects doesn't fail the others
+func sendRelayPayments(relays []*pairingtypes.RelayRequest) []txsender.ItemResult {
+	return g_txSender.SendChunked(context.Background(), len(relays), func(items []int) sdk.Msg {
+		chunk := make([]*pairingtypes.RelayRequest, len(items))
+		for idx, item := range items {
+			chunk[idx] = relays[item]
 		}
+		return pairingtypes.NewMsgRelayPayment(g_sentry.Acc, chunk, strconv.FormatUint(g_serverID, 10))
+	}, sentry.CheckRelayPaymentProfitability)
+}
+
+// reconcileRewards resubmits claimed relays that weren't paid and reports the epochs whose claims settled
+func reconcileRewards() {
+	reports := g_rewardsReconciler.Reconcile(context.Background(), g_sentry.GetBlockHeight(), g_sentry.GetEarliestSavedBlock())
+	for _, report := range reports {
+		utils.LavaFormatInfo("epoch rewards report", &map[string]string{
+			"epoch":     strconv.FormatUint(report.Epoch, 10),
+			"relays":    strconv.Itoa(report.Relays),
+			"claimedCU": strconv.FormatUint(report.Claimed, 10),
+			"paidCU":    strconv.FormatUint(report.Paid, 10),
+			"lostCU":    strconv.FormatUint(report.Lost, 10),
+			"expiredCU": strconv.FormatUint(report.Expired, 10),
+		})
 	}
-	return summarizedResult, transactionResults
 }
 
 func getRelayUser(in *pairingtypes.RelayRequest) (tenderbytes.HexBytes, error) {
@@ -483,28 +472,53 @@ func updateSessionCu(sess *RelaySession, userSessions *UserSessions, serviceApi
 	return nil
 }
 
-func processUnsubscribeEthereum(subscriptionID string, userSessions *UserSessions) {
+func processUnsubscribeEthereum(subscriptionID string, userSessions *UserSessions) bool {
+	sub, ok := userSessions.Subs[subscriptionID]
+	if ok {
+		sub.disconnect()
+		delete(userSessions.Subs, subscriptionID)
+	}
+	return ok
+}
+
+// closeSubscription disconnects a subscription and deletes it from the subs map
+func closeSubscription(userSessions *UserSessions, subscriptionID string) {
+	userSessions.Lock.Lock()
+	defer userSessions.Lock.Unlock()
 	if sub, ok := userSessions.Subs[subscriptionID]; ok {
 		sub.disconnect()
 		delete(userSessions.Subs, subscriptionID)
 	}
 }
 
-func processUnsubscribeTendermint(apiName string, subscriptionID string, userSessions *UserSessions) {
+func processUnsubscribeTendermint(apiName string, subscriptionID string, userSessions *UserSessions) bool {
 	if apiName == "unsubscribe" {
-		if sub, ok := userSessions.Subs[subscriptionID]; ok {
-			sub.disconnect()
-			delete(userSessions.Subs, subscriptionID)
-		}
-	} else {
-		for subscriptionID, sub := range userSessions.Subs {
-			sub.disconnect()
-			delete(userSessions.Subs, subscriptionID)
-		}
+		return processUnsubscribeEthereum(subscriptionID, userSessions)
+	}
+	found := len(userSessions.Subs) > 0
+	for subscriptionID, sub := range userSessions.Subs {
+		sub.disconnect()
+		delete(userSessions.Subs, subscriptionID)
+	}
+	return found
+}
+
+// unsubscribeReply returns the node reply to an unsubscribe of subscriptions the consumer had
+func unsubscribeReply(reqMsg *chainproxy.JsonrpcMessage, apiName string) (*pairingtypes.RelayReply, error) {
+	result := json.RawMessage("true")
+	if apiName == "unsubscribe" || apiName == "unsubscribe_all" {
+		// tendermint
+		result = json.RawMessage("{}")
 	}
+	data, err := json.Marshal(chainproxy.JsonrpcMessage{Version: reqMsg.Version, ID: reqMsg.ID, Result: result})
+	if err != nil {
+		return nil, utils.LavaFormatError("failed marshaling unsubscribe reply", err, nil)
+	}
+	return &pairingtypes.RelayReply{Data: data}, nil
 }
 
-func processUnsubscribe(apiName string, userAddr sdk.AccAddress, reqParams interface{}) error {
+// processUnsubscribe closes the subscriptions of the consumer the request references, returns whether there were any
+func processUnsubscribe(apiName string, userAddr sdk.AccAddress, reqParams interface{}) (bool, error) {
 	userSessions := getOrCreateUserSessions(userAddr.String())
 	userSessions.Lock.Lock()
 	defer userSessions.Lock.Unlock()
@@ -512,21 +526,21 @@ func processUnsubscribe(apiName string, userAddr sdk.AccAddress, reqParams inter
 	case []interface{}:
 		subscriptionID, ok := p[0].(string)
 		if !ok {
-			return fmt.Errorf("processUnsubscribe - p[0].(string) - type assertion failed, type:" + fmt.Sprintf("%s", p[0]))
+			return false, fmt.Errorf("processUnsubscribe - p[0].(string) - type assertion failed, type:" + fmt.Sprintf("%s", p[0]))
 		}
-		processUnsubscribeEthereum(subscriptionID, userSessions)
+		return processUnsubscribeEthereum(subscriptionID, userSessions), nil
 	case map[string]interface{}:
 		subscriptionID := ""
 		if apiName == "unsubscribe" {
 			var ok bool
 			subscriptionID, ok = p["query"].(string)
 			if !ok {
-				return fmt.Errorf("processUnsubscribe - p['query'].(string) - type assertion failed, type:" + fmt.Sprintf("%s", p["query"]))
+				return false, fmt.Errorf("processUnsubscribe - p['query'].(string) - type assertion failed, type:" + fmt.Sprintf("%s", p["query"]))
 			}
 		}
-		processUnsubscribeTendermint(apiName, subscriptionID, userSessions)
+		return processUnsubscribeTendermint(apiName, subscriptionID, userSessions), nil
 	}
-	return nil
+	return false, nil
 }
 
 func (s *relayServer) initRelay(ctx context.Context, request *pairingtypes.RelayRequest) (sdk.AccAddress, chainproxy.NodeMessage, *UserSessions, *RelaySession, error) {
@@ -539,9 +553,9 @@ func (s *relayServer) initRelay(ctx context.Context, request *pairingtypes.Relay
 	}
 
 	// Checks
-	if g_sentry.Acc != request.Provider {
+	if g_sentry.ProviderAcc != request.Provider {
 		return nil, nil, nil, nil, utils.LavaFormatError("User is trying to communicate with the wrong provider address.", nil, &map[string]string{
-			"ProviderWhoGotTheRequest": g_sentry.Acc,
+			"ProviderWhoGotTheRequest": g_sentry.ProviderAcc,
 			"ProviderInTheRequest":     request.Provider,
 		})
 	}
@@ -567,7 +581,7 @@ func (s *relayServer) initRelay(ctx context.Context, request *pairingtypes.Relay
 			return nil, nil, utils.LavaFormatError("user not authorized or error occurred", err, &map[string]string{"userAddr": userAddr.String(), "block": strconv.FormatUint(blockHeightToAuthorize, 10), "userRequest": fmt.Sprintf("%+v", request)})
 		}
 		// Parse message, check valid api, etc
-		nodeMsg, err := g_chainProxy.ParseMsg(request.ApiUrl, request.Data, request.ConnectionType)
+		nodeMsg, err := g_chainProxy.ParseMsg(request.ApiUrl, request.Data, request.ConnectionType, request.Metadata)
 		if err != nil {
 			return nil, nil, utils.LavaFormatError("failed parsing request message", err, &map[string]string{"apiInterface": g_sentry.ApiInterface, "request URL": request.ApiUrl, "request data": string(request.Data), "userAddr": userAddr.String()})
 		}
@@ -770,7 +784,18 @@ func (s *relayServer) Relay(ctx context.Context, request *pairingtypes.RelayRequ
 	return reply, err
 }
 
-func (s *relayServer) TryRelay(ctx context.Context, request *pairingtypes.RelayRequest, userAddr sdk.AccAddress, nodeMsg chainproxy.NodeMessage) (*pairingtypes.RelayReply, error) {
+// TryRelay sends the relay to the node and signs its reply, traced as a child of the consumer relay in ctx
+func (s *relayServer) TryRelay(ctx context.Context, request *pairingtypes.RelayRequest, userAddr sdk.AccAddress, nodeMsg chainproxy.NodeMessage) (reply *pairingtypes.RelayReply, err error) {
+	ctx, span := tracing.StartSpan(ctx, "TryRelay", trace.SpanKindInternal)
+	span.SetAttributes(
+		attribute.String("chain_id", request.ChainID),
+		attribute.String("method", nodeMsg.GetServiceApi().Name),
+		attribute.Bool("data_reliability", request.DataReliability != nil),
+	)
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
 	// Send
 	var reqMsg *chainproxy.JsonrpcMessage
 	var reqParams interface{}
@@ -806,12 +831,32 @@ func (s *relayServer) TryRelay(ctx context.Context, request *pairingtypes.RelayR
 		}
 
 		finalized = g_sentry.IsFinalizedBlock(request.RequestBlock, latestBlock)
+	} else if g_chainSentry != nil {
+		// only the hash is used, to key the cache by the block. reorged entries are invalidated by the chain sentry
+		_, _, requestedBlockHashStr, err := g_chainSentry.GetLatestBlockData(request.RequestBlock)
+		if err == nil && requestedBlockHashStr != "" {
+			requestedBlockHash = []byte(requestedBlockHashStr)
+		}
 	}
 	cache := g_chainProxy.GetCache()
-	// TODO: handle cache on fork for dataReliability = false
-	var reply *pairingtypes.RelayReply = nil
-	var err error = nil
-	if requestedBlockHash != nil || finalized {
+	apiName := nodeMsg.GetServiceApi().Name
+	if reqMsg != nil && strings.Contains(apiName, "unsubscribe") {
+		// node subscriptions are shared, the node doesn't know the subscription ids of consumers
+		unsubscribed, err := processUnsubscribe(apiName, userAddr, reqParams)
+		if err != nil {
+			return nil, err
+		}
+		if unsubscribed {
+			reply, err = unsubscribeReply(reqMsg, apiName)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	// state changing relays must reach the node every time
+	stateful := nodeMsg.GetInterface().Category.Stateful == spectypes.CONTEXT_STATE
+	useCache := reply == nil && !stateful && (requestedBlockHash != nil || finalized)
+	if useCache {
 		reply, err = cache.GetEntry(ctx, request, g_sentry.ApiInterface, requestedBlockHash, g_sentry.ChainID, finalized)
 	}
 	if err != nil || reply == nil {
@@ -819,11 +864,30 @@ func (s *relayServer) TryRelay(ctx context.Context, request *pairingtypes.RelayR
 			utils.LavaFormatWarning("cache not connected", err, nil)
 		}
 		// cache miss or invalid
-		reply, _, _, err = nodeMsg.Send(ctx, nil)
+		nodeCtx, nodeSpan := tracing.StartSpan(ctx, "node.call", trace.SpanKindClient)
+		nodeSpan.SetAttributes(attribute.String("method", apiName))
+		if key, ok := chainproxy.CoalescingKey(g_sentry.ChainID, g_sentry.ApiInterface, nodeMsg, request.ApiUrl, request.Data, request.Metadata); ok {
+			// identical requests of consumers reach the node once, the node call isn't canceled with the consumer that started it
+			sentToNode := false
+			reply, err = g_relayCoalescer.Do(key, request.Data, func() (*pairingtypes.RelayReply, error) {
+				sentToNode = true
+				// the shared node call is traced under the relay that made it, the others only wait for its reply
+				sendCtx, sendSpan := tracing.StartSpan(chainproxy.DetachedContext(nodeCtx), "node.send", trace.SpanKindClient)
+				reply, _, _, err := nodeMsg.Send(sendCtx, nil)
+				tracing.RecordError(sendSpan, err)
+				sendSpan.End()
+				return reply, err
+			})
+			nodeSpan.SetAttributes(attribute.Bool("coalesced", !sentToNode))
+		} else {
+			reply, _, _, err = nodeMsg.Send(nodeCtx, nil)
+		}
+		tracing.RecordError(nodeSpan, err)
+		nodeSpan.End()
 		if err != nil {
 			return nil, utils.LavaFormatError("Sending nodeMsg failed", err, nil)
 		}
-		if requestedBlockHash != nil || finalized {
+		if useCache {
 			err := cache.SetEntry(ctx, request, g_sentry.ApiInterface, requestedBlockHash, g_sentry.ChainID, userAddr.String(), reply, finalized)
 			if err != nil && !performance.NotInitialisedError.Is(err) {
 				utils.LavaFormatWarning("error updating cache with new entry", err, nil)
@@ -831,13 +895,6 @@ func (s *relayServer) TryRelay(ctx context.Context, request *pairingtypes.RelayR
 		}
 	}
 
-	apiName := nodeMsg.GetServiceApi().Name
-	if reqMsg != nil && strings.Contains(apiName, "unsubscribe") {
-		err := processUnsubscribe(apiName, userAddr, reqParams)
-		if err != nil {
-			return nil, err
-		}
-	}
 	// TODO: verify that the consumer still listens, if it took to much time to get the response we cant update the CU.
 
 	jsonStr, err := json.Marshal(finalizedBlockHashes)
@@ -854,7 +911,7 @@ func (s *relayServer) TryRelay(ctx context.Context, request *pairingtypes.RelayR
 		// update relay request requestedBlock to the provided one in case it was arbitrary
 		sentry.UpdateRequestedBlock(&request, reply)
 		// Update signature,
-		sig, err := sigs.SignRelayResponse(g_privKey, reply, &request)
+		sig, err := g_signer.SignRelayResponse(reply, &request)
 		if err != nil {
 			return utils.LavaFormatError("failed signing relay response", err,
 				&map[string]string{"request": fmt.Sprintf("%v", request), "reply": fmt.Sprintf("%v", reply)})
@@ -863,7 +920,7 @@ func (s *relayServer) TryRelay(ctx context.Context, request *pairingtypes.RelayR
 
 		if g_sentry.GetSpecDataReliabilityEnabled() {
 			// update sig blocks signature
-			sigBlocks, err := sigs.SignResponseFinalizationData(g_privKey, reply, &request, userAddr)
+			sigBlocks, err := g_signer.SignResponseFinalizationData(reply, &request, userAddr)
 			if err != nil {
 				return utils.LavaFormatError("failed signing finalization data", err,
 					&map[string]string{"request": fmt.Sprintf("%v", request), "reply": fmt.Sprintf("%v", reply), "userAddr": userAddr.String()})
@@ -890,8 +947,10 @@ func (s *relayServer) RelaySubscribe(request *pairingtypes.RelayRequest, srv pai
 		return err
 	}
 
-	err = s.TryRelaySubscribe(request, srv, nodeMsg, userSessions)
-	if err != nil && request.DataReliability == nil { // we ignore data reliability because its not checking/adding cu/relaynum.
+	established, err := s.TryRelaySubscribe(request, srv, nodeMsg, userSessions)
+	// the session of an established billed subscription carries its payments, the subscribe relay stays counted
+	billedSession := established && nodeMsg.GetServiceApi().SubscriptionBilling.IsBilled()
+	if err != nil && request.DataReliability == nil && !billedSession { // we ignore data reliability because its not checking/adding cu/relaynum.
 		// failed to send relay. we need to adjust session state. cuSum and relayNumber.
 		relayFailureError := s.onRelayFailure(userSessions, relaySession, nodeMsg)
 		if relayFailureError != nil {
@@ -901,24 +960,30 @@ func (s *relayServer) RelaySubscribe(request *pairingtypes.RelayRequest, srv pai
 	return err
 }
 
-func (s *relayServer) TryRelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer, nodeMsg chainproxy.NodeMessage, userSessions *UserSessions) error {
-	var reply *pairingtypes.RelayReply
-	var clientSub *rpcclient.ClientSubscription
-	var subscriptionID string
-	subscribeRepliesChan := make(chan interface{})
-	reply, subscriptionID, clientSub, err := nodeMsg.Send(context.Background(), subscribeRepliesChan)
+// TryRelaySubscribe streams the subscription until it is closed, established is false when it wasn't opened
+func (s *relayServer) TryRelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer, nodeMsg chainproxy.NodeMessage, userSessions *UserSessions) (established bool, err error) {
+	key := chainproxy.SubscriptionMultiplexingKey(g_sentry.ChainID, g_sentry.ApiInterface, request.ApiUrl, request.Data)
+	clientSub, reply, err := g_subscriptionMuxer.Subscribe(key, request.Data, func(ch chan interface{}) (*pairingtypes.RelayReply, string, chainproxy.UpstreamSubscription, error) {
+		reply, subscriptionID, nodeSub, err := nodeMsg.Send(context.Background(), ch)
+		return reply, subscriptionID, nodeSub, err
+	})
 	if err != nil {
-		return utils.LavaFormatError("Subscription failed", err, nil)
+		return false, utils.LavaFormatError("Subscription failed", err, nil)
 	}
+	subscriptionID := clientSub.ID()
 
 	userSessions.Lock.Lock()
 	if _, ok := userSessions.Subs[subscriptionID]; ok {
-		return utils.LavaFormatError("SubscriptiodID: "+subscriptionID+"exists", nil, nil)
+		userSessions.Lock.Unlock()
+		clientSub.Unsubscribe()
+		return false, utils.LavaFormatError("SubscriptiodID: "+subscriptionID+"exists", nil, nil)
 	}
 	userSessions.Subs[subscriptionID] = &subscription{
-		id:                   subscriptionID,
-		sub:                  clientSub,
-		subscribeRepliesChan: subscribeRepliesChan,
+		id:           subscriptionID,
+		sub:          clientSub,
+		sessionID:    request.SessionId,
+		pairingEpoch: uint64(request.BlockHeight),
+		lastPayment:  time.Now(),
 	}
 	userSessions.Lock.Unlock()
 
@@ -927,29 +992,33 @@ func (s *relayServer) TryRelaySubscribe(request *pairingtypes.RelayRequest, srv
 		utils.LavaFormatError("Error getting RPC ID", err, nil)
 	}
 
+	billing := nodeMsg.GetServiceApi().SubscriptionBilling
+	periodsChan, stopPeriods := subscriptionBillingTicker(billing)
+	defer stopPeriods()
 	for {
 		select {
-		case <-clientSub.Err():
+		case err := <-clientSub.Err():
 			utils.LavaFormatError("client sub", err, nil)
 			// delete this connection from the subs map
-			userSessions.Lock.Lock()
-			if sub, ok := userSessions.Subs[subscriptionID]; ok {
-				sub.disconnect()
-				delete(userSessions.Subs, subscriptionID)
+			closeSubscription(userSessions, subscriptionID)
+			return true, err
+		case <-periodsChan:
+			if err := chargeSubscription(userSessions, subscriptionID, billing.ComputeUnitsPerPeriod); err != nil {
+				closeSubscription(userSessions, subscriptionID)
+				return true, err
+			}
+		case subscribeReply := <-clientSub.Replies():
+			if billing.ComputeUnitsPerMessage > 0 {
+				if err := chargeSubscription(userSessions, subscriptionID, billing.ComputeUnitsPerMessage); err != nil {
+					closeSubscription(userSessions, subscriptionID)
+					return true, err
+				}
 			}
-			userSessions.Lock.Unlock()
-			return err
-		case subscribeReply := <-subscribeRepliesChan:
 			data, err := json.Marshal(subscribeReply)
 			if err != nil {
 				utils.LavaFormatError("client sub unmarshal", err, nil)
-				userSessions.Lock.Lock()
-				if sub, ok := userSessions.Subs[subscriptionID]; ok {
-					sub.disconnect()
-					delete(userSessions.Subs, subscriptionID)
-				}
-				userSessions.Lock.Unlock()
-				return err
+				closeSubscription(userSessions, subscriptionID)
+				return true, err
 			}
 
 			err = srv.Send(
@@ -964,13 +1033,8 @@ func (s *relayServer) TryRelaySubscribe(request *pairingtypes.RelayRequest, srv
 				} else {
 					utils.LavaFormatError("srv.Send", err, nil)
 				}
-				userSessions.Lock.Lock()
-				if sub, ok := userSessions.Subs[subscriptionID]; ok {
-					sub.disconnect()
-					delete(userSessions.Subs, subscriptionID)
-				}
-				userSessions.Lock.Unlock()
-				return err
+				closeSubscription(userSessions, subscriptionID)
+				return true, err
 			}
 
 			utils.LavaFormatInfo("Sending data", &map[string]string{"data": string(data)})
@@ -1010,9 +1074,7 @@ func (relayServ *relayServer) VerifyReliabilityAddressSigning(ctx context.Contex
 
 func SendVoteCommitment(voteID string, vote *voteData) {
 	msg := conflicttypes.NewMsgConflictVoteCommit(g_sentry.Acc, voteID, vote.CommitHash)
-	myWriter := bytes.Buffer{}
-	g_sentry.ClientCtx.Output = &myWriter
-	err := tx.GenerateOrBroadcastTxWithFactory(g_sentry.ClientCtx, g_txFactory, msg)
+	_, err := g_txSender.Send(context.Background(), msg)
 	if err != nil {
 		utils.LavaFormatError("failed to send vote commitment", err, nil)
 	}
@@ -1020,9 +1082,7 @@ func SendVoteCommitment(voteID string, vote *voteData) {
 
 func SendVoteReveal(voteID string, vote *voteData) {
 	msg := conflicttypes.NewMsgConflictVoteReveal(g_sentry.Acc, voteID, vote.Nonce, vote.RelayDataHash)
-	myWriter := bytes.Buffer{}
-	g_sentry.ClientCtx.Output = &myWriter
-	err := tx.GenerateOrBroadcastTxWithFactory(g_sentry.ClientCtx, g_txFactory, msg)
+	_, err := g_txSender.Send(context.Background(), msg)
 	if err != nil {
 		utils.LavaFormatError("failed to send vote Reveal", err, nil)
 	}
@@ -1092,7 +1152,7 @@ func voteEventHandler(ctx context.Context, voteID string, voteDeadline uint64, v
 		}
 		// we need to send a commit, first we need to use the chainProxy and get the response
 		// TODO: implement code that verified the requested block is finalized and if its not waits and tries again
-		nodeMsg, err := g_chainProxy.ParseMsg(voteParams.ApiURL, voteParams.RequestData, voteParams.ConnectionType)
+		nodeMsg, err := g_chainProxy.ParseMsg(voteParams.ApiURL, voteParams.RequestData, voteParams.ConnectionType, nil)
 		if err != nil {
 			utils.LavaFormatError("vote Request did not pass the api check on chain proxy", err,
 				&map[string]string{"voteID": voteID, "chainID": voteParams.ChainID})
@@ -1142,14 +1202,39 @@ func Server(
 	g_serverID = uint64(rand.Int63())
 
 	//
+	// Keys
+	remoteSigner, err := flagSet.GetString(sigs.RemoteSignerFlagName)
+	if err != nil {
+		utils.LavaFormatFatal("provider failure to read remote signer flag", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
+	}
+	g_signer, err = sigs.NewSigner(clientCtx, remoteSigner)
+	if err != nil {
+		utils.LavaFormatFatal("provider failure to load signer", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID, "remoteSigner": remoteSigner})
+	}
+	utils.LavaFormatInfo("Server loaded keys", &map[string]string{"PublicKey": g_signer.PubKey().Address().String(), "remoteSigner": remoteSigner})
+	clientCtx, txFactory = sigs.WithTxSigner(clientCtx, txFactory, g_signer)
+
+	// the provider account transactions
+	txSenderAddress, err := flagSet.GetString(txsender.TxSenderFlagName)
+	if err != nil {
+		utils.LavaFormatFatal("provider failure to read tx sender flag", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
+	}
+	g_txSender, err = txsender.NewSender(ctx, clientCtx, txFactory, txSenderAddress)
+	if err != nil {
+		utils.LavaFormatFatal("provider failure to load tx sender", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID, "txSender": txSenderAddress})
+	}
 
 	// Start newSentry
 	newSentry := sentry.NewSentry(clientCtx, txFactory, chainID, false, voteEventHandler, askForRewards, apiInterface, nil, flagSet, g_serverID)
-	err := newSentry.Init(ctx)
+	err = newSentry.Init(ctx)
 	if err != nil {
 		utils.LavaFormatError("sentry init failure to initialize", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
 		return
 	}
+	g_rewardsReconciler = rewards.NewReconciler(rewards.NewChainPaymentQuerier(pairingtypes.NewQueryClient(clientCtx), newSentry.ProviderAcc), sendRelayPayments)
+	newSentry.AddPaymentHandler(func(payment sentry.PaymentRequest) {
+		g_rewardsReconciler.PaymentReceived(payment.Client, payment.UniqueIdentifier, chainID)
+	})
 	go newSentry.Start(ctx)
 	for newSentry.GetSpecHash() == nil {
 		time.Sleep(1 * time.Second)
@@ -1159,27 +1244,20 @@ func Server(
 	g_votes = map[string]*voteData{}
 	g_rewardsSessions = map[uint64][]*RelaySession{}
 	g_serverChainID = chainID
-	// allow more gas
-	g_txFactory = txFactory.WithGas(1000000)
 
 	//
 	// Info
 	utils.LavaFormatInfo("Server starting", &map[string]string{"listenAddr": listenAddr, "ChainID": newSentry.GetChainID(), "node": nodeUrl, "spec": newSentry.GetSpecName(), "api Interface": apiInterface})
 
-	//
-	// Keys
-	keyName, err := sigs.GetKeyName(clientCtx)
+	tracingEndpoint, err := flagSet.GetString(tracing.TracingEndpointFlagName)
 	if err != nil {
-		utils.LavaFormatFatal("provider failure to getKeyName", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
+		utils.LavaFormatFatal("provider failure to read tracing endpoint flag", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
 	}
-
-	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+	err = tracing.Init(tracingEndpoint, "lava-provider")
 	if err != nil {
-		utils.LavaFormatFatal("provider failure to getPrivKey", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
+		utils.LavaFormatFatal("provider failure to init tracing", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID, "endpoint": tracingEndpoint})
 	}
-	g_privKey = privKey
-	serverKey, _ := clientCtx.Keyring.Key(keyName)
-	utils.LavaFormatInfo("Server loaded keys", &map[string]string{"PublicKey": serverKey.GetPubKey().Address().String()})
+	defer tracing.Shutdown()
 	//
 	// Node
 	// get portal logs
@@ -1187,37 +1265,58 @@ func Server(
 	if err != nil {
 		utils.LavaFormatFatal("provider failure to NewPortalLogs", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
 	}
-	chainProxy, err := chainproxy.GetChainProxy(nodeUrl, 1, newSentry, pLogs)
+	archiveNodeUrls, err := flagSet.GetString(chainproxy.ArchiveNodeUrlsFlagName)
+	if err != nil {
+		utils.LavaFormatFatal("provider failure to read archive node urls flag", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
+	}
+	maxNodeConns, err := flagSet.GetUint(chainproxy.MaxNodeConnsFlagName)
+	if err != nil {
+		utils.LavaFormatFatal("provider failure to read max node connections flag", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
+	}
+	chainProxy, err := chainproxy.GetChainProxy(chainproxy.ParseNodeUrls(nodeUrl), chainproxy.ParseNodeUrls(archiveNodeUrls), 1, maxNodeConns, newSentry, pLogs)
 	if err != nil {
 		utils.LavaFormatFatal("provider failure to GetChainProxy", err, &map[string]string{"apiInterface": apiInterface, "ChainID": chainID})
 	}
 	chainProxy.Start(ctx)
 	g_chainProxy = chainProxy
 
-	if g_sentry.GetSpecDataReliabilityEnabled() {
-		// Start chain sentry
-		chainSentry := chainsentry.NewChainSentry(clientCtx, chainProxy, chainID)
-		var chainSentryInitError error
-		errMapInfo := &map[string]string{"apiInterface": apiInterface, "ChainID": chainID, "nodeUrl": nodeUrl}
-		for attempt := 0; attempt < RetryInitAttempts; attempt++ {
-			chainSentryInitError = chainSentry.Init(ctx)
-			if chainSentryInitError != nil {
-				if chainsentry.ErrorFailedToFetchLatestBlock.Is(chainSentryInitError) { // we allow ErrorFailedToFetchLatestBlock. to retry
-					utils.LavaFormatWarning(fmt.Sprintf("chainSentry Init failed. Attempt Number: %d/%d, Retrying in %d seconds",
-						attempt+1, RetryInitAttempts, TimeWaitInitializeChainSentry), nil, nil)
-					time.Sleep(TimeWaitInitializeChainSentry * time.Second)
-					continue
-				} else { // other errors are currently fatal.
-					utils.LavaFormatFatal("Provider Init failure", chainSentryInitError, errMapInfo)
-				}
-			}
-			// break when chainSentry was initialized successfully
+	// Start chain sentry, specs without data reliability use it for the block hashes of the cache
+	dataReliabilityEnabled := g_sentry.GetSpecDataReliabilityEnabled()
+	chainSentry := chainsentry.NewChainSentry(clientCtx, chainProxy, chainID)
+	var chainSentryInitError error
+	errMapInfo := &map[string]string{"apiInterface": apiInterface, "ChainID": chainID, "nodeUrl": nodeUrl}
+	for attempt := 0; attempt < RetryInitAttempts; attempt++ {
+		chainSentryInitError = chainSentry.Init(ctx)
+		if chainSentryInitError != nil && !dataReliabilityEnabled {
+			// not all specs without data reliability support fetching block hashes, the provider runs without them
 			break
 		}
 		if chainSentryInitError != nil {
-			utils.LavaFormatFatal("provider failure initializing chainSentry - nodeUrl might be unreachable or offline", chainSentryInitError, errMapInfo)
+			if chainsentry.ErrorFailedToFetchLatestBlock.Is(chainSentryInitError) { // we allow ErrorFailedToFetchLatestBlock. to retry
+				utils.LavaFormatWarning(fmt.Sprintf("chainSentry Init failed. Attempt Number: %d/%d, Retrying in %d seconds",
+					attempt+1, RetryInitAttempts, TimeWaitInitializeChainSentry), nil, nil)
+				time.Sleep(TimeWaitInitializeChainSentry * time.Second)
+				continue
+			} else { // other errors are currently fatal.
+				utils.LavaFormatFatal("Provider Init failure", chainSentryInitError, errMapInfo)
+			}
 		}
+		// break when chainSentry was initialized successfully
+		break
+	}
+	if chainSentryInitError != nil && dataReliabilityEnabled {
+		utils.LavaFormatFatal("provider failure initializing chainSentry - nodeUrl might be unreachable or offline", chainSentryInitError, errMapInfo)
+	}
 
+	if chainSentryInitError != nil {
+		utils.LavaFormatWarning("chainSentry Init failed, relays won't use block hashes for the cache", chainSentryInitError, errMapInfo)
+	} else {
+		chainSentry.AddReorgHandler(func(reorg chainsentry.ReorgEvent) {
+			err := g_chainProxy.GetCache().InvalidateRange(ctx, chainID, apiInterface, reorg.FromBlock, reorg.ToBlock)
+			if err != nil && !performance.NotInitialisedError.Is(err) {
+				utils.LavaFormatWarning("failed invalidating reorged blocks in cache", err, &map[string]string{"fromBlock": strconv.FormatInt(reorg.FromBlock, 10), "toBlock": strconv.FormatInt(reorg.ToBlock, 10)})
+			}
+		})
 		chainSentry.Start(ctx)
 		g_chainSentry = chainSentry
 	}
@@ -1228,7 +1327,7 @@ func Server(
 	if err != nil {
 		utils.LavaFormatFatal("provider failure setting up listener", err, &map[string]string{"listenAddr": listenAddr, "ChainID": chainID})
 	}
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.UnaryInterceptor(tracing.UnaryServerInterceptor()), grpc.StreamInterceptor(tracing.StreamServerInterceptor()))
 
 	wrappedServer := grpcweb.WrapServer(s)
 	handler := func(resp http.ResponseWriter, req *http.Request) {
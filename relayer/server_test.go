@@ -0,0 +1,149 @@
+package relayer
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/lavanet/lava/relayer/chainproxy"
+	"github.com/lavanet/lava/relayer/chainproxy/rpcclient"
+	"github.com/lavanet/lava/relayer/lavasession"
+	"github.com/lavanet/lava/relayer/sentry"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/tracing"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// testNodeMessage is a deterministic relay, its node replies with data
+type testNodeMessage struct {
+	serviceApi *spectypes.ServiceApi
+	data       []byte
+}
+
+func (nm *testNodeMessage) GetServiceApi() *spectypes.ServiceApi {
+	return nm.serviceApi
+}
+
+func (nm *testNodeMessage) GetInterface() *spectypes.ApiInterface {
+	return &nm.serviceApi.ApiInterfaces[0]
+}
+
+func (nm *testNodeMessage) Send(ctx context.Context, ch chan interface{}) (*pairingtypes.RelayReply, string, *rpcclient.ClientSubscription, error) {
+	return &pairingtypes.RelayReply{Data: nm.data}, "", nil, nil
+}
+
+func (nm *testNodeMessage) RequestedBlock() int64 {
+	return spectypes.NOT_APPLICABLE
+}
+
+func (nm *testNodeMessage) GetMsg() interface{} {
+	return nil
+}
+
+// testChainProxy parses every request to the node message, without a spec
+type testChainProxy struct {
+	chainproxy.ChainProxy
+	nodeMsg chainproxy.NodeMessage
+}
+
+func (cp *testChainProxy) ParseMsg(url string, data []byte, connectionType string, metadata []pairingtypes.Metadata) (chainproxy.NodeMessage, error) {
+	return cp.nodeMsg, nil
+}
+
+// testProviderRelayer serves the relays with the provider TryRelay, the consumer authorization isn't checked
+type testProviderRelayer struct {
+	pairingtypes.UnimplementedRelayerServer
+	server  *relayServer
+	nodeMsg chainproxy.NodeMessage
+}
+
+func (tr *testProviderRelayer) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+	userAddr, err := getRelayUser(request)
+	if err != nil {
+		return nil, err
+	}
+	return tr.server.TryRelay(ctx, request, userAddr.Bytes(), tr.nodeMsg)
+}
+
+func TestRelayTraceFromPortalToNode(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	serviceApi := &spectypes.ServiceApi{Name: "eth_chainId", ComputeUnits: 10, ApiInterfaces: []spectypes.ApiInterface{{Type: "POST", Category: &spectypes.SpecCategory{Deterministic: true}}}}
+	nodeMsg := &testNodeMessage{serviceApi: serviceApi, data: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)}
+
+	// the provider, its grpc server is traced like the one of Server
+	providerSk, providerAddr := sigs.GenerateFloatingKey()
+	g_signer = sigs.NewKeyringSigner(providerSk)
+	g_sentry = &sentry.Sentry{ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC}
+	g_chainProxy = chainproxy.NewJrpcChainProxy(nil, 0, 0, g_sentry, nil, nil)
+	t.Cleanup(func() { g_signer, g_sentry, g_chainProxy = nil, nil, nil })
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	server := grpc.NewServer(grpc.UnaryInterceptor(tracing.UnaryServerInterceptor()), grpc.StreamInterceptor(tracing.StreamServerInterceptor()))
+	pairingtypes.RegisterRelayerServer(server, &testProviderRelayer{server: &relayServer{}, nodeMsg: nodeMsg})
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	// the portal, paired with the provider
+	csm := &lavasession.ConsumerSessionManager{}
+	require.Nil(t, csm.UpdateAllProviders(context.Background(), 20, []*lavasession.ConsumerSessionsWithProvider{{
+		Acc:             providerAddr.String(),
+		Endpoints:       []*lavasession.Endpoint{{Addr: lis.Addr().String(), Enabled: true}},
+		Sessions:        map[int64]*lavasession.SingleConsumerSession{},
+		MaxComputeUnits: 200,
+		PairingEpoch:    20,
+	}}))
+	cp := &testChainProxy{
+		ChainProxy: chainproxy.NewJrpcChainProxy(nil, 0, 0, &sentry.Sentry{ChainID: "ETH1", ApiInterface: spectypes.APIInterfaceJsonRPC}, csm, nil),
+		nodeMsg:    nodeMsg,
+	}
+	consumerSk, _ := sigs.GenerateFloatingKey()
+
+	ctx, portalSpan := chainproxy.StartPortalSpan(context.Background(), cp, "jsonRpc-http post")
+	reply, _, provenance, err := chainproxy.SendRelay(ctx, cp, sigs.NewKeyringSigner(consumerSk), "", `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`, "POST", "dapp", "", nil, nil)
+	portalSpan.End()
+	require.Nil(t, err)
+	require.Equal(t, providerAddr.String(), provenance.ProviderAddress)
+	require.Equal(t, nodeMsg.data, reply.Data)
+
+	// the grpc server span is named like the client one
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		name := span.Name
+		if span.SpanKind == trace.SpanKindServer && name != "portal.handler" {
+			name = "provider " + name
+		}
+		spans[name] = span
+	}
+	require.Len(t, spans, 8)
+	// a single connected trace, from the portal handler down to the provider node call
+	parents := map[string]string{
+		"SendRelay":                          "portal.handler",
+		"GetSession":                         "SendRelay",
+		"lavanet.lava.pairing.Relayer/Relay": "SendRelay",
+		"provider lavanet.lava.pairing.Relayer/Relay": "lavanet.lava.pairing.Relayer/Relay",
+		"TryRelay":  "provider lavanet.lava.pairing.Relayer/Relay",
+		"node.call": "TryRelay",
+		"node.send": "node.call",
+	}
+	traceID := spans["portal.handler"].SpanContext.TraceID()
+	require.False(t, spans["portal.handler"].Parent.IsValid())
+	for name, span := range spans {
+		require.Equal(t, traceID, span.SpanContext.TraceID(), name)
+	}
+	for child, parent := range parents {
+		require.Equal(t, spans[parent].SpanContext.SpanID(), spans[child].Parent.SpanID(), child)
+	}
+	require.Contains(t, spans["node.call"].Attributes, attribute.Bool("coalesced", false))
+	require.Contains(t, spans["SendRelay"].Attributes, attribute.String("provider", providerAddr.String()))
+}
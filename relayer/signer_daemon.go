@@ -0,0 +1,46 @@
+package relayer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+)
+
+// SignerDaemon signs the relays and transactions of the --from account for servers and portals started with
+// --remote-signer, within the policy. it holds the key so they don't have to
+func SignerDaemon(ctx context.Context, clientCtx client.Context, listenAddr string, policy sigs.SignerPolicy) error {
+	if len(policy.ChainIDs) == 0 {
+		return utils.LavaFormatError("signer policy allows no chains", nil, nil)
+	}
+	if clientCtx.ChainID == "" {
+		return utils.LavaFormatError("signer has no lava chain id to sign transactions of", nil, nil)
+	}
+	policy.LavaChainID = clientCtx.ChainID
+	keyName, err := sigs.GetKeyName(clientCtx)
+	if err != nil {
+		return utils.LavaFormatError("signer failure to getKeyName", err, nil)
+	}
+	privKey, err := sigs.GetPrivKey(clientCtx, keyName)
+	if err != nil {
+		return utils.LavaFormatError("signer failure to getPrivKey", err, nil)
+	}
+	signer := sigs.NewKeyringSigner(privKey)
+
+	listener, err := sigs.SignerListen(listenAddr)
+	if err != nil {
+		return utils.LavaFormatError("signer failure to listen", err, &map[string]string{"listenAddr": listenAddr})
+	}
+	grpcServer := grpc.NewServer()
+	pairingtypes.RegisterRelaySignerServer(grpcServer, sigs.NewSignerServer(signer, policy))
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+	utils.LavaFormatInfo("Signer started", &map[string]string{"listenAddr": listenAddr, "address": sigs.SignerAddress(signer).String(), "chains": strings.Join(policy.ChainIDs, ",")})
+	return grpcServer.Serve(listener)
+}
@@ -0,0 +1,258 @@
+package sigs
+
+//
+// Remote signing: relayer processes serving public traffic can sign relays and transactions with a key held by a signer
+// daemon, over a unix socket of the same host. the daemon signs relay structs of the chains its policy allows, vrf data
+// that carries no chain, and transactions of the lava chain made only of the messages a relayer sends (i.e no bank sends)
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	unixSocketPrefix    = "unix://"
+	remoteSignerTimeout = 5 * time.Second
+)
+
+// RemoteSigner signs with the key of a signer daemon
+type RemoteSigner struct {
+	conn   *grpc.ClientConn
+	client pairingtypes.RelaySignerClient
+	pubKey secp256k1.PubKey
+}
+
+// NewRemoteSigner connects to the signer daemon listening on the unix socket at address, unix:///path
+func NewRemoteSigner(address string) (*RemoteSigner, error) {
+	if !strings.HasPrefix(address, unixSocketPrefix) {
+		return nil, utils.LavaFormatError("remote signer address isn't a unix socket", nil, &map[string]string{"address": address})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		return nil, utils.LavaFormatError("failed connecting to remote signer", err, &map[string]string{"address": address})
+	}
+	client := pairingtypes.NewRelaySignerClient(conn)
+	reply, err := client.PubKey(ctx, &emptypb.Empty{})
+	if err != nil {
+		conn.Close()
+		return nil, utils.LavaFormatError("failed getting remote signer public key", err, &map[string]string{"address": address})
+	}
+	if len(reply.PubKey) != secp256k1.PubKeySize {
+		conn.Close()
+		return nil, utils.LavaFormatError("remote signer returned an invalid public key", nil, &map[string]string{"address": address})
+	}
+	return &RemoteSigner{conn: conn, client: client, pubKey: secp256k1.PubKey(reply.PubKey)}, nil
+}
+
+func (rs *RemoteSigner) Close() error {
+	return rs.conn.Close()
+}
+
+func (rs *RemoteSigner) PubKey() secp256k1.PubKey {
+	return rs.pubKey
+}
+
+func (rs *RemoteSigner) SignRelay(request pairingtypes.RelayRequest) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	reply, err := rs.client.SignRelay(ctx, &request)
+	if err != nil {
+		return nil, err
+	}
+	return reply.Sig, nil
+}
+
+func (rs *RemoteSigner) SignVRFData(vrfData *pairingtypes.VRFData) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	reply, err := rs.client.SignVRFData(ctx, vrfData)
+	if err != nil {
+		return nil, err
+	}
+	return reply.Sig, nil
+}
+
+func (rs *RemoteSigner) SignRelayResponse(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	reply, err := rs.client.SignRelayResponse(ctx, &pairingtypes.SignRelayResponseRequest{Reply: relayResponse, Request: relayReq})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Sig, nil
+}
+
+func (rs *RemoteSigner) SignResponseFinalizationData(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	reply, err := rs.client.SignResponseFinalizationData(ctx, &pairingtypes.SignRelayResponseRequest{Reply: relayResponse, Request: relayReq, ClientAddress: clientAddress})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Sig, nil
+}
+
+func (rs *RemoteSigner) SignTx(signDoc []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	reply, err := rs.client.SignTx(ctx, &pairingtypes.SignTxRequest{SignDoc: signDoc})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Sig, nil
+}
+
+// relayerTxMsgTypes are the messages of the transactions relayers send, transactions with other messages aren't signed
+var relayerTxMsgTypes = map[string]struct{}{
+	sdk.MsgTypeURL(&pairingtypes.MsgRelayPayment{}):        {},
+	sdk.MsgTypeURL(&conflicttypes.MsgDetection{}):          {},
+	sdk.MsgTypeURL(&conflicttypes.MsgConflictVoteCommit{}): {},
+	sdk.MsgTypeURL(&conflicttypes.MsgConflictVoteReveal{}): {},
+}
+
+// IsRelayerTxMsg returns true for the messages of the transactions relayers send
+func IsRelayerTxMsg(typeURL string) bool {
+	_, ok := relayerTxMsgTypes[typeURL]
+	return ok
+}
+
+// SignerPolicy is what a signer daemon agrees to sign
+type SignerPolicy struct {
+	ChainIDs    []string // relays of other chains are refused
+	LavaChainID string   // transactions of other chains are refused
+}
+
+func (sp SignerPolicy) allowsChain(chainID string) bool {
+	for _, allowed := range sp.ChainIDs {
+		if allowed == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+// SignerServer serves the signatures of a signer to remote signers within a policy
+type SignerServer struct {
+	pairingtypes.UnimplementedRelaySignerServer
+	signer Signer
+	policy SignerPolicy
+}
+
+func NewSignerServer(signer Signer, policy SignerPolicy) *SignerServer {
+	return &SignerServer{signer: signer, policy: policy}
+}
+
+func (ss *SignerServer) PubKey(ctx context.Context, _ *emptypb.Empty) (*pairingtypes.SignerPubKey, error) {
+	return &pairingtypes.SignerPubKey{PubKey: ss.signer.PubKey()}, nil
+}
+
+func (ss *SignerServer) SignRelay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.Signature, error) {
+	if err := ss.checkChain(request.ChainID, "relay"); err != nil {
+		return nil, err
+	}
+	sig, err := ss.signer.SignRelay(*request)
+	return &pairingtypes.Signature{Sig: sig}, err
+}
+
+// SignVRFData signs vrf data of any chain, the signed data doesn't name its chain
+func (ss *SignerServer) SignVRFData(ctx context.Context, vrfData *pairingtypes.VRFData) (*pairingtypes.Signature, error) {
+	sig, err := ss.signer.SignVRFData(vrfData)
+	return &pairingtypes.Signature{Sig: sig}, err
+}
+
+func (ss *SignerServer) SignRelayResponse(ctx context.Context, request *pairingtypes.SignRelayResponseRequest) (*pairingtypes.Signature, error) {
+	if request.Reply == nil || request.Request == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing relay reply or request")
+	}
+	if err := ss.checkChain(request.Request.ChainID, "relay response"); err != nil {
+		return nil, err
+	}
+	sig, err := ss.signer.SignRelayResponse(request.Reply, request.Request)
+	return &pairingtypes.Signature{Sig: sig}, err
+}
+
+func (ss *SignerServer) SignResponseFinalizationData(ctx context.Context, request *pairingtypes.SignRelayResponseRequest) (*pairingtypes.Signature, error) {
+	if request.Reply == nil || request.Request == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing relay reply or request")
+	}
+	if err := ss.checkChain(request.Request.ChainID, "finalization data"); err != nil {
+		return nil, err
+	}
+	sig, err := ss.signer.SignResponseFinalizationData(request.Reply, request.Request, request.ClientAddress)
+	return &pairingtypes.Signature{Sig: sig}, err
+}
+
+// SignTx signs direct mode sign docs of lava chain transactions made of relayer messages
+func (ss *SignerServer) SignTx(ctx context.Context, request *pairingtypes.SignTxRequest) (*pairingtypes.Signature, error) {
+	var signDoc txtypes.SignDoc
+	if err := signDoc.Unmarshal(request.SignDoc); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "sign doc isn't of the direct sign mode: "+err.Error())
+	}
+	if signDoc.ChainId != ss.policy.LavaChainID {
+		utils.LavaFormatWarning("signer refused signing a transaction of another chain", nil, &map[string]string{"chainID": signDoc.ChainId})
+		return nil, status.Error(codes.PermissionDenied, "transactions of chain "+signDoc.ChainId+" aren't allowed by the signer policy")
+	}
+	var body txtypes.TxBody
+	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction body: "+err.Error())
+	}
+	if len(body.Messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "transaction has no messages")
+	}
+	for _, msg := range body.Messages {
+		if !IsRelayerTxMsg(msg.TypeUrl) {
+			utils.LavaFormatWarning("signer refused signing a transaction with a message outside its policy", nil, &map[string]string{"msgType": msg.TypeUrl})
+			return nil, status.Error(codes.PermissionDenied, "message "+msg.TypeUrl+" isn't allowed by the signer policy")
+		}
+	}
+	sig, err := ss.signer.SignTx(request.SignDoc)
+	return &pairingtypes.Signature{Sig: sig}, err
+}
+
+func (ss *SignerServer) checkChain(chainID string, signed string) error {
+	if ss.policy.allowsChain(chainID) {
+		return nil
+	}
+	utils.LavaFormatWarning("signer refused signing "+signed+" of a chain outside its policy", nil, &map[string]string{"chainID": chainID})
+	return status.Error(codes.PermissionDenied, "chain "+chainID+" isn't allowed by the signer policy")
+}
+
+// SignerListen listens on the unix socket at address, unix:///path. signing isn't served over the network, it has no
+// authentication of its clients other than the socket permissions
+func SignerListen(address string) (net.Listener, error) {
+	if !strings.HasPrefix(address, unixSocketPrefix) {
+		return nil, utils.LavaFormatError("signer listen address isn't a unix socket", nil, &map[string]string{"address": address})
+	}
+	path := strings.TrimPrefix(address, unixSocketPrefix)
+	// a socket left by a previous run
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	// only the user running the signer can connect
+	if err := os.Chmod(path, 0o600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
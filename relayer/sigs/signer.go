@@ -0,0 +1,129 @@
+package sigs
+
+import (
+	btcSecp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cosmosSecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+const (
+	RemoteSignerFlagName  = "remote-signer"
+	AllowedChainsFlagName = "allowed-chains"
+)
+
+// Signer signs the relay structs and transactions of an account, the key may be held by the process or by a remote signer
+type Signer interface {
+	PubKey() secp256k1.PubKey
+	SignRelay(request pairingtypes.RelayRequest) ([]byte, error)
+	SignVRFData(vrfData *pairingtypes.VRFData) ([]byte, error)
+	SignRelayResponse(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) ([]byte, error)
+	SignResponseFinalizationData(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) ([]byte, error)
+	// SignTx signs the direct mode sign doc of a transaction
+	SignTx(signDoc []byte) ([]byte, error)
+}
+
+// SignerAddress returns the account address of the signer key
+func SignerAddress(signer Signer) sdk.AccAddress {
+	return sdk.AccAddress(signer.PubKey().Address())
+}
+
+// KeyringSigner signs with a private key loaded in the process
+type KeyringSigner struct {
+	privKey *btcSecp256k1.PrivateKey
+}
+
+func NewKeyringSigner(privKey *btcSecp256k1.PrivateKey) *KeyringSigner {
+	return &KeyringSigner{privKey: privKey}
+}
+
+func (ks *KeyringSigner) PubKey() secp256k1.PubKey {
+	return secp256k1.PubKey(ks.privKey.PubKey().SerializeCompressed())
+}
+
+func (ks *KeyringSigner) SignRelay(request pairingtypes.RelayRequest) ([]byte, error) {
+	return SignRelay(ks.privKey, request)
+}
+
+func (ks *KeyringSigner) SignVRFData(vrfData *pairingtypes.VRFData) ([]byte, error) {
+	return SignVRFData(ks.privKey, vrfData)
+}
+
+func (ks *KeyringSigner) SignRelayResponse(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) ([]byte, error) {
+	return SignRelayResponse(ks.privKey, relayResponse, relayReq)
+}
+
+func (ks *KeyringSigner) SignResponseFinalizationData(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest, clientAddress sdk.AccAddress) ([]byte, error) {
+	return SignResponseFinalizationData(ks.privKey, relayResponse, relayReq, clientAddress)
+}
+
+func (ks *KeyringSigner) SignTx(signDoc []byte) ([]byte, error) {
+	return (&cosmosSecp256k1.PrivKey{Key: ks.privKey.Serialize()}).Sign(signDoc)
+}
+
+// NewSigner returns the remote signer at remoteSigner, or the keyring key of the --from account when it is empty.
+// a remote signer has to hold the key of the --from account, the keyring then only needs its public key
+func NewSigner(clientCtx client.Context, remoteSigner string) (Signer, error) {
+	if remoteSigner == "" {
+		keyName, err := GetKeyName(clientCtx)
+		if err != nil {
+			return nil, err
+		}
+		privKey, err := GetPrivKey(clientCtx, keyName)
+		if err != nil {
+			return nil, err
+		}
+		return NewKeyringSigner(privKey), nil
+	}
+	signer, err := NewRemoteSigner(remoteSigner)
+	if err != nil {
+		return nil, err
+	}
+	if fromAddress := clientCtx.GetFromAddress(); !fromAddress.Equals(SignerAddress(signer)) {
+		signer.Close()
+		return nil, utils.LavaFormatError("remote signer key doesn't match the account", nil, &map[string]string{"remoteSigner": remoteSigner, "signerAddress": SignerAddress(signer).String(), "from": fromAddress.String()})
+	}
+	return signer, nil
+}
+
+// signerKeyring signs the transactions of the signer account with the signer, other keys are used as they are
+type signerKeyring struct {
+	keyring.Keyring
+	signer Signer
+}
+
+func (sk *signerKeyring) Sign(uid string, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	info, err := sk.Keyring.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sk.SignByAddress(info.GetAddress(), msg)
+}
+
+func (sk *signerKeyring) SignByAddress(address sdk.Address, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	if !SignerAddress(sk.signer).Equals(address) {
+		return sk.Keyring.SignByAddress(address, msg)
+	}
+	sig, err := sk.signer.SignTx(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, &cosmosSecp256k1.PubKey{Key: sk.signer.PubKey()}, nil
+}
+
+// WithTxSigner returns the client context and factory signing the transactions of the signer account with the
+// signer, in the direct sign mode a remote signer accepts. a keyring signer is the keyring key, nothing is changed
+func WithTxSigner(clientCtx client.Context, txFactory tx.Factory, signer Signer) (client.Context, tx.Factory) {
+	if _, ok := signer.(*KeyringSigner); ok {
+		return clientCtx, txFactory
+	}
+	kr := &signerKeyring{Keyring: clientCtx.Keyring, signer: signer}
+	return clientCtx.WithKeyring(kr), txFactory.WithKeybase(kr).WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+}
@@ -0,0 +1,155 @@
+package sigs
+
+import (
+	"path/filepath"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cosmosSecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startSigner serves a signer daemon of a new key on a unix socket
+func startSigner(t *testing.T, policy SignerPolicy) (*KeyringSigner, string) {
+	privKey, _ := GenerateFloatingKey()
+	signer := NewKeyringSigner(privKey)
+	address := "unix://" + filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := SignerListen(address)
+	require.Nil(t, err)
+	grpcServer := grpc.NewServer()
+	pairingtypes.RegisterRelaySignerServer(grpcServer, NewSignerServer(signer, policy))
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	return signer, address
+}
+
+func TestRemoteSigner(t *testing.T) {
+	localSigner, address := startSigner(t, SignerPolicy{ChainIDs: []string{"LAV1"}})
+	remoteSigner, err := NewRemoteSigner(address)
+	require.Nil(t, err)
+	defer remoteSigner.Close()
+	require.Equal(t, SignerAddress(localSigner), SignerAddress(remoteSigner))
+
+	request := pairingtypes.RelayRequest{ChainID: "LAV1", Provider: "provider", SessionId: 1, CuSum: 10, RelayNum: 1, BlockHeight: 20, Data: []byte("data")}
+	request.Sig, err = remoteSigner.SignRelay(request)
+	require.Nil(t, err)
+	pubKey, err := RecoverPubKeyFromRelay(request)
+	require.Nil(t, err)
+	require.Equal(t, SignerAddress(localSigner), sdk.AccAddress(pubKey.Address()))
+
+	reply := &pairingtypes.RelayReply{Data: []byte("reply"), Nonce: 5, LatestBlock: 30}
+	reply.Sig, err = remoteSigner.SignRelayResponse(reply, &request)
+	require.Nil(t, err)
+	pubKey, err = RecoverPubKeyFromRelayReply(reply, &request)
+	require.Nil(t, err)
+	require.Equal(t, SignerAddress(localSigner), sdk.AccAddress(pubKey.Address()))
+
+	clientAddress := SignerAddress(localSigner)
+	reply.SigBlocks, err = remoteSigner.SignResponseFinalizationData(reply, &request, clientAddress)
+	require.Nil(t, err)
+	pubKey, err = RecoverPubKeyFromResponseFinalizationData(reply, &request, clientAddress)
+	require.Nil(t, err)
+	require.Equal(t, SignerAddress(localSigner), sdk.AccAddress(pubKey.Address()))
+
+	vrfData := &pairingtypes.VRFData{Differentiator: true, VrfValue: []byte("value"), VrfProof: []byte("proof"), ProviderSig: []byte("sig"), AllDataHash: []byte("hash"), QueryHash: []byte("query")}
+	vrfData.Sig, err = remoteSigner.SignVRFData(vrfData)
+	require.Nil(t, err)
+	pubKey, err = RecoverPubKeyFromVRFData(*vrfData)
+	require.Nil(t, err)
+	require.Equal(t, SignerAddress(localSigner), sdk.AccAddress(pubKey.Address()))
+}
+
+func TestRemoteSignerPolicy(t *testing.T) {
+	_, address := startSigner(t, SignerPolicy{ChainIDs: []string{"LAV1"}})
+	remoteSigner, err := NewRemoteSigner(address)
+	require.Nil(t, err)
+	defer remoteSigner.Close()
+
+	request := pairingtypes.RelayRequest{ChainID: "ETH1", Provider: "provider", SessionId: 1, CuSum: 10, RelayNum: 1}
+	_, err = remoteSigner.SignRelay(request)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = remoteSigner.SignRelayResponse(&pairingtypes.RelayReply{}, &request)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = remoteSigner.SignResponseFinalizationData(&pairingtypes.RelayReply{}, &request, nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = remoteSigner.SignRelayResponse(nil, &request)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRemoteSignerUnixSocketOnly(t *testing.T) {
+	_, err := SignerListen("127.0.0.1:0")
+	require.Error(t, err)
+	_, err = NewRemoteSigner("127.0.0.1:2222")
+	require.Error(t, err)
+}
+
+// testSignDoc returns the direct mode sign doc of a transaction of msgs
+func testSignDoc(t *testing.T, chainID string, msgs ...sdk.Msg) []byte {
+	body := &txtypes.TxBody{}
+	for _, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.Nil(t, err)
+		body.Messages = append(body.Messages, anyMsg)
+	}
+	bodyBytes, err := body.Marshal()
+	require.Nil(t, err)
+	signDoc, err := (&txtypes.SignDoc{BodyBytes: bodyBytes, ChainId: chainID, AccountNumber: 1}).Marshal()
+	require.Nil(t, err)
+	return signDoc
+}
+
+func TestRemoteSignerTxPolicy(t *testing.T) {
+	localSigner, address := startSigner(t, SignerPolicy{ChainIDs: []string{"LAV1"}, LavaChainID: "lava"})
+	remoteSigner, err := NewRemoteSigner(address)
+	require.Nil(t, err)
+	defer remoteSigner.Close()
+	from := SignerAddress(localSigner)
+
+	signDoc := testSignDoc(t, "lava", &pairingtypes.MsgRelayPayment{Creator: from.String()})
+	sig, err := remoteSigner.SignTx(signDoc)
+	require.Nil(t, err)
+	require.True(t, (&cosmosSecp256k1.PubKey{Key: localSigner.PubKey()}).VerifySignature(signDoc, sig))
+
+	// transactions of another chain, or with messages a relayer doesn't send, aren't signed
+	_, err = remoteSigner.SignTx(testSignDoc(t, "other", &pairingtypes.MsgRelayPayment{Creator: from.String()}))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = remoteSigner.SignTx(testSignDoc(t, "lava", &pairingtypes.MsgRelayPayment{Creator: from.String()}, &banktypes.MsgSend{FromAddress: from.String()}))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = remoteSigner.SignTx([]byte("not a sign doc"))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSignerKeyring(t *testing.T) {
+	localSigner, address := startSigner(t, SignerPolicy{ChainIDs: []string{"LAV1"}, LavaChainID: "lava"})
+	remoteSigner, err := NewRemoteSigner(address)
+	require.Nil(t, err)
+	defer remoteSigner.Close()
+
+	// the keyring of the process only holds the public key of the account
+	kr := keyring.NewInMemory()
+	_, err = kr.SavePubKey("servicer1", &cosmosSecp256k1.PubKey{Key: localSigner.PubKey()}, hd.Secp256k1Type)
+	require.Nil(t, err)
+	signerKr := &signerKeyring{Keyring: kr, signer: remoteSigner}
+	signDoc := testSignDoc(t, "lava", &pairingtypes.MsgRelayPayment{Creator: SignerAddress(localSigner).String()})
+	sig, pubKey, err := signerKr.Sign("servicer1", signDoc)
+	require.Nil(t, err)
+	require.True(t, pubKey.VerifySignature(signDoc, sig))
+	require.Equal(t, SignerAddress(localSigner), sdk.AccAddress(pubKey.Address()))
+
+	// other keys are signed by the keyring
+	_, _, err = kr.NewMnemonic("other", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.Nil(t, err)
+	sig, pubKey, err = signerKr.Sign("other", signDoc)
+	require.Nil(t, err)
+	require.True(t, pubKey.VerifySignature(signDoc, sig))
+	require.NotEqual(t, SignerAddress(localSigner), sdk.AccAddress(pubKey.Address()))
+}
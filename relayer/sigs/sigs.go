@@ -79,7 +79,12 @@ func SignRelay(pkey *btcSecp256k1.PrivateKey, request pairingtypes.RelayRequest)
 func AllDataHash(relayResponse *pairingtypes.RelayReply, relayReq *pairingtypes.RelayRequest) (data_hash []byte) {
 	nonceBytes := make([]byte, 4)
 	binary.LittleEndian.PutUint32(nonceBytes, relayResponse.Nonce)
-	data_hash = HashMsg(bytes.Join([][]byte{relayResponse.Data, nonceBytes, []byte(relayReq.String())}, nil))
+	// the reply headers are signed with the data, replies without headers hash as before
+	metadataBytes := []byte{}
+	for _, metadata := range relayResponse.Metadata {
+		metadataBytes = append(metadataBytes, []byte(metadata.String())...)
+	}
+	data_hash = HashMsg(bytes.Join([][]byte{relayResponse.Data, nonceBytes, []byte(relayReq.String()), metadataBytes}, nil))
 	return
 }
 
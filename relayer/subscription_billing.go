@@ -0,0 +1,136 @@
+package relayer
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/chainproxy"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	subscriptionPaymentTimeout = 3 * chainproxy.SubscriptionPaymentInterval // unpaid subscriptions are closed after it
+)
+
+// subscriptionBillingTicker returns the channel charging the subscription periods, nil when periods aren't charged
+func subscriptionBillingTicker(billing spectypes.SubscriptionBilling) (<-chan time.Time, func()) {
+	if billing.ComputeUnitsPerPeriod == 0 || billing.PeriodSeconds == 0 {
+		return nil, func() {}
+	}
+	ticker := time.NewTicker(time.Duration(billing.PeriodSeconds) * time.Second)
+	return ticker.C, ticker.Stop
+}
+
+// chargeSubscription adds compute units to a billed subscription, fails when the consumer stopped paying for it or
+// its allowance can't cover it
+func chargeSubscription(userSessions *UserSessions, subscriptionID string, computeUnits uint64) error {
+	userSessions.Lock.Lock()
+	defer userSessions.Lock.Unlock()
+	sub, ok := userSessions.Subs[subscriptionID]
+	if !ok {
+		return utils.LavaFormatError("charged subscription doesn't exist", nil, &map[string]string{"subscriptionID": subscriptionID})
+	}
+	sub.billedCu += computeUnits
+	unpaidCu := sub.billedCu - sub.paidCu
+	if unpaidCu == 0 {
+		return nil
+	}
+	if time.Since(sub.lastPayment) > subscriptionPaymentTimeout {
+		return utils.LavaFormatWarning("consumer stopped paying for subscription", nil, &map[string]string{
+			"subscriptionID": subscriptionID,
+			"consumer":       userSessions.user,
+			"unpaidCu":       strconv.FormatUint(unpaidCu, 10),
+			"lastPayment":    sub.lastPayment.String(),
+		})
+	}
+	if epochData, ok := userSessions.dataByEpoch[sub.pairingEpoch]; !ok || epochData.UsedComputeUnits+unpaidCu > epochData.MaxComputeUnits {
+		return utils.LavaFormatWarning("consumer compute units can't cover subscription", nil, &map[string]string{
+			"subscriptionID": subscriptionID,
+			"consumer":       userSessions.user,
+			"unpaidCu":       strconv.FormatUint(unpaidCu, 10),
+		})
+	}
+	return nil
+}
+
+// SubscriptionPayment applies the compute units the consumer acknowledges for its open subscriptions on the session
+// they were opened with, the payment is the session proof like a relay
+func (s *relayServer) SubscriptionPayment(ctx context.Context, request *pairingtypes.RelayRequest) (*emptypb.Empty, error) {
+	if g_sentry.ProviderAcc != request.Provider {
+		return nil, utils.LavaFormatError("subscription payment sent to the wrong provider address", nil, &map[string]string{
+			"ProviderWhoGotTheRequest": g_sentry.ProviderAcc,
+			"ProviderInTheRequest":     request.Provider,
+		})
+	}
+	user, err := getRelayUser(request)
+	if err != nil {
+		return nil, utils.LavaFormatError("get relay user", err, &map[string]string{})
+	}
+	userAddr, err := sdk.AccAddressFromHex(user.String())
+	if err != nil {
+		return nil, utils.LavaFormatError("get relay acc address", err, &map[string]string{})
+	}
+	g_sessions_mutex.Lock()
+	userSessions, ok := g_sessions[userAddr.String()]
+	g_sessions_mutex.Unlock()
+	if !ok {
+		return nil, utils.LavaFormatError("subscription payment of unknown consumer", nil, &map[string]string{"userAddr": userAddr.String()})
+	}
+
+	userSessions.Lock.Lock()
+	defer userSessions.Lock.Unlock()
+	relaySession, ok := userSessions.Sessions[request.SessionId]
+	if !ok {
+		return nil, utils.LavaFormatError("subscription payment of unknown session", nil, &map[string]string{"userAddr": userAddr.String(), "request.SessionId": strconv.FormatUint(request.SessionId, 10)})
+	}
+	relaySession.Lock.Lock()
+	defer relaySession.Lock.Unlock()
+	pairingEpoch := relaySession.PairingEpoch
+	if request.BlockHeight != int64(pairingEpoch) {
+		return nil, utils.LavaFormatError("subscription payment blockheight mismatch to session epoch", nil, &map[string]string{
+			"pairingEpoch": strconv.FormatUint(pairingEpoch, 10), "userAddr": userAddr.String(),
+			"relay blockheight": strconv.FormatInt(request.BlockHeight, 10),
+		})
+	}
+	if request.RelayNum <= relaySession.RelayNum || request.CuSum <= relaySession.CuSum {
+		return nil, utils.LavaFormatError("subscription payment doesn't add to the session", nil, &map[string]string{
+			"RelayNum": strconv.FormatUint(relaySession.RelayNum, 10), "request.RelayNum": strconv.FormatUint(request.RelayNum, 10),
+			"CuSum": strconv.FormatUint(relaySession.CuSum, 10), "request.CuSum": strconv.FormatUint(request.CuSum, 10),
+		})
+	}
+	paidCu := request.CuSum - relaySession.CuSum
+	epochData := userSessions.dataByEpoch[pairingEpoch]
+	if epochData == nil || epochData.UsedComputeUnits+paidCu > epochData.MaxComputeUnits {
+		return nil, utils.LavaFormatError("client cu overflow on subscription payment", nil, &map[string]string{
+			"userAddr": userAddr.String(),
+			"paidCu":   strconv.FormatUint(paidCu, 10),
+		})
+	}
+	epochData.UsedComputeUnits += paidCu
+	relaySession.CuSum = request.CuSum
+	relaySession.RelayNum = request.RelayNum
+	relaySession.Proof = request.ShallowCopy()
+
+	// credit the session subscriptions
+	now := time.Now()
+	for _, sub := range userSessions.Subs {
+		if sub.sessionID != request.SessionId || sub.pairingEpoch != pairingEpoch {
+			continue
+		}
+		sub.lastPayment = now
+		if unpaidCu := sub.billedCu - sub.paidCu; unpaidCu > 0 {
+			credit := paidCu
+			if credit > unpaidCu {
+				credit = unpaidCu
+			}
+			sub.paidCu += credit
+			paidCu -= credit
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
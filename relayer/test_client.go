@@ -29,12 +29,12 @@ func TestClient(
 	rand.Seed(time.Now().UnixNano())
 
 	//
-	sk, _, err := utils.GetOrCreateVRFKey(clientCtx)
+	vrfSks, err := utils.GetOrCreateVRFKeys(clientCtx)
 	if err != nil {
-		log.Fatalln("error: GetOrCreateVRFKey", err)
+		log.Fatalln("error: GetOrCreateVRFKeys", err)
 	}
 	// Start sentry
-	sentry := sentry.NewSentry(clientCtx, txFactory, chainID, true, nil, nil, apiInterface, sk, flagSet, 0)
+	sentry := sentry.NewSentry(clientCtx, txFactory, chainID, true, nil, nil, apiInterface, vrfSks, flagSet, 0)
 	err = sentry.Init(ctx)
 	if err != nil {
 		log.Fatalln("error sentry.Init", err)
@@ -46,7 +46,7 @@ func TestClient(
 
 	//
 	// Node
-	chainProxy, err := chainproxy.GetChainProxy("", 1, sentry, nil)
+	chainProxy, err := chainproxy.GetChainProxy(nil, nil, 1, chainproxy.DefaultMaxConnsPerNode, sentry, nil)
 	if err != nil {
 		log.Fatalln("error: GetChainProxy", err)
 	}
@@ -68,6 +68,7 @@ func TestClient(
 	if err != nil {
 		log.Fatalln("error: getPrivKey", err)
 	}
+	signer := sigs.NewKeyringSigner(privKey)
 	clientKey, _ := clientCtx.Keyring.Key(keyName)
 	log.Println("Client pubkey", clientKey.GetPubKey().Address())
 
@@ -82,19 +83,19 @@ func TestClient(
 	case "FTM250":
 		testErrors = testclients.EthTests(ctx, chainID, "http://127.0.0.1:3336/1", testDuration)
 	case "COS1":
-		testErrors = testclients.TerraTests(ctx, chainProxy, privKey, apiInterface)
+		testErrors = testclients.TerraTests(ctx, chainProxy, signer, apiInterface)
 	case "COS3", "COS4":
-		testErrors = testclients.OsmosisTests(ctx, chainProxy, privKey, apiInterface)
+		testErrors = testclients.OsmosisTests(ctx, chainProxy, signer, apiInterface)
 	case "LAV1":
-		testErrors = testclients.LavaTests(ctx, chainProxy, privKey, apiInterface, sentry, clientCtx)
+		testErrors = testclients.LavaTests(ctx, chainProxy, signer, apiInterface, sentry, clientCtx)
 	case "APT1":
-		testErrors = testclients.AptosTests(ctx, chainProxy, privKey, apiInterface, sentry, clientCtx)
+		testErrors = testclients.AptosTests(ctx, chainProxy, signer, apiInterface, sentry, clientCtx)
 	case "JUN1":
-		testErrors = testclients.JunoTests(ctx, chainProxy, privKey, apiInterface)
+		testErrors = testclients.JunoTests(ctx, chainProxy, signer, apiInterface)
 	case "COS5":
-		testErrors = testclients.CosmoshubTests(ctx, chainProxy, privKey, apiInterface, sentry, clientCtx)
+		testErrors = testclients.CosmoshubTests(ctx, chainProxy, signer, apiInterface, sentry, clientCtx)
 	case "STRK":
-		testErrors = testclients.StarknetTests(ctx, chainID, "http://127.0.0.1:3345/1", chainProxy, privKey, testDuration)
+		testErrors = testclients.StarknetTests(ctx, chainID, "http://127.0.0.1:3345/1", chainProxy, signer, testDuration)
 	}
 
 	if testErrors != nil {
@@ -0,0 +1,128 @@
+package tracing
+
+//
+// Tracing of the relay path with the opentelemetry sdk: spans from the portal handler through the provider down to
+// the node call, exported over otlp/http by a batch span processor. the trace context travels from the consumer to
+// the provider in the w3c traceparent grpc metadata of the relay calls, unary and streaming.
+// without Init the global tracer provider is a noop one, spans aren't recorded
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const (
+	TracingEndpointFlagName = "tracing-endpoint"
+	instrumentationName     = "lava.relayer"
+)
+
+var (
+	providerLock   sync.Mutex
+	tracerProvider *sdktrace.TracerProvider
+	propagator     = propagation.TraceContext{}
+)
+
+// Init exports the spans of serviceName to the otlp/http collector at endpoint, i.e http://localhost:4318.
+// an empty endpoint leaves tracing off
+func Init(endpoint string, serviceName string) error {
+	if endpoint == "" {
+		return nil
+	}
+	collectorURL, err := url.Parse(endpoint)
+	if err != nil || collectorURL.Host == "" {
+		return fmt.Errorf("invalid tracing endpoint %s", endpoint)
+	}
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(collectorURL.Host)}
+	if collectorURL.Scheme != "https" {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	if path := strings.TrimSuffix(collectorURL.Path, "/"); path != "" {
+		options = append(options, otlptracehttp.WithURLPath(path+"/v1/traces"))
+	}
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return err
+	}
+	setTracerProvider(sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	))
+	return nil
+}
+
+// setTracerProvider makes provider the global one, the previous provider is shut down
+func setTracerProvider(provider *sdktrace.TracerProvider) {
+	providerLock.Lock()
+	defer providerLock.Unlock()
+	if tracerProvider != nil {
+		tracerProvider.Shutdown(context.Background())
+	}
+	tracerProvider = provider
+	otel.SetTextMapPropagator(propagator)
+	if provider == nil {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		return
+	}
+	otel.SetTracerProvider(provider)
+}
+
+// Shutdown exports the pending spans and stops tracing
+func Shutdown() error {
+	providerLock.Lock()
+	defer providerLock.Unlock()
+	if tracerProvider == nil {
+		return nil
+	}
+	err := tracerProvider.Shutdown(context.Background())
+	tracerProvider = nil
+	otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	return err
+}
+
+// StartSpan starts a span, child of the span of ctx. the returned context carries the new span
+func StartSpan(ctx context.Context, name string, kind trace.SpanKind) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithSpanKind(kind))
+}
+
+// RecordError marks the span as failed, a nil error is ignored
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// UnaryClientInterceptor traces grpc calls and sends their trace context in the request metadata
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return otelgrpc.UnaryClientInterceptor(otelgrpc.WithPropagators(propagator))
+}
+
+// StreamClientInterceptor traces grpc streams (i.e RelaySubscribe) and sends their trace context in the request metadata
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return otelgrpc.StreamClientInterceptor(otelgrpc.WithPropagators(propagator))
+}
+
+// UnaryServerInterceptor traces grpc calls, as children of the trace context in the request metadata
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return otelgrpc.UnaryServerInterceptor(otelgrpc.WithPropagators(propagator))
+}
+
+// StreamServerInterceptor traces grpc streams, as children of the trace context in the request metadata
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return otelgrpc.StreamServerInterceptor(otelgrpc.WithPropagators(propagator))
+}
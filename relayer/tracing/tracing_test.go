@@ -0,0 +1,159 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+// testRelayer streams back the subscribe request, under a handler span. the relay path spans are tested with the
+// portal and provider code, this only covers the trace context of the grpc streams
+type testRelayer struct {
+	pairingtypes.UnimplementedRelayerServer
+}
+
+func (tr *testRelayer) RelaySubscribe(request *pairingtypes.RelayRequest, srv pairingtypes.Relayer_RelaySubscribeServer) error {
+	_, span := StartSpan(srv.Context(), "handler", trace.SpanKindInternal)
+	span.End()
+	return srv.Send(&pairingtypes.RelayReply{Data: request.Data})
+}
+
+// startTestRelayer starts a traced relayer, interceptors are created after the tracer provider is set
+func startTestRelayer(t *testing.T) pairingtypes.RelayerClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	server := grpc.NewServer(grpc.UnaryInterceptor(UnaryServerInterceptor()), grpc.StreamInterceptor(StreamServerInterceptor()))
+	pairingtypes.RegisterRelayerServer(server, &testRelayer{})
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(UnaryClientInterceptor()), grpc.WithStreamInterceptor(StreamClientInterceptor()))
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pairingtypes.NewRelayerClient(conn)
+}
+
+// setTestTracerProvider traces to an in memory exporter through a batch span processor like Init does
+func setTestTracerProvider(t *testing.T) func() tracetest.SpanStubs {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter))
+	setTracerProvider(provider)
+	t.Cleanup(func() { setTracerProvider(nil) })
+	return func() tracetest.SpanStubs {
+		require.Nil(t, provider.ForceFlush(context.Background()))
+		return exporter.GetSpans()
+	}
+}
+
+// spansByName names the grpc server spans "provider <method>", the client and server spans have the same name
+func spansByName(spans tracetest.SpanStubs) map[string]tracetest.SpanStub {
+	named := map[string]tracetest.SpanStub{}
+	for _, span := range spans {
+		name := span.Name
+		if span.SpanKind == trace.SpanKindServer {
+			name = "provider " + name
+		}
+		named[name] = span
+	}
+	return named
+}
+
+func TestSubscribeTraceAcrossConsumerAndProvider(t *testing.T) {
+	getSpans := setTestTracerProvider(t)
+	client := startTestRelayer(t)
+
+	ctx, consumerSpan := StartSpan(context.Background(), "consumer", trace.SpanKindInternal)
+	stream, err := client.RelaySubscribe(ctx, &pairingtypes.RelayRequest{Data: []byte("subscribe")})
+	require.Nil(t, err)
+	reply, err := stream.Recv()
+	require.Nil(t, err)
+	require.Equal(t, []byte("subscribe"), reply.Data)
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+	consumerSpan.End()
+
+	// the client stream span ends asynchronously once the stream finished
+	var spans map[string]tracetest.SpanStub
+	require.Eventually(t, func() bool {
+		spans = spansByName(getSpans())
+		return len(spans) == 4
+	}, time.Second, 10*time.Millisecond)
+	parents := map[string]string{
+		"lavanet.lava.pairing.Relayer/RelaySubscribe":          "consumer",
+		"provider lavanet.lava.pairing.Relayer/RelaySubscribe": "lavanet.lava.pairing.Relayer/RelaySubscribe",
+		"handler": "provider lavanet.lava.pairing.Relayer/RelaySubscribe",
+	}
+	for child, parent := range parents {
+		require.Equal(t, spans["consumer"].SpanContext.TraceID(), spans[child].SpanContext.TraceID(), child)
+		require.Equal(t, spans[parent].SpanContext.SpanID(), spans[child].Parent.SpanID(), child)
+	}
+}
+
+func TestTracingOff(t *testing.T) {
+	ctx, span := StartSpan(context.Background(), "SendRelay", trace.SpanKindInternal)
+	require.False(t, span.IsRecording())
+	require.False(t, trace.SpanContextFromContext(ctx).IsValid())
+	RecordError(span, fmt.Errorf("failure"))
+	span.End()
+	require.Nil(t, Init("", "lava-portal"))
+	require.Nil(t, Shutdown())
+}
+
+func TestOtlpExport(t *testing.T) {
+	var lock sync.Mutex
+	var requests []*coltracepb.ExportTraceServiceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		request := &coltracepb.ExportTraceServiceRequest{}
+		require.Nil(t, proto.Unmarshal(body, request))
+		lock.Lock()
+		requests = append(requests, request)
+		lock.Unlock()
+	}))
+	defer server.Close()
+
+	require.Nil(t, Init(server.URL, "lava-portal"))
+	ctx, span := StartSpan(context.Background(), "SendRelay", trace.SpanKindInternal)
+	_, child := StartSpan(ctx, "GetSession", trace.SpanKindInternal)
+	RecordError(child, fmt.Errorf("no pairing"))
+	child.End()
+	span.End()
+	require.Nil(t, Shutdown()) // flushes the batch
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.Len(t, requests, 1)
+	resourceSpans := requests[0].ResourceSpans
+	require.Len(t, resourceSpans, 1)
+	serviceName := ""
+	for _, attribute := range resourceSpans[0].Resource.Attributes {
+		if attribute.Key == "service.name" {
+			serviceName = attribute.Value.GetStringValue()
+		}
+	}
+	require.Equal(t, "lava-portal", serviceName)
+	spans := map[string]bool{}
+	for _, scopeSpans := range resourceSpans[0].ScopeSpans {
+		for _, span := range scopeSpans.Spans {
+			spans[span.Name] = true
+		}
+	}
+	require.Equal(t, map[string]bool{"SendRelay": true, "GetSession": true}, spans)
+}
@@ -0,0 +1,86 @@
+package txsender
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	DefaultGasPrice      = "0.000000001ulava"
+	DefaultGasAdjustment = 1.5
+	MaximumGasAllowed    = 5000000 // gas of a transaction, larger ones are split where possible
+)
+
+// Simulation is the result of simulating a transaction
+type Simulation struct {
+	Gas    uint64 // adjusted gas the transaction is sent with
+	Fees   sdk.DecCoins
+	Events []abci.Event
+}
+
+// Broadcaster simulates and broadcasts the transactions of one account
+type Broadcaster interface {
+	// AccountSequence returns the sequence of the next transaction of the account
+	AccountSequence(ctx context.Context) (uint64, error)
+	Simulate(ctx context.Context, sequence uint64, msgs ...sdk.Msg) (*Simulation, error)
+	Broadcast(ctx context.Context, sequence uint64, gas uint64, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+}
+
+// ClientBroadcaster broadcasts the transactions of the from account of a client context
+type ClientBroadcaster struct {
+	clientCtx client.Context
+	txFactory tx.Factory
+}
+
+func NewClientBroadcaster(clientCtx client.Context, txFactory tx.Factory) *ClientBroadcaster {
+	txFactory = txFactory.WithGasPrices(DefaultGasPrice).WithGasAdjustment(DefaultGasAdjustment)
+	return &ClientBroadcaster{clientCtx: clientCtx, txFactory: txFactory}
+}
+
+func (cb *ClientBroadcaster) AccountSequence(ctx context.Context) (uint64, error) {
+	from := cb.clientCtx.GetFromAddress()
+	if err := cb.clientCtx.AccountRetriever.EnsureExists(cb.clientCtx, from); err != nil {
+		return 0, err
+	}
+	accountNumber, sequence, err := cb.clientCtx.AccountRetriever.GetAccountNumberSequence(cb.clientCtx, from)
+	if err != nil {
+		return 0, err
+	}
+	cb.txFactory = cb.txFactory.WithAccountNumber(accountNumber)
+	return sequence, nil
+}
+
+func (cb *ClientBroadcaster) Simulate(ctx context.Context, sequence uint64, msgs ...sdk.Msg) (*Simulation, error) {
+	txf := cb.txFactory.WithSequence(sequence)
+	simResult, gas, err := tx.CalculateGas(cb.clientCtx, txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	fees := make(sdk.DecCoins, len(txf.GasPrices()))
+	for idx, gasPrice := range txf.GasPrices() {
+		fees[idx] = sdk.NewDecCoinFromDec(gasPrice.Denom, gasPrice.Amount.MulInt64(int64(gas)))
+	}
+	return &Simulation{Gas: gas, Fees: fees, Events: simResult.GetResult().Events}, nil
+}
+
+func (cb *ClientBroadcaster) Broadcast(ctx context.Context, sequence uint64, gas uint64, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txf := cb.txFactory.WithSequence(sequence).WithGas(gas)
+	txBuilder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder.SetFeeGranter(cb.clientCtx.GetFeeGranterAddress())
+	err = tx.Sign(txf, cb.clientCtx.GetFromName(), txBuilder, true)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := cb.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return cb.clientCtx.BroadcastTx(txBytes)
+}
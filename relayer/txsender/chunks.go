@@ -0,0 +1,81 @@
+package txsender
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+)
+
+// ChunkedMsg returns the message of some of the items of a chunked send, i.e a relay payment of some of the relays
+type ChunkedMsg func(items []int) sdk.Msg
+
+// ItemResult is the outcome of one item of a chunked send
+type ItemResult struct {
+	TxHash string
+	Err    error
+}
+
+// SendChunked sends count items in as few transactions as the gas limit allows. a chunk the chain rejects is split
+// in halves until its failing items are found, so only they fail
+func (ts *TxSender) SendChunked(ctx context.Context, count int, newMsg ChunkedMsg, check SimulationCheck) []ItemResult {
+	return sendChunked(ctx, ts, count, newMsg, check)
+}
+
+func sendChunked(ctx context.Context, sender Sender, count int, newMsg ChunkedMsg, check SimulationCheck) []ItemResult {
+	results := make([]ItemResult, count)
+	items := make([]int, count)
+	for idx := range items {
+		items[idx] = idx
+	}
+	if count > 0 {
+		sendChunk(ctx, sender, items, newMsg, check, results)
+	}
+	return results
+}
+
+func sendChunk(ctx context.Context, sender Sender, items []int, newMsg ChunkedMsg, check SimulationCheck, results []ItemResult) {
+	response, err := sender.SendWithCheck(ctx, check, newMsg(items))
+	if err == nil {
+		for _, item := range items {
+			results[item] = ItemResult{TxHash: response.TxHash}
+		}
+		return
+	}
+	parts := 2
+	var gasLimitErr *GasLimitError
+	if errors.As(err, &gasLimitErr) {
+		// the chunk gas estimates the gas of its items
+		parts = int(gasLimitErr.Gas/MaximumGasAllowed) + 1
+	} else if !isRejected(err) {
+		// the chain wasn't reached, splitting won't help
+		parts = 1
+	}
+	if parts > len(items) {
+		parts = len(items)
+	}
+	if parts == 1 || ctx.Err() != nil {
+		for _, item := range items {
+			results[item] = ItemResult{Err: err}
+		}
+		return
+	}
+	utils.LavaFormatInfo("splitting failed chunk", &map[string]string{"items": strconv.Itoa(len(items)), "parts": strconv.Itoa(parts), "error": err.Error()})
+	size := (len(items) + parts - 1) / parts
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		sendChunk(ctx, sender, items[start:end], newMsg, check, results)
+	}
+}
+
+// isRejected returns true for messages the chain failed, rather than failures to send them
+func isRejected(err error) bool {
+	var txErr *TxError
+	var simulationErr *SimulationError
+	return errors.As(err, &txErr) || errors.As(err, &simulationErr)
+}
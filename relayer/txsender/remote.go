@@ -0,0 +1,277 @@
+package txsender
+
+//
+// Tx sender daemon: the processes of an account (i.e the servers of its chains) send their messages to the TxSender of
+// a daemon over a unix socket, so they share the account sequence and their messages share transactions. simulation
+// checks are run by the process sending the messages, the daemon streams it the simulations and waits for the answers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	TxSenderFlagName      = "tx-sender"
+	unixSocketPrefix      = "unix://"
+	remoteTxSenderTimeout = 5 * time.Second
+)
+
+// NewSender returns the tx sender daemon at txSenderAddress, or a started TxSender of the process when it is empty.
+// the daemon has to send the transactions of the --from account
+func NewSender(ctx context.Context, clientCtx client.Context, txFactory tx.Factory, txSenderAddress string) (Sender, error) {
+	if txSenderAddress == "" {
+		txSender := NewTxSender(NewClientBroadcaster(clientCtx, txFactory))
+		txSender.Start(ctx)
+		return txSender, nil
+	}
+	remoteTxSender, err := NewRemoteTxSender(txSenderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if fromAddress := clientCtx.GetFromAddress(); !fromAddress.Equals(remoteTxSender.Account()) {
+		remoteTxSender.Close()
+		return nil, utils.LavaFormatError("tx sender account doesn't match the account", nil, &map[string]string{"txSender": txSenderAddress, "txSenderAccount": remoteTxSender.Account().String(), "from": fromAddress.String()})
+	}
+	return remoteTxSender, nil
+}
+
+// RemoteTxSender sends messages with the TxSender of a tx sender daemon
+type RemoteTxSender struct {
+	conn    *grpc.ClientConn
+	client  pairingtypes.TxSenderClient
+	account sdk.AccAddress
+}
+
+// NewRemoteTxSender connects to the tx sender daemon listening on the unix socket at address, unix:///path
+func NewRemoteTxSender(address string) (*RemoteTxSender, error) {
+	if !strings.HasPrefix(address, unixSocketPrefix) {
+		return nil, utils.LavaFormatError("tx sender address isn't a unix socket", nil, &map[string]string{"address": address})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), remoteTxSenderTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		return nil, utils.LavaFormatError("failed connecting to tx sender", err, &map[string]string{"address": address})
+	}
+	client := pairingtypes.NewTxSenderClient(conn)
+	reply, err := client.Account(ctx, &emptypb.Empty{})
+	if err != nil {
+		conn.Close()
+		return nil, utils.LavaFormatError("failed getting tx sender account", err, &map[string]string{"address": address})
+	}
+	account, err := sdk.AccAddressFromBech32(reply.Address)
+	if err != nil {
+		conn.Close()
+		return nil, utils.LavaFormatError("tx sender returned an invalid account", err, &map[string]string{"address": address})
+	}
+	return &RemoteTxSender{conn: conn, client: client, account: account}, nil
+}
+
+func (rts *RemoteTxSender) Close() error {
+	return rts.conn.Close()
+}
+
+// Account is the account the daemon sends the transactions of
+func (rts *RemoteTxSender) Account() sdk.AccAddress {
+	return rts.account
+}
+
+func (rts *RemoteTxSender) Send(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	return rts.SendWithCheck(ctx, nil, msgs...)
+}
+
+// SendWithCheck sends msgs in one transaction of the daemon, check is run here on the simulations the daemon sends
+func (rts *RemoteTxSender) SendWithCheck(ctx context.Context, check SimulationCheck, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	request := &pairingtypes.TxSendRequest{Check: check != nil}
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		request.Msgs = append(request.Msgs, anyMsg)
+	}
+	// the stream is closed with the context
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := rts.client.Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = stream.Send(request); err != nil {
+		return nil, err
+	}
+	var checkErr error
+	for {
+		reply, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if reply.Simulation == nil {
+			return reply.Response, decodeSendError(reply, checkErr)
+		}
+		if check == nil {
+			return nil, utils.LavaFormatError("tx sender sent a simulation that wasn't asked for", nil, nil)
+		}
+		checkErr = check(&Simulation{Gas: reply.Simulation.Gas, Fees: reply.Simulation.Fees, Events: reply.Simulation.Events})
+		answer := &pairingtypes.TxSendRequest{}
+		if checkErr != nil {
+			answer.CheckError = checkErr.Error()
+		}
+		if err = stream.Send(answer); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (rts *RemoteTxSender) SendChunked(ctx context.Context, count int, newMsg ChunkedMsg, check SimulationCheck) []ItemResult {
+	return sendChunked(ctx, rts, count, newMsg, check)
+}
+
+// TxSenderServer serves the TxSender of an account to its processes, for messages of relayers signed by the account
+type TxSenderServer struct {
+	pairingtypes.UnimplementedTxSenderServer
+	sender   *TxSender
+	account  sdk.AccAddress
+	registry codectypes.InterfaceRegistry
+}
+
+func NewTxSenderServer(sender *TxSender, account sdk.AccAddress, registry codectypes.InterfaceRegistry) *TxSenderServer {
+	return &TxSenderServer{sender: sender, account: account, registry: registry}
+}
+
+func (tss *TxSenderServer) Account(ctx context.Context, _ *emptypb.Empty) (*pairingtypes.TxSenderAccount, error) {
+	return &pairingtypes.TxSenderAccount{Address: tss.account.String()}, nil
+}
+
+func (tss *TxSenderServer) Send(stream pairingtypes.TxSender_SendServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	msgs, err := tss.unpackMsgs(request.Msgs)
+	if err != nil {
+		return err
+	}
+	var check SimulationCheck
+	if request.Check {
+		// run by the sending routine of the TxSender while Send waits for the result
+		check = func(simulation *Simulation) error {
+			if err := stream.Context().Err(); err != nil {
+				return err
+			}
+			err := stream.Send(&pairingtypes.TxSendReply{Simulation: &pairingtypes.TxSimulation{Gas: simulation.Gas, Fees: simulation.Fees, Events: simulation.Events}})
+			if err != nil {
+				return err
+			}
+			answer, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			if answer.CheckError != "" {
+				return &remoteCheckError{message: answer.CheckError}
+			}
+			return nil
+		}
+	}
+	response, err := tss.sender.SendWithCheck(stream.Context(), check, msgs...)
+	return stream.Send(&pairingtypes.TxSendReply{Response: response, Error: encodeSendError(err)})
+}
+
+// unpackMsgs returns the messages of a request, they have to be relayer messages signed by the account
+func (tss *TxSenderServer) unpackMsgs(anyMsgs []*codectypes.Any) ([]sdk.Msg, error) {
+	if len(anyMsgs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no messages to send")
+	}
+	msgs := make([]sdk.Msg, 0, len(anyMsgs))
+	for _, anyMsg := range anyMsgs {
+		if !sigs.IsRelayerTxMsg(anyMsg.TypeUrl) {
+			utils.LavaFormatWarning("tx sender refused sending a message of another type than the relayer ones", nil, &map[string]string{"msgType": anyMsg.TypeUrl})
+			return nil, status.Error(codes.PermissionDenied, "message "+anyMsg.TypeUrl+" isn't sent by the tx sender")
+		}
+		var msg sdk.Msg
+		if err := tss.registry.UnpackAny(anyMsg, &msg); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid message: "+err.Error())
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid message: "+err.Error())
+		}
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(tss.account) {
+				return nil, status.Error(codes.PermissionDenied, "message of "+signer.String()+" isn't signed by the tx sender account")
+			}
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// remoteCheckError is a simulation the process sending the messages refused
+type remoteCheckError struct {
+	message string
+}
+
+func (rce *remoteCheckError) Error() string {
+	return rce.message
+}
+
+// encodeSendError keeps the kind of a send error for the process sending the messages, i.e to split failed chunks
+func encodeSendError(err error) *pairingtypes.TxSendError {
+	if err == nil {
+		return nil
+	}
+	var gasLimitErr *GasLimitError
+	var txErr *TxError
+	var simulationErr *SimulationError
+	var checkErr *remoteCheckError
+	switch {
+	case errors.As(err, &gasLimitErr):
+		return &pairingtypes.TxSendError{Kind: pairingtypes.TxSendError_GAS_LIMIT, Message: err.Error(), Gas: gasLimitErr.Gas}
+	case errors.As(err, &txErr):
+		return &pairingtypes.TxSendError{Kind: pairingtypes.TxSendError_TX, Message: err.Error()}
+	case errors.As(err, &simulationErr):
+		return &pairingtypes.TxSendError{Kind: pairingtypes.TxSendError_SIMULATION, Message: simulationErr.Err.Error()}
+	case errors.As(err, &checkErr):
+		return &pairingtypes.TxSendError{Kind: pairingtypes.TxSendError_CHECK, Message: err.Error()}
+	}
+	return &pairingtypes.TxSendError{Kind: pairingtypes.TxSendError_FAILED, Message: err.Error()}
+}
+
+// decodeSendError returns the error of a send reply, a refused simulation is the error of the local check
+func decodeSendError(reply *pairingtypes.TxSendReply, checkErr error) error {
+	if reply.Error == nil {
+		return nil
+	}
+	switch reply.Error.Kind {
+	case pairingtypes.TxSendError_GAS_LIMIT:
+		return &GasLimitError{Gas: reply.Error.Gas}
+	case pairingtypes.TxSendError_TX:
+		if reply.Response != nil {
+			return &TxError{Response: reply.Response}
+		}
+	case pairingtypes.TxSendError_SIMULATION:
+		return &SimulationError{Err: errors.New(reply.Error.Message)}
+	case pairingtypes.TxSendError_CHECK:
+		if checkErr != nil {
+			return checkErr
+		}
+	}
+	return errors.New(reply.Error.Message)
+}
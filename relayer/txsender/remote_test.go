@@ -0,0 +1,174 @@
+package txsender
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// paymentBroadcaster is a fakeBroadcaster of relay payments, the relays session ids are the message items
+type paymentBroadcaster struct {
+	*fakeBroadcaster
+}
+
+func (pb paymentBroadcaster) Simulate(ctx context.Context, sequence uint64, msgs ...sdk.Msg) (*Simulation, error) {
+	return pb.fakeBroadcaster.Simulate(ctx, sequence, paymentTestMsgs(msgs)...)
+}
+
+func (pb paymentBroadcaster) Broadcast(ctx context.Context, sequence uint64, gas uint64, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	return pb.fakeBroadcaster.Broadcast(ctx, sequence, gas, paymentTestMsgs(msgs)...)
+}
+
+func paymentTestMsgs(msgs []sdk.Msg) []sdk.Msg {
+	testMsgs := make([]sdk.Msg, len(msgs))
+	for idx, msg := range msgs {
+		testMsg := &testMsg{}
+		for _, relay := range msg.(*pairingtypes.MsgRelayPayment).Relays {
+			testMsg.items = append(testMsg.items, int(relay.SessionId))
+		}
+		testMsgs[idx] = testMsg
+	}
+	return testMsgs
+}
+
+func relayPayment(creator sdk.AccAddress, sessionIDs ...int) *pairingtypes.MsgRelayPayment {
+	relays := make([]*pairingtypes.RelayRequest, len(sessionIDs))
+	for idx, sessionID := range sessionIDs {
+		relays[idx] = &pairingtypes.RelayRequest{SessionId: uint64(sessionID)}
+	}
+	return pairingtypes.NewMsgRelayPayment(creator.String(), relays, "")
+}
+
+// startTxSenderDaemon serves the TxSender of account on a unix socket and returns its address
+func startTxSenderDaemon(t *testing.T, txSender *TxSender, account sdk.AccAddress) string {
+	registry := codectypes.NewInterfaceRegistry()
+	pairingtypes.RegisterInterfaces(registry)
+	address := "unix://" + filepath.Join(t.TempDir(), "txsender.sock")
+	listener, err := sigs.SignerListen(address)
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	pairingtypes.RegisterTxSenderServer(server, NewTxSenderServer(txSender, account, registry))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return address
+}
+
+func TestRemoteTxSenderSharedByServers(t *testing.T) {
+	broadcaster := &fakeBroadcaster{sequence: 3}
+	txSender := startTxSender(t, paymentBroadcaster{broadcaster})
+	_, account := sigs.GenerateFloatingKey()
+	address := startTxSenderDaemon(t, txSender, account)
+
+	// a server of another account can't use the daemon
+	_, other := sigs.GenerateFloatingKey()
+	_, err := NewSender(context.Background(), client.Context{}.WithFromAddress(other), tx.Factory{}, address)
+	require.Error(t, err)
+
+	// the servers of two chains
+	servers := make([]Sender, 2)
+	for idx := range servers {
+		server, err := NewSender(context.Background(), client.Context{}.WithFromAddress(account), tx.Factory{}, address)
+		require.NoError(t, err)
+		t.Cleanup(func() { server.(*RemoteTxSender).Close() })
+		servers[idx] = server
+	}
+
+	send := func(server Sender, sessionIDs ...int) chan error {
+		errs := make(chan error, 1)
+		go func() {
+			_, err := server.Send(context.Background(), relayPayment(account, sessionIDs...))
+			errs <- err
+		}()
+		return errs
+	}
+	// the messages of both servers are queued while a transaction is broadcasted, and share the next one
+	hold := make(chan struct{})
+	broadcaster.lock.Lock()
+	broadcaster.hold = hold
+	broadcaster.lock.Unlock()
+	first := send(servers[0], 1)
+	<-hold
+	results := []chan error{send(servers[0], 2), send(servers[1], 3)}
+	require.Eventually(t, func() bool { return len(txSender.requests) == 2 }, time.Second, time.Millisecond)
+	hold <- struct{}{}
+	require.NoError(t, <-first)
+	for _, result := range results {
+		require.NoError(t, <-result)
+	}
+	require.ElementsMatch(t, []int{1, 2, 3}, broadcaster.included)
+	require.Equal(t, 2, broadcaster.transactions)
+	// one sequence for the account
+	require.Equal(t, uint64(5), broadcaster.sequence)
+	require.Equal(t, 1, broadcaster.sequenceQueries)
+}
+
+func TestRemoteTxSenderChecksAndErrors(t *testing.T) {
+	broadcaster := &fakeBroadcaster{}
+	txSender := startTxSender(t, paymentBroadcaster{broadcaster})
+	_, account := sigs.GenerateFloatingKey()
+	remote, err := NewRemoteTxSender(startTxSenderDaemon(t, txSender, account))
+	require.NoError(t, err)
+	defer remote.Close()
+	require.Equal(t, account, remote.Account())
+
+	// the check of the server refuses the simulation the daemon sends it
+	checkErr := errors.New("not profitable")
+	_, err = remote.SendWithCheck(context.Background(), func(simulation *Simulation) error {
+		require.Equal(t, uint64(itemGas), simulation.Gas)
+		return checkErr
+	}, relayPayment(account, 1))
+	require.Equal(t, checkErr, err)
+	require.Empty(t, broadcaster.included)
+	checked := 0
+	response, err := remote.SendWithCheck(context.Background(), func(simulation *Simulation) error {
+		checked++
+		return nil
+	}, relayPayment(account, 2))
+	require.NoError(t, err)
+	require.Equal(t, "TX0", response.TxHash)
+	require.Equal(t, 1, checked)
+	require.Equal(t, []int{2}, broadcaster.included)
+
+	// chunks are split by the gas limit and the rejected items of the daemon errors, like a TxSender of the process
+	broadcaster.included = nil
+	broadcaster.failingItems = map[int]struct{}{3: {}}
+	newMsg := func(items []int) sdk.Msg {
+		return relayPayment(account, items...)
+	}
+	const items = 120
+	results := remote.SendChunked(context.Background(), items, newMsg, nil)
+	for item, result := range results {
+		if item == 3 {
+			var simulationErr *SimulationError
+			require.ErrorAs(t, result.Err, &simulationErr)
+			continue
+		}
+		require.NoError(t, result.Err)
+	}
+	require.Len(t, broadcaster.included, items-1)
+	for _, gas := range broadcaster.gas {
+		require.LessOrEqual(t, gas, uint64(MaximumGasAllowed))
+	}
+
+	// only relayer messages of the account are sent
+	_, other := sigs.GenerateFloatingKey()
+	_, err = remote.Send(context.Background(), relayPayment(other, 200))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = remote.Send(context.Background(), banktypes.NewMsgSend(account, other, sdk.NewCoins(sdk.NewInt64Coin("ulava", 1))))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.NotContains(t, broadcaster.included, 200)
+}
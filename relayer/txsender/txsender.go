@@ -0,0 +1,323 @@
+package txsender
+
+//
+// TxSender owns the transactions of the provider account: messages of every caller are queued and sent one at a time
+// with a local sequence, so concurrent reward claims and votes don't race on it. the sequence is resynced when the
+// node reports a mismatch, and failures the node may recover from are retried. the processes sending from the same
+// account (i.e providers of other chains) share the TxSender of a tx sender daemon (remote.go)
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MaxSendAttempts       = 5
+	MaxBatchMessages      = 50              // messages of queued requests sent in one transaction
+	batchWindow           = 1 * time.Second // requests queued within it share a transaction
+	sendQueueSize         = 100
+	retryBackoff          = 2 * time.Second
+	sequenceMismatchRegex = `account sequence mismatch, expected (\d+), got (\d+)`
+)
+
+var sequenceMismatch = regexp.MustCompile(sequenceMismatchRegex)
+
+// SimulationCheck decides if a simulated transaction is sent, an error drops it
+type SimulationCheck func(simulation *Simulation) error
+
+type sendRequest struct {
+	ctx    context.Context
+	msgs   []sdk.Msg
+	check  SimulationCheck
+	result chan sendResult
+}
+
+type sendResult struct {
+	response *sdk.TxResponse
+	err      error
+}
+
+// GasLimitError is a transaction that needs more than MaximumGasAllowed
+type GasLimitError struct {
+	Gas uint64
+}
+
+func (gle *GasLimitError) Error() string {
+	return "transaction gas " + strconv.FormatUint(gle.Gas, 10) + " exceeds the limit " + strconv.FormatUint(MaximumGasAllowed, 10)
+}
+
+// Sender sends the transactions of an account, with a TxSender of the process or the one of a tx sender daemon
+type Sender interface {
+	Send(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+	SendWithCheck(ctx context.Context, check SimulationCheck, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+	SendChunked(ctx context.Context, count int, newMsg ChunkedMsg, check SimulationCheck) []ItemResult
+}
+
+type TxSender struct {
+	broadcaster Broadcaster
+	requests    chan *sendRequest
+	backoff     time.Duration
+	batchWindow time.Duration
+	// only accessed by the sending routine
+	sequence uint64
+	synced   bool
+	pending  *sendRequest // taken from the queue but didn't fit in the last batch
+}
+
+func NewTxSender(broadcaster Broadcaster) *TxSender {
+	return &TxSender{broadcaster: broadcaster, requests: make(chan *sendRequest, sendQueueSize), backoff: retryBackoff, batchWindow: batchWindow}
+}
+
+// Start sends the queued messages until ctx is done
+func (ts *TxSender) Start(ctx context.Context) {
+	go func() {
+		for {
+			request := ts.pending
+			ts.pending = nil
+			if request == nil {
+				select {
+				case <-ctx.Done():
+					return
+				case request = <-ts.requests:
+				}
+			}
+			ts.sendBatch(ctx, ts.collectBatch(ctx, request))
+		}
+	}()
+}
+
+// collectBatch returns the requests queued within the batch window of the first one that fit in a transaction. a
+// request queued alone is returned right away, so requests share transactions while a previous one is sent
+func (ts *TxSender) collectBatch(ctx context.Context, first *sendRequest) []*sendRequest {
+	batch := []*sendRequest{first}
+	if len(ts.requests) == 0 {
+		// nothing else is waiting, the request isn't delayed for requests that may come
+		return batch
+	}
+	messages := len(first.msgs)
+	window := time.NewTimer(ts.batchWindow)
+	defer window.Stop()
+	for messages < MaxBatchMessages {
+		select {
+		case <-ctx.Done():
+			return batch
+		case <-window.C:
+			return batch
+		case request := <-ts.requests:
+			if messages+len(request.msgs) > MaxBatchMessages {
+				ts.pending = request
+				return batch
+			}
+			batch = append(batch, request)
+			messages += len(request.msgs)
+		}
+	}
+	return batch
+}
+
+// sendBatch sends the messages of the requests in one transaction. when it fails each request is sent on its own so
+// one request can't fail the others
+func (ts *TxSender) sendBatch(ctx context.Context, batch []*sendRequest) {
+	if len(batch) == 1 {
+		response, err := ts.send(batch[0].ctx, batch[0].msgs, batch[0].check)
+		batch[0].result <- sendResult{response: response, err: err}
+		return
+	}
+	msgs := []sdk.Msg{}
+	checks := []SimulationCheck{}
+	for _, request := range batch {
+		msgs = append(msgs, request.msgs...)
+		if request.check != nil {
+			checks = append(checks, request.check)
+		}
+	}
+	response, err := ts.send(ctx, msgs, func(simulation *Simulation) error {
+		for _, check := range checks {
+			if err := check(simulation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		for _, request := range batch {
+			request.result <- sendResult{response: response}
+		}
+		return
+	}
+	utils.LavaFormatInfo("batched transaction failed, sending its requests separately", &map[string]string{"requests": strconv.Itoa(len(batch)), "messages": strconv.Itoa(len(msgs)), "error": err.Error()})
+	for _, request := range batch {
+		response, err := ts.send(request.ctx, request.msgs, request.check)
+		request.result <- sendResult{response: response, err: err}
+	}
+}
+
+// Send sends msgs in one transaction after the messages queued before them, and returns the node response to it.
+// messages of other requests queued at the same time may share the transaction
+func (ts *TxSender) Send(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	return ts.SendWithCheck(ctx, nil, msgs...)
+}
+
+// SendWithCheck is Send with a check of the transaction simulation before it is broadcasted
+func (ts *TxSender) SendWithCheck(ctx context.Context, check SimulationCheck, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	request := &sendRequest{ctx: ctx, msgs: msgs, check: check, result: make(chan sendResult, 1)}
+	select {
+	case ts.requests <- request:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case result := <-request.result:
+		return result.response, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (ts *TxSender) send(ctx context.Context, msgs []sdk.Msg, check SimulationCheck) (*sdk.TxResponse, error) {
+	var err error
+	for attempt := 0; attempt < MaxSendAttempts; attempt++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if attempt > 0 && !isSequenceMismatch(err) {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(ts.backoff):
+			}
+		}
+		if !ts.synced {
+			ts.sequence, err = ts.broadcaster.AccountSequence(ctx)
+			if err != nil {
+				utils.LavaFormatWarning("failed fetching account sequence", err, &map[string]string{"attempt": strconv.Itoa(attempt)})
+				continue
+			}
+			ts.synced = true
+		}
+
+		var simulation *Simulation
+		simulation, err = ts.broadcaster.Simulate(ctx, ts.sequence, msgs...)
+		if err != nil {
+			if isSequenceMismatch(err) {
+				ts.resync(err)
+				continue
+			}
+			if isTransientSimulationError(err) {
+				utils.LavaFormatWarning("failed simulating transaction, retrying", err, &map[string]string{"attempt": strconv.Itoa(attempt)})
+				continue
+			}
+			return nil, &SimulationError{Err: err}
+		}
+		if simulation.Gas > MaximumGasAllowed {
+			return nil, &GasLimitError{Gas: simulation.Gas}
+		}
+		if check != nil {
+			if err := check(simulation); err != nil {
+				return nil, err
+			}
+		}
+
+		var response *sdk.TxResponse
+		response, err = ts.broadcaster.Broadcast(ctx, ts.sequence, simulation.Gas, msgs...)
+		if err != nil {
+			// the transaction may have reached the node, the sequence is fetched again
+			ts.synced = false
+			utils.LavaFormatWarning("failed broadcasting transaction, retrying", err, &map[string]string{"attempt": strconv.Itoa(attempt)})
+			continue
+		}
+		// transactions are broadcasted in sync mode, the response is of the mempool check. only transactions accepted to the
+		// mempool use the sequence, their execution in a block (i.e running out of gas) isn't known here
+		if response.Code == 0 {
+			ts.sequence++
+			return response, nil
+		}
+
+		err = &TxError{Response: response}
+		switch {
+		case isSequenceMismatch(err):
+			ts.resync(err)
+			continue
+		case isCode(response, sdkerrors.ErrMempoolIsFull):
+			utils.LavaFormatWarning("transaction failed, retrying", err, &map[string]string{"attempt": strconv.Itoa(attempt), "txHash": response.TxHash})
+			continue
+		case isCode(response, sdkerrors.ErrTxInMempoolCache):
+			// a previous attempt reached the mempool even though its broadcast failed
+			ts.sequence++
+			return response, nil
+		}
+		return response, err
+	}
+	return nil, utils.LavaFormatError("failed sending transaction", err, &map[string]string{"attempts": strconv.Itoa(MaxSendAttempts)})
+}
+
+// resync sets the sequence to the one the node expects, or fetches it when the error doesn't say
+func (ts *TxSender) resync(err error) {
+	match := sequenceMismatch.FindStringSubmatch(err.Error())
+	if match != nil {
+		if expected, parseErr := strconv.ParseUint(match[1], 10, 64); parseErr == nil {
+			utils.LavaFormatInfo("account sequence mismatch, resyncing", &map[string]string{"sequence": strconv.FormatUint(ts.sequence, 10), "expected": match[1]})
+			ts.sequence = expected
+			return
+		}
+	}
+	ts.synced = false
+}
+
+// SimulationError is a transaction the simulation failed
+type SimulationError struct {
+	Err error
+}
+
+func (se *SimulationError) Error() string {
+	return "transaction simulation failed: " + se.Err.Error()
+}
+
+func (se *SimulationError) Unwrap() error {
+	return se.Err
+}
+
+// TxError is a transaction the node didn't accept to its mempool
+type TxError struct {
+	Response *sdk.TxResponse
+}
+
+func (te *TxError) Error() string {
+	return "transaction failed with code " + strconv.FormatUint(uint64(te.Response.Code), 10) + " (" + te.Response.Codespace + "): " + te.Response.RawLog
+}
+
+func isCode(response *sdk.TxResponse, sdkErr *sdkerrors.Error) bool {
+	return response.Codespace == sdkErr.Codespace() && response.Code == sdkErr.ABCICode()
+}
+
+func isSequenceMismatch(err error) bool {
+	if err == nil {
+		return false
+	}
+	if txErr, ok := err.(*TxError); ok {
+		return isCode(txErr.Response, sdkerrors.ErrWrongSequence)
+	}
+	return sequenceMismatch.MatchString(err.Error())
+}
+
+// isTransientSimulationError returns true when the node couldn't simulate, rather than the transaction failing
+func isTransientSimulationError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,346 @@
+package txsender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	simulatedGas = 10000
+	itemGas      = 100000 // gas of every item of a chunked message
+)
+
+type testMsg struct {
+	sdk.Msg
+	id      int
+	items   []int // items of a chunked message
+	invalid bool
+}
+
+func (tm *testMsg) ValidateBasic() error {
+	if tm.invalid {
+		return errors.New("invalid message")
+	}
+	return nil
+}
+
+// fakeBroadcaster is a chain where every broadcasted transaction is included, its failures are injected
+type fakeBroadcaster struct {
+	lock             sync.Mutex
+	sequence         uint64
+	included         []int // ids of the included messages, in order
+	sequenceQueries  int
+	simulateFailures []error
+	broadcastResults []*sdk.TxResponse // consumed before including transactions
+	broadcastErrors  []error
+	gas              []uint64 // gas of every broadcast
+	failingItems     map[int]struct{}
+	transactions     int
+	hold             chan struct{} // the next broadcast signals it and waits on it
+}
+
+func (fb *fakeBroadcaster) AccountSequence(ctx context.Context) (uint64, error) {
+	fb.lock.Lock()
+	defer fb.lock.Unlock()
+	fb.sequenceQueries++
+	return fb.sequence, nil
+}
+
+func (fb *fakeBroadcaster) Simulate(ctx context.Context, sequence uint64, msgs ...sdk.Msg) (*Simulation, error) {
+	fb.lock.Lock()
+	defer fb.lock.Unlock()
+	if len(fb.simulateFailures) > 0 {
+		err := fb.simulateFailures[0]
+		fb.simulateFailures = fb.simulateFailures[1:]
+		return nil, err
+	}
+	if sequence != fb.sequence {
+		return nil, fmt.Errorf("account sequence mismatch, expected %d, got %d: incorrect account sequence", fb.sequence, sequence)
+	}
+	gas := uint64(0)
+	for _, msg := range msgs {
+		items := msg.(*testMsg).items
+		if len(items) == 0 {
+			gas += simulatedGas
+		}
+		for _, item := range items {
+			if _, ok := fb.failingItems[item]; ok {
+				return nil, status.Error(codes.Unknown, "invalid relay")
+			}
+			gas += itemGas
+		}
+	}
+	return &Simulation{Gas: gas}, nil
+}
+
+func (fb *fakeBroadcaster) Broadcast(ctx context.Context, sequence uint64, gas uint64, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	fb.lock.Lock()
+	hold := fb.hold
+	fb.hold = nil
+	fb.lock.Unlock()
+	if hold != nil {
+		hold <- struct{}{}
+		<-hold
+	}
+	fb.lock.Lock()
+	defer fb.lock.Unlock()
+	fb.gas = append(fb.gas, gas)
+	if len(fb.broadcastErrors) > 0 {
+		err := fb.broadcastErrors[0]
+		fb.broadcastErrors = fb.broadcastErrors[1:]
+		return nil, err
+	}
+	if len(fb.broadcastResults) > 0 {
+		response := fb.broadcastResults[0]
+		fb.broadcastResults = fb.broadcastResults[1:]
+		return response, nil
+	}
+	if sequence != fb.sequence {
+		return errorResponse(sdkerrors.ErrWrongSequence, fmt.Sprintf("account sequence mismatch, expected %d, got %d: incorrect account sequence", fb.sequence, sequence)), nil
+	}
+	fb.sequence++
+	fb.transactions++
+	for _, msg := range msgs {
+		if items := msg.(*testMsg).items; len(items) > 0 {
+			fb.included = append(fb.included, items...)
+			continue
+		}
+		fb.included = append(fb.included, msg.(*testMsg).id)
+	}
+	return &sdk.TxResponse{TxHash: fmt.Sprintf("TX%d", sequence)}, nil
+}
+
+// useSequence simulates a transaction of the account sent by someone else
+func (fb *fakeBroadcaster) useSequence() {
+	fb.lock.Lock()
+	defer fb.lock.Unlock()
+	fb.sequence++
+}
+
+func errorResponse(sdkErr *sdkerrors.Error, log string) *sdk.TxResponse {
+	return &sdk.TxResponse{Code: sdkErr.ABCICode(), Codespace: sdkErr.Codespace(), RawLog: log}
+}
+
+func startTxSender(t *testing.T, broadcaster Broadcaster) *TxSender {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	txSender := NewTxSender(broadcaster)
+	txSender.backoff = time.Millisecond
+	txSender.batchWindow = time.Millisecond
+	txSender.Start(ctx)
+	return txSender
+}
+
+func TestConcurrentSends(t *testing.T) {
+	broadcaster := &fakeBroadcaster{sequence: 7}
+	txSender := startTxSender(t, broadcaster)
+	const senders = 20
+	var wg sync.WaitGroup
+	wg.Add(senders)
+	for i := 0; i < senders; i++ {
+		go func(id int) {
+			defer wg.Done()
+			response, err := txSender.Send(context.Background(), &testMsg{id: id})
+			require.NoError(t, err)
+			require.Equal(t, uint32(0), response.Code)
+		}(i)
+	}
+	wg.Wait()
+	require.Len(t, broadcaster.included, senders)
+	require.Equal(t, uint64(7+broadcaster.transactions), broadcaster.sequence)
+	require.Equal(t, 1, broadcaster.sequenceQueries)
+}
+
+func TestSequenceResync(t *testing.T) {
+	broadcaster := &fakeBroadcaster{}
+	txSender := startTxSender(t, broadcaster)
+	_, err := txSender.Send(context.Background(), &testMsg{id: 1})
+	require.NoError(t, err)
+
+	// another transaction of the account makes the simulation fail
+	broadcaster.useSequence()
+	response, err := txSender.Send(context.Background(), &testMsg{id: 2})
+	require.NoError(t, err)
+	require.Equal(t, "TX2", response.TxHash)
+
+	// the broadcast fails on a mismatch the simulation didn't catch
+	broadcaster.broadcastResults = []*sdk.TxResponse{errorResponse(sdkerrors.ErrWrongSequence, "account sequence mismatch, expected 4, got 3: incorrect account sequence")}
+	broadcaster.useSequence()
+	response, err = txSender.Send(context.Background(), &testMsg{id: 3})
+	require.NoError(t, err)
+	require.Equal(t, "TX4", response.TxHash)
+	require.Equal(t, []int{1, 2, 3}, broadcaster.included)
+	require.Equal(t, 1, broadcaster.sequenceQueries)
+}
+
+func TestTransientFailuresRetried(t *testing.T) {
+	broadcaster := &fakeBroadcaster{
+		simulateFailures: []error{status.Error(codes.Unavailable, "node down")},
+		broadcastErrors:  []error{errors.New("connection reset")},
+		broadcastResults: []*sdk.TxResponse{errorResponse(sdkerrors.ErrMempoolIsFull, "mempool is full")},
+	}
+	txSender := startTxSender(t, broadcaster)
+	_, err := txSender.Send(context.Background(), &testMsg{id: 1})
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, broadcaster.included)
+	require.Equal(t, []uint64{simulatedGas, simulatedGas, simulatedGas}, broadcaster.gas)
+	// the sequence is fetched again after a failed broadcast
+	require.Equal(t, 2, broadcaster.sequenceQueries)
+}
+
+func TestPermanentFailures(t *testing.T) {
+	broadcaster := &fakeBroadcaster{
+		simulateFailures: []error{status.Error(codes.Unknown, "invalid relay proof")},
+		broadcastResults: []*sdk.TxResponse{errorResponse(sdkerrors.ErrInsufficientFunds, "insufficient funds")},
+	}
+	txSender := startTxSender(t, broadcaster)
+
+	_, err := txSender.Send(context.Background(), &testMsg{id: 1, invalid: true})
+	require.Error(t, err)
+
+	_, err = txSender.Send(context.Background(), &testMsg{id: 2})
+	require.Error(t, err)
+	require.Empty(t, broadcaster.gas)
+
+	response, err := txSender.Send(context.Background(), &testMsg{id: 3})
+	var txErr *TxError
+	require.ErrorAs(t, err, &txErr)
+	require.Equal(t, sdkerrors.ErrInsufficientFunds.ABCICode(), response.Code)
+	require.Len(t, broadcaster.gas, 1)
+
+	// a failing check drops the transaction
+	checkErr := errors.New("not profitable")
+	_, err = txSender.SendWithCheck(context.Background(), func(simulation *Simulation) error {
+		require.Equal(t, uint64(simulatedGas), simulation.Gas)
+		return checkErr
+	}, &testMsg{id: 4})
+	require.Equal(t, checkErr, err)
+	require.Empty(t, broadcaster.included)
+
+	// the sequence wasn't used by the failed transactions
+	_, err = txSender.Send(context.Background(), &testMsg{id: 5})
+	require.NoError(t, err)
+	require.Equal(t, []int{5}, broadcaster.included)
+}
+
+func TestGivesUpAfterMaxAttempts(t *testing.T) {
+	broadcaster := &fakeBroadcaster{}
+	for i := 0; i < MaxSendAttempts; i++ {
+		broadcaster.broadcastResults = append(broadcaster.broadcastResults, errorResponse(sdkerrors.ErrMempoolIsFull, "mempool is full"))
+	}
+	txSender := startTxSender(t, broadcaster)
+	_, err := txSender.Send(context.Background(), &testMsg{id: 1})
+	require.Error(t, err)
+	require.Len(t, broadcaster.gas, MaxSendAttempts)
+}
+
+func TestQueuedRequestsShareTransaction(t *testing.T) {
+	broadcaster := &fakeBroadcaster{failingItems: map[int]struct{}{3: {}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	txSender := NewTxSender(broadcaster)
+	txSender.backoff = time.Millisecond
+	txSender.batchWindow = time.Hour // the batch is sent once it's full
+	txSender.Start(ctx)
+
+	send := func(msgs ...sdk.Msg) chan error {
+		errs := make(chan error, 1)
+		go func() {
+			_, err := txSender.Send(context.Background(), msgs...)
+			errs <- err
+		}()
+		return errs
+	}
+	// requests are queued while the transaction of a previous one is broadcasted
+	sendQueued := func(msgs ...[]sdk.Msg) []chan error {
+		hold := make(chan struct{})
+		broadcaster.lock.Lock()
+		broadcaster.hold = hold
+		broadcaster.lock.Unlock()
+		results := []chan error{send(&testMsg{id: 0})}
+		<-hold
+		for _, requestMsgs := range msgs {
+			results = append(results, send(requestMsgs...))
+		}
+		require.Eventually(t, func() bool { return len(txSender.requests) == len(msgs) }, time.Second, time.Millisecond)
+		hold <- struct{}{}
+		require.NoError(t, <-results[0])
+		return results[1:]
+	}
+
+	// a request queued alone isn't delayed by the batch window
+	sendCtx, sendCancel := context.WithTimeout(context.Background(), time.Second)
+	defer sendCancel()
+	_, err := txSender.Send(sendCtx, &testMsg{id: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, broadcaster.transactions)
+
+	msgs := make([]sdk.Msg, MaxBatchMessages-1)
+	for idx := range msgs {
+		msgs[idx] = &testMsg{id: 100 + idx}
+	}
+	results := sendQueued(msgs, []sdk.Msg{&testMsg{id: 2}})
+	require.NoError(t, <-results[0])
+	require.NoError(t, <-results[1])
+	require.Equal(t, 3, broadcaster.transactions)
+	require.Len(t, broadcaster.included, 2+MaxBatchMessages)
+
+	// a failing request doesn't fail the requests sharing its transaction
+	txSender.batchWindow = 50 * time.Millisecond
+	results = sendQueued([]sdk.Msg{&testMsg{items: []int{3}}}, []sdk.Msg{&testMsg{id: 3}})
+	require.Error(t, <-results[0])
+	require.NoError(t, <-results[1])
+	require.Equal(t, 5, broadcaster.transactions)
+	require.Contains(t, broadcaster.included, 3)
+}
+
+func TestSendChunked(t *testing.T) {
+	const items = 120
+	broadcaster := &fakeBroadcaster{}
+	txSender := startTxSender(t, broadcaster)
+	chunks := [][]int{}
+	newMsg := func(items []int) sdk.Msg {
+		chunks = append(chunks, items)
+		return &testMsg{items: items}
+	}
+	results := txSender.SendChunked(context.Background(), items, newMsg, nil)
+	require.Len(t, results, items)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+		require.NotEmpty(t, result.TxHash)
+	}
+	require.Len(t, broadcaster.included, items)
+	// the chunk is split by its gas
+	parts := items*itemGas/MaximumGasAllowed + 1
+	require.Len(t, chunks, parts+1)
+	for _, chunk := range chunks[1:] {
+		require.Len(t, chunk, items/parts)
+	}
+	for _, gas := range broadcaster.gas {
+		require.LessOrEqual(t, gas, uint64(MaximumGasAllowed))
+	}
+
+	// failing items are split from the ones sent
+	broadcaster.included = nil
+	broadcaster.failingItems = map[int]struct{}{3: {}, 20: {}}
+	results = txSender.SendChunked(context.Background(), 24, newMsg, nil)
+	for item, result := range results {
+		if item == 3 || item == 20 {
+			var simulationErr *SimulationError
+			require.ErrorAs(t, result.Err, &simulationErr)
+			continue
+		}
+		require.NoError(t, result.Err)
+	}
+	require.Len(t, broadcaster.included, 22)
+}
@@ -0,0 +1,38 @@
+package relayer
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/relayer/sigs"
+	"github.com/lavanet/lava/relayer/txsender"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+)
+
+// TxSenderDaemon sends the transactions of the --from account for the servers started with --tx-sender, so the servers
+// of all its chains share the account sequence and their reward claims and votes share transactions
+func TxSenderDaemon(ctx context.Context, clientCtx client.Context, txFactory tx.Factory, listenAddr string, remoteSigner string) error {
+	signer, err := sigs.NewSigner(clientCtx, remoteSigner)
+	if err != nil {
+		return utils.LavaFormatError("tx sender failure to load signer", err, &map[string]string{"remoteSigner": remoteSigner})
+	}
+	clientCtx, txFactory = sigs.WithTxSigner(clientCtx, txFactory, signer)
+	sender := txsender.NewTxSender(txsender.NewClientBroadcaster(clientCtx, txFactory))
+	sender.Start(ctx)
+
+	listener, err := sigs.SignerListen(listenAddr)
+	if err != nil {
+		return utils.LavaFormatError("tx sender failure to listen", err, &map[string]string{"listenAddr": listenAddr})
+	}
+	grpcServer := grpc.NewServer()
+	pairingtypes.RegisterTxSenderServer(grpcServer, txsender.NewTxSenderServer(sender, sigs.SignerAddress(signer), clientCtx.InterfaceRegistry))
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+	utils.LavaFormatInfo("Tx sender started", &map[string]string{"listenAddr": listenAddr, "address": sigs.SignerAddress(signer).String(), "remoteSigner": remoteSigner})
+	return grpcServer.Serve(listener)
+}
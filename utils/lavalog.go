@@ -3,8 +3,12 @@ package utils
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"runtime"
 	"runtime/debug"
+	"strings"
+	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,30 +47,149 @@ func LavaError(ctx sdk.Context, logger log.Logger, name string, attributes map[s
 	return errors.New(err_msg)
 }
 
-func LoggingLevel(logLevel string) {
+// log output formats
+const (
+	LogFormatPlain  = "plain"
+	LogFormatJSON   = "json"
+	LogFormatLogfmt = "logfmt"
+
+	ComponentLogLevelsFlagName = "component_log_levels"
+)
+
+// logging configuration, set once by the process before it starts logging from other goroutines
+var (
+	logConfigMu        sync.RWMutex
+	logFormat                    = LogFormatPlain
+	globalLogLevel               = zerolog.InfoLevel
+	componentLogLevels           = map[string]zerolog.Level{}
+	logOutput          io.Writer = os.Stderr
+)
+
+func init() {
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	// LAVA_OUTPUT=json predates the log format flag
+	if os.Getenv("LAVA_OUTPUT") == LogFormatJSON {
+		logFormat = LogFormatJSON
+	}
+	setLogOutput()
+}
+
+func setLogOutput() {
+	switch logFormat {
+	case LogFormatJSON:
+		zerologlog.Logger = zerolog.New(logOutput).With().Timestamp().Logger()
+	case LogFormatLogfmt:
+		zerologlog.Logger = zerolog.New(logfmtWriter{out: logOutput}).With().Timestamp().Logger()
+	default:
+		zerologlog.Logger = zerolog.New(zerolog.ConsoleWriter{Out: logOutput, NoColor: true, TimeFormat: time.Stamp}).With().Timestamp().Logger()
+	}
+}
+
+func parseLogLevel(logLevel string) (zerolog.Level, bool) {
 	switch logLevel {
 	case "debug":
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+		return zerolog.DebugLevel, true
 	case "info":
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+		return zerolog.InfoLevel, true
 	case "warn":
-		zerolog.SetGlobalLevel(zerolog.WarnLevel)
+		return zerolog.WarnLevel, true
 	case "error":
-		zerolog.SetGlobalLevel(zerolog.ErrorLevel)
+		return zerolog.ErrorLevel, true
 	case "fatal":
-		zerolog.SetGlobalLevel(zerolog.FatalLevel)
+		return zerolog.FatalLevel, true
 	default:
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+		return zerolog.InfoLevel, false
 	}
+}
+
+// updateZerologLevel lets through the lowest configured level, LavaFormatLog filters each component by its own level
+func updateZerologLevel() {
+	minLevel := globalLogLevel
+	for _, level := range componentLogLevels {
+		if level < minLevel {
+			minLevel = level
+		}
+	}
+	zerolog.SetGlobalLevel(minLevel)
+}
+
+func LoggingLevel(logLevel string) {
+	logConfigMu.Lock()
+	globalLogLevel, _ = parseLogLevel(logLevel)
+	updateZerologLevel()
+	logConfigMu.Unlock()
 	LavaFormatInfo("setting log level", &map[string]string{"loglevel": logLevel})
 }
 
+// LoggingFormat sets the log output format, plain, json or logfmt. json and logfmt keep the attributes as fields
+func LoggingFormat(format string) error {
+	switch format {
+	case "", LogFormatPlain, LogFormatJSON, LogFormatLogfmt:
+	default:
+		return fmt.Errorf("invalid log format %s, expected %s, %s or %s", format, LogFormatPlain, LogFormatJSON, LogFormatLogfmt)
+	}
+	logConfigMu.Lock()
+	defer logConfigMu.Unlock()
+	if format == "" {
+		format = LogFormatPlain
+	}
+	logFormat = format
+	setLogOutput()
+	return nil
+}
+
+// LoggingComponentLevels sets log levels overriding the global level for components, i.e chainproxy=debug,sentry=info.
+// the component of a log is the package it's logged from
+func LoggingComponentLevels(levels string) error {
+	parsed := map[string]zerolog.Level{}
+	for _, componentLevel := range strings.Split(levels, ",") {
+		if strings.TrimSpace(componentLevel) == "" {
+			continue
+		}
+		component, logLevel, found := strings.Cut(strings.TrimSpace(componentLevel), "=")
+		level, valid := parseLogLevel(logLevel)
+		if !found || component == "" || !valid {
+			return fmt.Errorf("invalid component log level %s, expected component=level", componentLevel)
+		}
+		parsed[component] = level
+	}
+	logConfigMu.Lock()
+	defer logConfigMu.Unlock()
+	componentLogLevels = parsed
+	updateZerologLevel()
+	return nil
+}
+
+// logComponent returns the package name of the code calling the LavaFormat helpers
+func logComponent() string {
+	pcs := make([]uintptr, 8)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		// package path, then the function name after the first dot of the last path element
+		function := frame.Function
+		pkg := function
+		if slash := strings.LastIndex(function, "/"); slash >= 0 {
+			pkg = function[slash+1:]
+		}
+		name := ""
+		if dot := strings.Index(pkg, "."); dot >= 0 {
+			pkg, name = pkg[:dot], pkg[dot+1:]
+		}
+		if !(pkg == "utils" && strings.HasPrefix(name, "LavaFormat")) || !more {
+			return pkg
+		}
+	}
+}
+
 func LavaFormatLog(description string, err error, extraAttributes *map[string]string, severity uint) error {
-	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
-	// os.Getenv("LAVA_DISABLE_COLORS") == "true"
-	NoColor := true
-	if os.Getenv("LAVA_OUTPUT") != "json" {
-		zerologlog.Logger = zerologlog.Output(zerolog.ConsoleWriter{Out: os.Stderr, NoColor: NoColor, TimeFormat: time.Stamp})
+	logConfigMu.RLock()
+	structured := logFormat != LogFormatPlain
+	checkComponent := len(componentLogLevels) > 0
+	logConfigMu.RUnlock()
+	component := ""
+	if structured || checkComponent {
+		component = logComponent()
 	}
 
 	var logEvent *zerolog.Event
@@ -88,6 +211,22 @@ func LavaFormatLog(description string, err error, extraAttributes *map[string]st
 		logEvent = zerologlog.Debug()
 		// prefix = "Debug:"
 	}
+	if checkComponent && logEvent != nil {
+		logConfigMu.RLock()
+		level, overridden := componentLogLevels[component]
+		if !overridden {
+			level = globalLogLevel
+		}
+		logConfigMu.RUnlock()
+		if severityLevel(severity) < level {
+			// the event is dropped, only the error is returned
+			logEvent.Discard()
+			logEvent = nil
+		}
+	}
+	if structured && logEvent != nil {
+		logEvent = logEvent.Str("component", component)
+	}
 	output := description
 	if err != nil {
 		logEvent = logEvent.Err(err)
@@ -108,6 +247,21 @@ func LavaFormatLog(description string, err error, extraAttributes *map[string]st
 	return errRet
 }
 
+func severityLevel(severity uint) zerolog.Level {
+	switch severity {
+	case 4:
+		return zerolog.FatalLevel
+	case 3:
+		return zerolog.ErrorLevel
+	case 2:
+		return zerolog.WarnLevel
+	case 1:
+		return zerolog.InfoLevel
+	default:
+		return zerolog.DebugLevel
+	}
+}
+
 func LavaFormatFatal(description string, err error, extraAttributes *map[string]string) {
 	if extraAttributes != nil {
 		(*extraAttributes)["StackTrace"] = string(debug.Stack())
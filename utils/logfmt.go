@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	zerolog "github.com/rs/zerolog"
+)
+
+// logfmt fields written before the attributes, in this order
+var logfmtLeadingFields = []string{zerolog.TimestampFieldName, zerolog.LevelFieldName, "component", zerolog.MessageFieldName, zerolog.ErrorFieldName}
+
+// logfmtWriter rewrites the json events of zerolog as logfmt lines, key=value pairs with the value quoted when needed
+type logfmtWriter struct {
+	out io.Writer
+}
+
+func (lw logfmtWriter) Write(p []byte) (int, error) {
+	fields := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(p))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		// not an event, write it as is
+		return lw.out.Write(p)
+	}
+	var line strings.Builder
+	writeField := func(key string, value interface{}) {
+		if line.Len() > 0 {
+			line.WriteByte(' ')
+		}
+		line.WriteString(key)
+		line.WriteByte('=')
+		line.WriteString(logfmtValue(value))
+	}
+	for _, key := range logfmtLeadingFields {
+		if value, ok := fields[key]; ok {
+			writeField(key, value)
+			delete(fields, key)
+		}
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		writeField(key, fields[key])
+	}
+	line.WriteByte('\n')
+	if _, err := io.WriteString(lw.out, line.String()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func logfmtValue(value interface{}) string {
+	str, ok := value.(string)
+	if !ok {
+		str = fmt.Sprint(value)
+	}
+	if str == "" || strings.ContainsAny(str, " =\"\t\n") {
+		return strconv.Quote(str)
+	}
+	return str
+}
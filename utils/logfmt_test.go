@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// captureLogs sends the logs to a buffer with format until the test ends
+func captureLogs(t *testing.T, format string) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	logOutput = buf
+	require.Nil(t, LoggingFormat(format))
+	t.Cleanup(func() {
+		logOutput = os.Stderr
+		LoggingFormat(LogFormatPlain)
+		LoggingComponentLevels("")
+		LoggingLevel("info")
+	})
+	return buf
+}
+
+func TestStructuredLogs(t *testing.T) {
+	buf := captureLogs(t, LogFormatJSON)
+	LavaFormatWarning("provider failed", nil, &map[string]string{"provider": "lava@1", "chainID": "ETH1"})
+	fields := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(buf.Bytes(), &fields))
+	require.Equal(t, "warn", fields["level"])
+	require.Equal(t, "provider failed", fields["message"])
+	require.Equal(t, "lava@1", fields["provider"])
+	require.Equal(t, "ETH1", fields["chainID"])
+	require.Equal(t, "utils", fields["component"])
+
+	buf = captureLogs(t, LogFormatLogfmt)
+	LavaFormatInfo("relay done", &map[string]string{"latency": "10ms", "reply": "a b"})
+	line := strings.TrimSpace(buf.String())
+	require.Contains(t, line, `level=info component=utils message="relay done" latency=10ms reply="a b"`)
+
+	require.NotNil(t, LoggingFormat("xml"))
+}
+
+func TestComponentLogLevels(t *testing.T) {
+	buf := captureLogs(t, LogFormatJSON)
+	LoggingLevel("error")
+	buf.Reset()
+	LavaFormatDebug("dropped", nil)
+	require.Empty(t, buf.String())
+
+	require.Nil(t, LoggingComponentLevels("utils=debug,chainproxy=info"))
+	LavaFormatDebug("kept", nil)
+	require.Contains(t, buf.String(), "kept")
+
+	require.Nil(t, LoggingComponentLevels("chainproxy=debug"))
+	buf.Reset()
+	LavaFormatDebug("dropped", nil)
+	require.Empty(t, buf.String())
+	// the error is returned either way
+	require.NotNil(t, LavaFormatWarning("dropped", nil, nil))
+
+	require.NotNil(t, LoggingComponentLevels("chainproxy"))
+	require.NotNil(t, LoggingComponentLevels("chainproxy=loud"))
+}
@@ -2,12 +2,17 @@ package utils
 
 import (
 	"bytes"
+	stdEd25519 "crypto/ed25519"
 	"encoding/binary"
 	"fmt"
 
 	"github.com/99designs/keyring"
 	vrf "github.com/coniks-sys/coniks-go/crypto/vrf"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -15,9 +20,13 @@ import (
 )
 
 const (
-	bechPrefix    = "vrf"
-	pk_vrf_prefix = "vrf-pk-"
-	sk_vrf_prefix = "vrf-sk-"
+	bechPrefix              = "vrf"
+	pk_vrf_prefix           = "vrf-pk-" // legacy vrf file
+	sk_vrf_prefix           = "vrf-sk-" // legacy vrf file
+	vrf_key_prefix          = "vrf-"
+	previous_vrf_key_prefix = "vrf-previous-"
+	pending_vrf_key_prefix  = "vrf-pending-"
+	vrf_key_algo            = "ed25519"
 )
 
 var VRFValueAboveReliabilityThresholdError = sdkerrors.New("VRFValueAboveReliabilityThreshold Error", 1, "calculated vrf does not result in a smaller value than threshold") // client could'nt connect to any provider.
@@ -101,6 +110,18 @@ func VerifyVRF(vrfpk string) error {
 	return nil
 }
 
+// ParseVrfPubKey decodes a bech32 vrf pk and checks it's a valid verification key
+func ParseVrfPubKey(vrfpk string) (*VrfPubKey, error) {
+	pk, err := (&VrfPubKey{}).DecodeFromBech32(vrfpk)
+	if err != nil {
+		return nil, err
+	}
+	if len(pk.Bytes()) != vrf.PublicKeySize {
+		return nil, fmt.Errorf("invalid vrf pk length %d, expected %d", len(pk.Bytes()), vrf.PublicKeySize)
+	}
+	return pk, nil
+}
+
 func GeneratePrivateVRFKey() (vrf.PrivateKey, vrf.PublicKey, error) {
 	privateKey, err := vrf.GenerateKey(nil)
 	if err != nil {
@@ -122,29 +143,213 @@ func GetOrCreateVRFKey(clientCtx client.Context) (sk vrf.PrivateKey, pk *VrfPubK
 	return
 }
 
-func GenerateVRFKey(clientCtx client.Context) (vrf.PrivateKey, *VrfPubKey, error) {
-	kr, err := OpenKeyring(clientCtx)
+// GetOrCreateVRFKeys returns the vrf key of the --from account followed by the one it replaced when it's kept
+func GetOrCreateVRFKeys(clientCtx client.Context) ([]vrf.PrivateKey, error) {
+	if _, _, err := GetOrCreateVRFKey(clientCtx); err != nil {
+		return nil, err
+	}
+	current, previous, err := LoadVRFKeys(clientCtx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	if previous == nil {
+		return []vrf.PrivateKey{current}, nil
 	}
+	return []vrf.PrivateKey{current, previous}, nil
+}
+
+// vrf keys are kept in the account keyring as ed25519 keys, so they're encrypted at rest like the account keys of
+// the keyring backend. the previous key is kept after a rotation until the chain stops using it
+func VRFKeyName(name string) string {
+	return vrf_key_prefix + name
+}
+
+func PreviousVRFKeyName(name string) string {
+	return previous_vrf_key_prefix + name
+}
+
+// a rotated key is staged under its own name until the rotation tx is confirmed, the chain still expects the current key
+func PendingVRFKeyName(name string) string {
+	return pending_vrf_key_prefix + name
+}
+
+func GenerateVRFKey(clientCtx client.Context) (vrf.PrivateKey, *VrfPubKey, error) {
 	sk, pk, err := GeneratePrivateVRFKey()
 	if err != nil {
 		return nil, nil, err
 	}
-	key := keyring.Item{Key: pk_vrf_prefix + clientCtx.FromName, Data: pk, Label: "pk", Description: "the vrf public key"}
-	err = kr.Set(key)
+	err = storeVRFKey(clientCtx, VRFKeyName(clientCtx.FromName), sk)
 	if err != nil {
 		return nil, nil, err
 	}
-	key = keyring.Item{Key: sk_vrf_prefix + clientCtx.FromName, Data: sk, Label: "sk", Description: "the vrf secret key"}
-	err = kr.Set(key)
+	return sk, &VrfPubKey{pk: pk}, nil
+}
+
+// LoadVRFKey loads the vrf key of the --from account, a key in the legacy vrf file is moved to the keyring
+func LoadVRFKey(clientCtx client.Context) (vrf.PrivateKey, *VrfPubKey, error) {
+	sk, err := loadVRFKey(clientCtx, VRFKeyName(clientCtx.FromName))
+	if err != nil {
+		var legacyErr error
+		sk, legacyErr = migrateLegacyVRFKey(clientCtx)
+		if legacyErr != nil {
+			return nil, nil, err
+		}
+	}
+	pk, err := VrfPublicKey(sk)
+	return sk, pk, err
+}
+
+// LoadVRFKeys loads the current vrf key of the --from account and the one it replaced, previous is nil when there's none
+func LoadVRFKeys(clientCtx client.Context) (current vrf.PrivateKey, previous vrf.PrivateKey, err error) {
+	current, _, err = LoadVRFKey(clientCtx)
 	if err != nil {
 		return nil, nil, err
 	}
-	return sk, &VrfPubKey{pk: pk}, nil
+	previous, err = loadVRFKey(clientCtx, PreviousVRFKeyName(clientCtx.FromName))
+	if err != nil {
+		previous = nil
+	}
+	return current, previous, nil
+}
+
+// SetVRFKeys replaces the vrf keys of the --from account, a nil previous removes it
+func SetVRFKeys(clientCtx client.Context, current vrf.PrivateKey, previous vrf.PrivateKey) error {
+	currentName, previousName := VRFKeyName(clientCtx.FromName), PreviousVRFKeyName(clientCtx.FromName)
+	// the keyring doesn't keep a key under two names, the current key becomes the previous one on rotation
+	for _, uid := range []string{currentName, previousName} {
+		if err := deleteVRFKey(clientCtx, uid); err != nil {
+			return err
+		}
+	}
+	if previous != nil {
+		if err := storeVRFKey(clientCtx, previousName, previous); err != nil {
+			return err
+		}
+	}
+	return storeVRFKey(clientCtx, currentName, current)
+}
+
+// StageVRFKey keeps a new vrf key of the --from account aside until PromoteVRFKey, it refuses to replace a staged key
+// since its rotation tx may still be included
+func StageVRFKey(clientCtx client.Context, sk vrf.PrivateKey) error {
+	if _, err := LoadPendingVRFKey(clientCtx); err == nil {
+		return fmt.Errorf("vrf key %s is already staged, promote or discard it first", PendingVRFKeyName(clientCtx.FromName))
+	}
+	return storeVRFKey(clientCtx, PendingVRFKeyName(clientCtx.FromName), sk)
 }
 
-func OpenKeyring(clientCtx client.Context) (keyring.Keyring, error) {
+// LoadPendingVRFKey loads the staged vrf key of the --from account
+func LoadPendingVRFKey(clientCtx client.Context) (vrf.PrivateKey, error) {
+	return loadVRFKey(clientCtx, PendingVRFKeyName(clientCtx.FromName))
+}
+
+// PromoteVRFKey makes the staged vrf key the current one once its rotation tx succeeded, the replaced key is kept as previous
+func PromoteVRFKey(clientCtx client.Context) error {
+	pending, err := LoadPendingVRFKey(clientCtx)
+	if err != nil {
+		return err
+	}
+	current, _, err := LoadVRFKey(clientCtx)
+	if err != nil {
+		return err
+	}
+	// the keyring doesn't keep a key under two names, so the staged name is dropped first and restored on failure
+	err = DiscardPendingVRFKey(clientCtx)
+	if err != nil {
+		return err
+	}
+	err = SetVRFKeys(clientCtx, pending, current)
+	if err != nil {
+		if restoreErr := storeVRFKey(clientCtx, PendingVRFKeyName(clientCtx.FromName), pending); restoreErr != nil {
+			return LavaFormatError("failed restoring the staged vrf key after a failed promotion", restoreErr, &map[string]string{"error": err.Error()})
+		}
+		return err
+	}
+	return nil
+}
+
+// DiscardPendingVRFKey removes the staged vrf key of the --from account after its rotation tx failed
+func DiscardPendingVRFKey(clientCtx client.Context) error {
+	return deleteVRFKey(clientCtx, PendingVRFKeyName(clientCtx.FromName))
+}
+
+// VrfPublicKey returns the verification key of a vrf secret key
+func VrfPublicKey(sk vrf.PrivateKey) (*VrfPubKey, error) {
+	pk, success := sk.Public()
+	if !success {
+		return nil, fmt.Errorf("invalid vrf secret key length %d", len(sk))
+	}
+	return &VrfPubKey{pk: pk}, nil
+}
+
+func storeVRFKey(clientCtx client.Context, uid string, sk vrf.PrivateKey) error {
+	if clientCtx.Keyring == nil {
+		return fmt.Errorf("no keyring to store the vrf key in")
+	}
+	if err := deleteVRFKey(clientCtx, uid); err != nil {
+		return err
+	}
+	// the armor only moves the key into the keyring, the keyring backend encrypts it
+	armor := crypto.EncryptArmorPrivKey(&ed25519.PrivKey{Key: stdEd25519.PrivateKey(sk)}, "", vrf_key_algo)
+	return clientCtx.Keyring.ImportPrivKey(uid, armor, "")
+}
+
+func loadVRFKey(clientCtx client.Context, uid string) (vrf.PrivateKey, error) {
+	if clientCtx.Keyring == nil {
+		return nil, fmt.Errorf("no keyring to load the vrf key from")
+	}
+	armor, err := clientCtx.Keyring.ExportPrivKeyArmor(uid, "")
+	if err != nil {
+		return nil, err
+	}
+	privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, "")
+	if err != nil {
+		return nil, err
+	}
+	if algo != vrf_key_algo || len(privKey.Bytes()) != vrf.PrivateKeySize {
+		return nil, fmt.Errorf("key %s isn't a vrf key", uid)
+	}
+	return vrf.PrivateKey(privKey.Bytes()), nil
+}
+
+func deleteVRFKey(clientCtx client.Context, uid string) error {
+	if clientCtx.Keyring == nil {
+		return fmt.Errorf("no keyring to delete the vrf key from")
+	}
+	info, err := clientCtx.Keyring.Key(uid)
+	if err != nil {
+		// nothing to delete
+		return nil
+	}
+	// never delete an account key that happens to have a vrf key name
+	if info.GetType() != cosmoskeyring.TypeLocal || info.GetAlgo() != hd.PubKeyType(vrf_key_algo) {
+		return fmt.Errorf("key %s isn't a vrf key, refusing to delete it", uid)
+	}
+	return clientCtx.Keyring.Delete(uid)
+}
+
+// migrateLegacyVRFKey moves the vrf key of the legacy plain vrf file into the keyring
+func migrateLegacyVRFKey(clientCtx client.Context) (vrf.PrivateKey, error) {
+	kr, err := openLegacyKeyring(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	skItem, err := kr.Get(sk_vrf_prefix + clientCtx.FromName)
+	if err != nil {
+		return nil, err
+	}
+	sk := vrf.PrivateKey(skItem.Data)
+	err = storeVRFKey(clientCtx, VRFKeyName(clientCtx.FromName), sk)
+	if err != nil {
+		return nil, err
+	}
+	kr.Remove(sk_vrf_prefix + clientCtx.FromName)
+	kr.Remove(pk_vrf_prefix + clientCtx.FromName)
+	LavaFormatInfo("moved the vrf key to the keyring", &map[string]string{"key": VRFKeyName(clientCtx.FromName)})
+	return sk, nil
+}
+
+func openLegacyKeyring(clientCtx client.Context) (keyring.Keyring, error) {
 	keyringConfig := keyring.Config{
 		AllowedBackends: []keyring.BackendType{keyring.FileBackend},
 		ServiceName:     "vrf",
@@ -161,19 +366,6 @@ func OpenKeyring(clientCtx client.Context) (keyring.Keyring, error) {
 	return kr, nil
 }
 
-func LoadVRFKey(clientCtx client.Context) (vrf.PrivateKey, *VrfPubKey, error) {
-	kr, err := OpenKeyring(clientCtx)
-	if err != nil {
-		return nil, nil, err
-	}
-	pkItem, err := kr.Get(pk_vrf_prefix + clientCtx.FromName)
-	if err != nil {
-		return nil, nil, err
-	}
-	skItem, err := kr.Get(sk_vrf_prefix + clientCtx.FromName)
-	return skItem.Data, &VrfPubKey{pk: pkItem.Data}, err
-}
-
 // type PubKey interface {
 // 	proto.Message
 
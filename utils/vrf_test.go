@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/99designs/keyring"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVRFKeys(t *testing.T) {
+	clientCtx := client.Context{}.WithKeyring(cosmoskeyring.NewInMemory()).WithFromName("user1").WithKeyringDir(t.TempDir())
+	_, _, err := LoadVRFKey(clientCtx)
+	require.NotNil(t, err)
+
+	sk, pk, err := GetOrCreateVRFKey(clientCtx)
+	require.Nil(t, err)
+	loadedSk, loadedPk, err := LoadVRFKey(clientCtx)
+	require.Nil(t, err)
+	require.Equal(t, sk, loadedSk)
+	require.True(t, pk.Equals(*loadedPk))
+	_, err = ParseVrfPubKey(pk.String())
+	require.Nil(t, err)
+
+	// rotating keeps the replaced key
+	newSk, _, err := GeneratePrivateVRFKey()
+	require.Nil(t, err)
+	err = SetVRFKeys(clientCtx, newSk, sk)
+	require.Nil(t, err)
+	current, previous, err := LoadVRFKeys(clientCtx)
+	require.Nil(t, err)
+	require.Equal(t, newSk, current)
+	require.Equal(t, sk, previous)
+
+	err = SetVRFKeys(clientCtx, newSk, nil)
+	require.Nil(t, err)
+	_, previous, err = LoadVRFKeys(clientCtx)
+	require.Nil(t, err)
+	require.Nil(t, previous)
+}
+
+func TestStagedVRFKey(t *testing.T) {
+	clientCtx := client.Context{}.WithKeyring(cosmoskeyring.NewInMemory()).WithFromName("user1").WithKeyringDir(t.TempDir())
+	sk, _, err := GetOrCreateVRFKey(clientCtx)
+	require.Nil(t, err)
+	require.NotNil(t, PromoteVRFKey(clientCtx))
+
+	// a staged key isn't used until it's promoted
+	newSk, _, err := GeneratePrivateVRFKey()
+	require.Nil(t, err)
+	require.Nil(t, StageVRFKey(clientCtx, newSk))
+	otherSk, _, err := GeneratePrivateVRFKey()
+	require.Nil(t, err)
+	require.NotNil(t, StageVRFKey(clientCtx, otherSk))
+	current, previous, err := LoadVRFKeys(clientCtx)
+	require.Nil(t, err)
+	require.Equal(t, sk, current)
+	require.Nil(t, previous)
+
+	require.Nil(t, PromoteVRFKey(clientCtx))
+	current, previous, err = LoadVRFKeys(clientCtx)
+	require.Nil(t, err)
+	require.Equal(t, newSk, current)
+	require.Equal(t, sk, previous)
+	_, err = LoadPendingVRFKey(clientCtx)
+	require.NotNil(t, err)
+
+	// a discarded key leaves the current keys
+	require.Nil(t, StageVRFKey(clientCtx, otherSk))
+	require.Nil(t, DiscardPendingVRFKey(clientCtx))
+	current, _, err = LoadVRFKeys(clientCtx)
+	require.Nil(t, err)
+	require.Equal(t, newSk, current)
+}
+
+func TestDeleteVRFKeyRefusesAccountKeys(t *testing.T) {
+	kr := cosmoskeyring.NewInMemory()
+	clientCtx := client.Context{}.WithKeyring(kr).WithFromName("user1").WithKeyringDir(t.TempDir())
+	_, _, err := kr.NewMnemonic(PendingVRFKeyName("user1"), cosmoskeyring.English, sdk.FullFundraiserPath, cosmoskeyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.Nil(t, err)
+
+	require.NotNil(t, DiscardPendingVRFKey(clientCtx))
+	_, err = kr.Key(PendingVRFKeyName("user1"))
+	require.Nil(t, err)
+}
+
+func TestMigrateLegacyVRFKey(t *testing.T) {
+	clientCtx := client.Context{}.WithKeyring(cosmoskeyring.NewInMemory()).WithFromName("user1").WithKeyringDir(t.TempDir())
+	kr, err := openLegacyKeyring(clientCtx)
+	require.Nil(t, err)
+	sk, _, err := GeneratePrivateVRFKey()
+	require.Nil(t, err)
+	require.Nil(t, kr.Set(keyring.Item{Key: sk_vrf_prefix + "user1", Data: sk}))
+
+	loadedSk, _, err := LoadVRFKey(clientCtx)
+	require.Nil(t, err)
+	require.Equal(t, sk, loadedSk)
+	// the legacy file is gone
+	_, err = kr.Get(sk_vrf_prefix + "user1")
+	require.NotNil(t, err)
+}
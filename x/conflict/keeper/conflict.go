@@ -100,7 +100,8 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 		if err != nil {
 			return nil, fmt.Errorf("AccAddressFromHex %s provider: %w", print_st, err)
 		}
-		_, err = k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddress, epochStart)
+		// the provider or its operator signs the replies
+		_, err = k.epochstorageKeeper.GetStakeEntryForRelaySignerEpoch(ctx, chainID, providerAddress, epochStart)
 		if err != nil {
 			return nil, fmt.Errorf("did not find a stake entry for %s provider %s on epoch %d, chainID %s error: %s", print_st, providerAddress, epochStart, chainID, err.Error())
 		}
@@ -214,6 +214,20 @@ func (k Keeper) GetStakeEntryByAddressCurrent(ctx sdk.Context, storageType strin
 	return
 }
 
+// GetStakeEntryByOperatorCurrent returns the latest stake entry operated by address
+func (k Keeper) GetStakeEntryByOperatorCurrent(ctx sdk.Context, storageType string, chainID string, operator sdk.AccAddress) (value types.StakeEntry, found bool) {
+	stakeStorage, found := k.GetStakeStorageCurrent(ctx, storageType, chainID)
+	if !found {
+		return types.StakeEntry{}, false
+	}
+	for _, entry := range stakeStorage.StakeEntries {
+		if entry.Operator != "" && entry.Operator == operator.String() {
+			return entry, true
+		}
+	}
+	return types.StakeEntry{}, false
+}
+
 func (k Keeper) RemoveStakeEntryCurrent(ctx sdk.Context, storageType string, chainID string, idx uint64) error {
 	stakeStorage, found := k.GetStakeStorageCurrent(ctx, storageType, chainID)
 	if !found {
@@ -466,6 +480,24 @@ func (k Keeper) GetStakeEntryForProviderEpoch(ctx sdk.Context, chainID string, s
 	return
 }
 
+// GetStakeEntryForRelaySignerEpoch returns the provider stake entry whose relays signer signs, as the provider or as its operator
+func (k Keeper) GetStakeEntryForRelaySignerEpoch(ctx sdk.Context, chainID string, signer sdk.AccAddress, epoch uint64) (entry *types.StakeEntry, err error) {
+	stakeStorage, found := k.getStakeStorageEpoch(ctx, epoch, types.ProviderKey, chainID)
+	if !found {
+		return nil, fmt.Errorf("could not find stakeStorage - epoch %d, chainID %s signer %s", epoch, chainID, signer.String())
+	}
+	providerStakeEntry, found, _ := k.GetStakeEntryByAddressFromStorage(ctx, stakeStorage, signer)
+	if found {
+		return &providerStakeEntry, nil
+	}
+	for idx := range stakeStorage.StakeEntries {
+		if stakeStorage.StakeEntries[idx].IsRelaySigner(signer) {
+			return &stakeStorage.StakeEntries[idx], nil
+		}
+	}
+	return nil, fmt.Errorf("could not find stakeEntry - epoch %d for provider or operator %s, chainID %s", epoch, signer.String(), chainID)
+}
+
 func (k Keeper) GetStakeEntryForAllProvidersEpoch(ctx sdk.Context, chainID string, epoch uint64) (entrys *[]types.StakeEntry, err error) {
 	stakeStorage, found := k.getStakeStorageEpoch(ctx, epoch, types.ProviderKey, chainID)
 	if !found {
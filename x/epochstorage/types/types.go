@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const TokenDenom = "ulava"
 
 const (
@@ -23,8 +27,17 @@ func (stksto StakeStorage) Copy() (returnedStorage StakeStorage) {
 			Geolocation: stakeEntry.Geolocation,
 			Chain:       stakeEntry.Chain,
 			Vrfpk:       stakeEntry.Vrfpk,
+			Operator:    stakeEntry.Operator,
 		}
 		returnedStorage.StakeEntries = append(returnedStorage.StakeEntries, newStakeEntry)
 	}
 	return
 }
+
+// IsRelaySigner returns true when address signs the relays of the entry, the entry address or its operator
+func (stakeEntry *StakeEntry) IsRelaySigner(address sdk.AccAddress) bool {
+	if stakeEntry.Address == address.String() {
+		return true
+	}
+	return stakeEntry.Operator != "" && stakeEntry.Operator == address.String()
+}
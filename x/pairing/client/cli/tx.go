@@ -32,6 +32,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdStakeClient())
 	cmd.AddCommand(CmdUnstakeProvider())
 	cmd.AddCommand(CmdUnstakeClient())
+	cmd.AddCommand(CmdSetProviderOperator())
+	cmd.AddCommand(CmdModifyProvider())
+	cmd.AddCommand(CmdRotateVrfKey())
 	cmd.AddCommand(CmdRelayPayment())
 	// this line is used by starport scaffolding # 1
 
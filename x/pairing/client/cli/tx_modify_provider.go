@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdModifyProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modify-provider [chain-id] [endpoint endpoint ...] [geolocation]",
+		Short: "Broadcast message modifyProvider",
+		Long:  "Replaces the endpoints, geolocation and moniker of a staked provider from the next epoch, the stake is unchanged. the moniker is kept unless --moniker is passed",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainID := args[0]
+			argEndpoints, err := parseEndpoints(args[1])
+			if err != nil {
+				return err
+			}
+			argGeolocation, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			moniker, _ := cmd.Flags().GetString(FlagMoniker)
+			setMoniker := cmd.Flags().Changed(FlagMoniker)
+
+			msg := types.NewMsgModifyProvider(
+				clientCtx.GetFromAddress().String(),
+				argChainID,
+				argEndpoints,
+				argGeolocation,
+				moniker,
+				setMoniker,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagMoniker, "", "The provider's name")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
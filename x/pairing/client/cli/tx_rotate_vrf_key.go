@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdRotateVrfKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-vrf-key [chain-id]",
+		Short: "Broadcast message rotateVrfKey",
+		Long: `Generates a new vrf key for the --from account and replaces the vrf pk of its stake entries on the chain from the next epoch.
+the new key is staged in the keyring and becomes the current one only after the transaction is included successfully,
+the replaced key is then kept as the previous vrf key, so relays of the current epoch can still be proven with it.
+with --generate-only the key stays staged, promote it with "lavad keys vrf promote" once the transaction is included`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainID := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, _, err := utils.LoadVRFKey(clientCtx); err != nil {
+				return err
+			}
+			newSk, _, err := utils.GeneratePrivateVRFKey()
+			if err != nil {
+				return err
+			}
+			newPk, err := utils.VrfPublicKey(newSk)
+			if err != nil {
+				return err
+			}
+			vrfpkStr, err := newPk.EncodeBech32()
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRotateVrfKey(
+				clientCtx.GetFromAddress().String(),
+				argChainID,
+				vrfpkStr,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// stage the new key before sending so it's never lost, the chain keeps using the current key until the tx is included
+			err = utils.StageVRFKey(clientCtx, newSk)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GenerateOnly {
+				err = tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+				if err != nil {
+					return discardStagedVrfKey(clientCtx, err)
+				}
+				fmt.Fprintf(os.Stderr, "the new vrf key is staged as %s, promote it with \"lavad keys vrf promote %s\" once the transaction is included\n", utils.PendingVRFKeyName(clientCtx.FromName), clientCtx.FromName)
+				return nil
+			}
+			txBytes, err := buildRotateVrfKeyTx(clientCtx, cmd.Flags(), msg)
+			if err != nil {
+				return discardStagedVrfKey(clientCtx, err)
+			}
+			if txBytes == nil {
+				// cancelled or only simulated
+				return discardStagedVrfKey(clientCtx, nil)
+			}
+			// block mode returns the deliver tx result, a failure in it must not promote the key
+			res, err := clientCtx.WithBroadcastMode(flags.BroadcastBlock).BroadcastTx(txBytes)
+			if err != nil {
+				return utils.LavaFormatError("the vrf key rotation result is unknown, the new key stays staged until it's promoted or discarded", err, &map[string]string{"staged": utils.PendingVRFKeyName(clientCtx.FromName)})
+			}
+			if err := clientCtx.PrintProto(res); err != nil {
+				return err
+			}
+			if res.Code != 0 {
+				return discardStagedVrfKey(clientCtx, fmt.Errorf("vrf key rotation failed with code %d: %s", res.Code, res.RawLog))
+			}
+			return utils.PromoteVRFKey(clientCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// buildRotateVrfKeyTx signs the rotation tx like tx.BroadcastTx does, it returns nil bytes when it's not to be sent
+func buildRotateVrfKeyTx(clientCtx client.Context, flagSet *pflag.FlagSet, msg sdk.Msg) ([]byte, error) {
+	txf, err := tx.NewFactoryCLI(clientCtx, flagSet).Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	if txf.SimulateAndExecute() || clientCtx.Simulate {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msg)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+		fmt.Fprintf(os.Stderr, "%s\n", tx.GasEstimateResponse{GasEstimate: txf.Gas()})
+	}
+	if clientCtx.Simulate {
+		return nil, nil
+	}
+	txBuilder, err := tx.BuildUnsignedTx(txf, msg)
+	if err != nil {
+		return nil, err
+	}
+	if !clientCtx.SkipConfirm {
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(os.Stderr, "%s\n\n", out)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", bufio.NewReader(os.Stdin), os.Stderr)
+		if err != nil || !ok {
+			fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
+			return nil, err
+		}
+	}
+	err = tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true)
+	if err != nil {
+		return nil, err
+	}
+	return clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// discardStagedVrfKey drops the staged key of a rotation that wasn't applied, keeping the current keys
+func discardStagedVrfKey(clientCtx client.Context, err error) error {
+	if discardErr := utils.DiscardPendingVRFKey(clientCtx); discardErr != nil {
+		errStr := ""
+		if err != nil {
+			errStr = err.Error()
+		}
+		return utils.LavaFormatError("failed discarding the staged vrf key after a failed rotation", discardErr, &map[string]string{"error": errStr})
+	}
+	return err
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetProviderOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-provider-operator [chain-id] [operator]",
+		Short: "Broadcast message setProviderOperator",
+		Long:  `sets the address signing relays for the provider, signed by the staked provider address. rewards are still paid to the provider address, and only it can stake and unstake. without an operator the provider signs its relays itself`,
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainID := args[0]
+			argOperator := ""
+			if len(args) == 2 {
+				argOperator = args[1]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProviderOperator(
+				clientCtx.GetFromAddress().String(),
+				argChainID,
+				argOperator,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
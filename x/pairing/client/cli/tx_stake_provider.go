@@ -30,19 +30,9 @@ func CmdStakeProvider() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			tmpArg := strings.Fields(args[2])
-			argEndpoints := []epochstoragetypes.Endpoint{}
-			for _, endpointStr := range tmpArg {
-				splitted := strings.Split(endpointStr, ",")
-				if len(splitted) != 3 {
-					return fmt.Errorf("invalid argument format in endpoints, must be: IP:PORT,useType,geolocation IP:PORT,useType,geolocation")
-				}
-				geoloc, err := strconv.ParseUint(splitted[2], 10, 64)
-				if err != nil {
-					return fmt.Errorf("invalid argument format in endpoints, geolocation must be a number")
-				}
-				endpoint := epochstoragetypes.Endpoint{IPPORT: splitted[0], UseType: splitted[1], Geolocation: geoloc}
-				argEndpoints = append(argEndpoints, endpoint)
+			argEndpoints, err := parseEndpoints(args[2])
+			if err != nil {
+				return err
 			}
 			argGeolocation, err := cast.ToUint64E(args[3])
 			if err != nil {
@@ -75,3 +65,21 @@ func CmdStakeProvider() *cobra.Command {
 
 	return cmd
 }
+
+// parseEndpoints parses a space separated list of IP:PORT,useType,geolocation endpoints
+func parseEndpoints(arg string) ([]epochstoragetypes.Endpoint, error) {
+	endpoints := []epochstoragetypes.Endpoint{}
+	for _, endpointStr := range strings.Fields(arg) {
+		splitted := strings.Split(endpointStr, ",")
+		if len(splitted) != 3 {
+			return nil, fmt.Errorf("invalid argument format in endpoints, must be: IP:PORT,useType,geolocation IP:PORT,useType,geolocation")
+		}
+		geoloc, err := strconv.ParseUint(splitted[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument format in endpoints, geolocation must be a number")
+		}
+		endpoint := epochstoragetypes.Endpoint{IPPORT: splitted[0], UseType: splitted[1], Geolocation: geoloc}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
@@ -32,6 +32,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgRelayPayment:
 			res, err := msgServer.RelayPayment(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetProviderOperator:
+			res, err := msgServer.SetProviderOperator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgModifyProvider:
+			res, err := msgServer.ModifyProvider(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRotateVrfKey:
+			res, err := msgServer.RotateVrfKey(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
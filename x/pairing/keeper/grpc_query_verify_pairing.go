@@ -32,6 +32,10 @@ func (k Keeper) VerifyPairing(goCtx context.Context, req *types.QueryVerifyPairi
 	if err != nil {
 		return nil, fmt.Errorf("invalid creator address %s error: %s", req.Provider, err)
 	}
+	// relays are signed by the provider or its operator
+	if signerProvider, err := k.relaySignerProvider(ctx, req.ChainID, providerAddr, req.Block); err == nil {
+		providerAddr = signerProvider
+	}
 	isValidPairing, _, index, err := k.ValidatePairingForClient(ctx, req.ChainID, clientAddr, providerAddr, req.Block)
 
 	return &types.QueryVerifyPairingResponse{Valid: isValidPairing, Index: int64(index)}, err
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// ModifyProvider updates the endpoints, geolocation and moniker of a staked provider without touching its stake,
+// it takes effect from the next epoch. the moniker is changed only when SetMoniker is set
+func (k msgServer) ModifyProvider(goCtx context.Context, msg *types.MsgModifyProvider) (*types.MsgModifyProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+
+	providerAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, utils.LavaError(ctx, logger, "modify_provider_addr", map[string]string{"provider": msg.Creator, "error": err.Error()}, "invalid provider address")
+	}
+	details := map[string]string{"spec": msg.ChainID, "provider": msg.Creator, "geolocation": strconv.FormatUint(msg.Geolocation, 10)}
+	existingEntry, found, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, msg.ChainID, providerAddr)
+	if !found {
+		return nil, utils.LavaError(ctx, logger, "modify_provider_stake", details, "provider isn't staked on the chain")
+	}
+	if !k.isValidGeolocation(ctx, msg.Geolocation) {
+		return nil, utils.LavaError(ctx, logger, "modify_provider_geolocation", details, "can't register for no geolocation or geolocation outside zones")
+	}
+	err = k.validateGeoLocationAndApiInterfaces(ctx, msg.Endpoints, msg.Geolocation, msg.ChainID)
+	if err != nil {
+		details["error"] = err.Error()
+		details["endpoints"] = fmt.Sprintf("%v", msg.Endpoints)
+		return nil, utils.LavaError(ctx, logger, "modify_provider_endpoints", details, "invalid provider endpoints implementation for the given spec")
+	}
+
+	existingEntry.Endpoints = msg.Endpoints
+	existingEntry.Geolocation = msg.Geolocation
+	if msg.SetMoniker {
+		existingEntry.Moniker = truncateMoniker(msg.Moniker)
+	}
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, msg.ChainID, existingEntry, indexInStakeStorage)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventModifyProvider{
+		Provider:    msg.Creator,
+		ChainID:     msg.ChainID,
+		Endpoints:   existingEntry.Endpoints,
+		Geolocation: existingEntry.Geolocation,
+		Moniker:     existingEntry.Moniker,
+	})
+	if err != nil {
+		details["error"] = err.Error()
+		return nil, utils.LavaError(ctx, logger, "modify_provider_event", details, "failed emitting the modify event")
+	}
+	return &types.MsgModifyProviderResponse{}, nil
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyProvider(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	provider := ts.providers[0]
+	apiInterface := ts.spec.GetApis()[0].ApiInterfaces[0].Interface
+	endpoints := []epochstoragetypes.Endpoint{{IPPORT: "456", UseType: apiInterface, Geolocation: 1}}
+	stakeBefore, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider.address)
+	require.True(t, found)
+	balance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider.address, epochstoragetypes.TokenDenom)
+
+	// only a staked provider can be modified
+	_, notStaked := sigs.GenerateFloatingKey()
+	_, err := ts.servers.PairingServer.ModifyProvider(ts.ctx, &types.MsgModifyProvider{Creator: notStaked.String(), ChainID: ts.spec.Name, Endpoints: endpoints, Geolocation: 1})
+	require.NotNil(t, err)
+	// endpoints and geolocation are validated like staking
+	_, err = ts.servers.PairingServer.ModifyProvider(ts.ctx, &types.MsgModifyProvider{Creator: provider.address.String(), ChainID: ts.spec.Name, Endpoints: endpoints, Geolocation: 0})
+	require.NotNil(t, err)
+	_, err = ts.servers.PairingServer.ModifyProvider(ts.ctx, &types.MsgModifyProvider{Creator: provider.address.String(), ChainID: ts.spec.Name, Endpoints: []epochstoragetypes.Endpoint{{IPPORT: "456", UseType: "unsupported", Geolocation: 1}}, Geolocation: 1})
+	require.NotNil(t, err)
+
+	_, err = ts.servers.PairingServer.ModifyProvider(ts.ctx, &types.MsgModifyProvider{Creator: provider.address.String(), ChainID: ts.spec.Name, Endpoints: endpoints, Geolocation: 1, Moniker: "moved", SetMoniker: true})
+	require.Nil(t, err)
+	stakeEntry, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider.address)
+	require.Equal(t, endpoints, stakeEntry.Endpoints)
+	require.Equal(t, "moved", stakeEntry.Moniker)
+	require.Equal(t, stakeBefore.Stake, stakeEntry.Stake)
+
+	// the moniker is kept when it isn't set
+	_, err = ts.servers.PairingServer.ModifyProvider(ts.ctx, &types.MsgModifyProvider{Creator: provider.address.String(), ChainID: ts.spec.Name, Endpoints: endpoints, Geolocation: 1})
+	require.Nil(t, err)
+	stakeEntry, _, _ = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider.address)
+	require.Equal(t, "moved", stakeEntry.Moniker)
+
+	// each modification emits a typed event
+	events := sdk.UnwrapSDKContext(ts.ctx).EventManager().Events()
+	modifyEvents := 0
+	for _, event := range events {
+		if event.Type == "lavanet.lava.pairing.EventModifyProvider" {
+			modifyEvents++
+		}
+	}
+	require.Equal(t, 2, modifyEvents)
+
+	// the epoch snapshot has the new endpoints
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epochEntry, err := ts.keepers.Epochstorage.GetStakeEntryForProviderEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, provider.address, uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight()))
+	require.Nil(t, err)
+	require.Equal(t, endpoints, epochEntry.Endpoints)
+	// nothing was paid for the change
+	require.Equal(t, balance, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), provider.address, epochstoragetypes.TokenDenom))
+}
@@ -50,7 +50,10 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			return errorLogAndFormat("relay_payment_addr", map[string]string{"provider": relay.Provider, "creator": msg.Creator}, "invalid provider address in relay msg")
 		}
 		if !providerAddr.Equals(creator) {
-			return errorLogAndFormat("relay_payment_addr", map[string]string{"provider": relay.Provider, "creator": msg.Creator}, "invalid provider address in relay msg, creator and signed provider mismatch")
+			// the operator of the provider can claim its relays, the provider is still the one paid
+			if !k.isProviderOperator(ctx, relay.ChainID, providerAddr, creator, uint64(relay.BlockHeight)) {
+				return errorLogAndFormat("relay_payment_addr", map[string]string{"provider": relay.Provider, "creator": msg.Creator}, "invalid provider address in relay msg, creator and signed provider mismatch")
+			}
 		}
 
 		// TODO: add support for spec changes
@@ -102,10 +105,15 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 				details["error"] = err.Error()
 				return errorLogAndFormat("relay_data_reliability_signer", details, "invalid signature by consumer on data reliability message")
 			}
-			otherProviderAddress, err := sigs.RecoverProviderPubKeyFromVrfDataOnly(relay.DataReliability)
+			otherProviderSigner, err := sigs.RecoverProviderPubKeyFromVrfDataOnly(relay.DataReliability)
 			if err != nil {
 				return errorLogAndFormat("relay_data_reliability_other_provider", details, "invalid signature by other provider on data reliability message")
 			}
+			otherProviderAddress, err := k.relaySignerProvider(ctx, relay.ChainID, otherProviderSigner, uint64(relay.BlockHeight))
+			if err != nil {
+				details["error"] = err.Error()
+				return errorLogAndFormat("relay_data_reliability_other_provider", details, "invalid signature by other provider on data reliability message, signer isn't a provider")
+			}
 			if otherProviderAddress.Equals(providerAddr) {
 				// provider signed his own stuff
 				details["error"] = "provider attempted to claim data reliability sent by himself"
@@ -163,7 +171,7 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 		}
 
 		// this prevents double spend attacks, and tracks the CU per session a client can use
-		totalCUInEpochForUserProvider, err := k.Keeper.AddEpochPayment(ctx, relay.ChainID, epochStart, clientAddr, providerAddr, relay.CuSum, strconv.FormatUint(relay.SessionId, 16))
+		totalCUInEpochForUserProvider, err := k.Keeper.AddEpochPayment(ctx, relay.ChainID, epochStart, clientAddr, providerAddr, relay.CuSum, types.UniquePaymentIdentifier(relay.SessionId))
 		if err != nil {
 			// double spending on user detected!
 			details := map[string]string{
@@ -283,6 +291,19 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 	return &types.MsgRelayPaymentResponse{}, nil
 }
 
+// isProviderOperator returns true when operator is the operator of the provider on the epoch of block
+func (k msgServer) isProviderOperator(ctx sdk.Context, chainID string, providerAddr sdk.AccAddress, operator sdk.AccAddress, block uint64) bool {
+	epochStart, _, err := k.epochStorageKeeper.GetEpochStartForBlock(ctx, block)
+	if err != nil {
+		return false
+	}
+	stakeEntry, err := k.epochStorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddr, epochStart)
+	if err != nil {
+		return false
+	}
+	return stakeEntry.Operator != "" && stakeEntry.Operator == operator.String()
+}
+
 func (k msgServer) dealWithUnresponsiveProviders(ctx sdk.Context, unresponsiveData []byte, logger log.Logger, clientAddr sdk.AccAddress, epoch uint64, chainID string) error {
 	var unresponsiveProviders []string
 	if len(unresponsiveData) == 0 {
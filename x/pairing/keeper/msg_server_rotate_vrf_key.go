@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// RotateVrfKey replaces the vrf pk of the creator consumer and provider stake entries on the chain, it takes effect from
+// the next epoch so proofs of the current epoch are still verified with the previous key
+func (k msgServer) RotateVrfKey(goCtx context.Context, msg *types.MsgRotateVrfKey) (*types.MsgRotateVrfKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, utils.LavaError(ctx, logger, "rotate_vrf_key_addr", map[string]string{"creator": msg.Creator, "error": err.Error()}, "invalid creator address")
+	}
+	details := map[string]string{"spec": msg.ChainID, "creator": msg.Creator, "vrfpk": msg.Vrfpk}
+	if _, err := utils.ParseVrfPubKey(msg.Vrfpk); err != nil {
+		details["error"] = err.Error()
+		return nil, utils.LavaError(ctx, logger, "rotate_vrf_key_vrfpk", details, "invalid vrf pk, must provide a valid verification key")
+	}
+
+	rotated := []string{}
+	for _, stakeType := range []string{epochstoragetypes.ClientKey, epochstoragetypes.ProviderKey} {
+		existingEntry, found, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, stakeType, msg.ChainID, senderAddr)
+		if !found {
+			continue
+		}
+		existingEntry.Vrfpk = msg.Vrfpk
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, stakeType, msg.ChainID, existingEntry, indexInStakeStorage)
+		rotated = append(rotated, stakeType)
+	}
+	if len(rotated) == 0 {
+		return nil, utils.LavaError(ctx, logger, "rotate_vrf_key_stake", details, "creator isn't staked on the chain")
+	}
+	details["stakeEntries"] = strings.Join(rotated, ",")
+	utils.LogLavaEvent(ctx, logger, types.VrfKeyRotateEventName, details, "Vrf key rotated")
+	return &types.MsgRotateVrfKeyResponse{}, nil
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateVrfKey(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	client := ts.clients[0]
+	oldVrfPk := &utils.VrfPubKey{}
+	oldVrfPk.Unmarshal(client.vrfPk)
+	newVrfSk, _, err := utils.GeneratePrivateVRFKey()
+	require.Nil(t, err)
+	newVrfPk, err := utils.VrfPublicKey(newVrfSk)
+	require.Nil(t, err)
+
+	// only a staked account rotates its key, to a valid one
+	_, notStaked := sigs.GenerateFloatingKey()
+	_, err = ts.servers.PairingServer.RotateVrfKey(ts.ctx, &types.MsgRotateVrfKey{Creator: notStaked.String(), ChainID: ts.spec.Name, Vrfpk: newVrfPk.String()})
+	require.NotNil(t, err)
+	_, err = ts.servers.PairingServer.RotateVrfKey(ts.ctx, &types.MsgRotateVrfKey{Creator: client.address.String(), ChainID: ts.spec.Name, Vrfpk: "vrf1invalid"})
+	require.NotNil(t, err)
+
+	_, err = ts.servers.PairingServer.RotateVrfKey(ts.ctx, &types.MsgRotateVrfKey{Creator: client.address.String(), ChainID: ts.spec.Name, Vrfpk: newVrfPk.String()})
+	require.Nil(t, err)
+	stakeEntry, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ClientKey, ts.spec.Name, client.address)
+	require.True(t, found)
+	require.Equal(t, newVrfPk.String(), stakeEntry.Vrfpk)
+
+	// the current epoch keeps the old key, the next one has the new key
+	epoch := ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx))
+	epochEntry, err := ts.keepers.Epochstorage.GetStakeEntryForClientEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, client.address, epoch)
+	require.Nil(t, err)
+	require.Equal(t, oldVrfPk.String(), epochEntry.Vrfpk)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	epochEntry, err = ts.keepers.Epochstorage.GetStakeEntryForClientEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, client.address, ts.keepers.Epochstorage.GetEpochStart(sdk.UnwrapSDKContext(ts.ctx)))
+	require.Nil(t, err)
+	require.Equal(t, newVrfPk.String(), epochEntry.Vrfpk)
+	epochEntry, err = ts.keepers.Epochstorage.GetStakeEntryForClientEpoch(sdk.UnwrapSDKContext(ts.ctx), ts.spec.Name, client.address, epoch)
+	require.Nil(t, err)
+	require.Equal(t, oldVrfPk.String(), epochEntry.Vrfpk)
+}
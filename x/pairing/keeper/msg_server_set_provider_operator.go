@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetProviderOperator sets the address signing relays for a staked provider, it takes effect from the next epoch
+func (k msgServer) SetProviderOperator(goCtx context.Context, msg *types.MsgSetProviderOperator) (*types.MsgSetProviderOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+
+	providerAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, utils.LavaError(ctx, logger, "set_provider_operator_addr", map[string]string{"provider": msg.Creator, "error": err.Error()}, "invalid provider address")
+	}
+	details := map[string]string{"spec": msg.ChainID, "provider": msg.Creator, "operator": msg.Operator}
+	existingEntry, found, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, msg.ChainID, providerAddr)
+	if !found {
+		return nil, utils.LavaError(ctx, logger, "set_provider_operator_stake", details, "provider isn't staked on the chain")
+	}
+	if msg.Operator != "" {
+		operatorAddr, err := sdk.AccAddressFromBech32(msg.Operator)
+		if err != nil {
+			details["error"] = err.Error()
+			return nil, utils.LavaError(ctx, logger, "set_provider_operator_addr", details, "invalid operator address")
+		}
+		if _, staked, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, epochstoragetypes.ProviderKey, msg.ChainID, operatorAddr); staked {
+			// its relay signatures would be ambiguous
+			return nil, utils.LavaError(ctx, logger, "set_provider_operator_staked", details, "operator is a staked provider on the chain")
+		}
+		if operatedEntry, operating := k.epochStorageKeeper.GetStakeEntryByOperatorCurrent(ctx, epochstoragetypes.ProviderKey, msg.ChainID, operatorAddr); operating && operatedEntry.Address != msg.Creator {
+			// its relays would be paid to either provider
+			details["operatedProvider"] = operatedEntry.Address
+			return nil, utils.LavaError(ctx, logger, "set_provider_operator_taken", details, "operator already operates another provider on the chain")
+		}
+	}
+	details["previousOperator"] = existingEntry.Operator
+	existingEntry.Operator = msg.Operator
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, epochstoragetypes.ProviderKey, msg.ChainID, existingEntry, indexInStakeStorage)
+	utils.LogLavaEvent(ctx, logger, types.ProviderOperatorEventName, details, "Provider operator changed")
+	return &types.MsgSetProviderOperatorResponse{}, nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/relayer/sigs"
+	testkeeper "github.com/lavanet/lava/testutil/keeper"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetProviderOperator(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	provider := ts.providers[0]
+	_, operator := sigs.GenerateFloatingKey()
+
+	// only a staked provider sets an operator
+	_, err := ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: operator.String(), ChainID: ts.spec.Name, Operator: provider.address.String()})
+	require.NotNil(t, err)
+	// a staked provider can't operate another one
+	err = ts.addProvider(1)
+	require.Nil(t, err)
+	_, err = ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: provider.address.String(), ChainID: ts.spec.Name, Operator: ts.providers[1].address.String()})
+	require.NotNil(t, err)
+
+	_, err = ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: provider.address.String(), ChainID: ts.spec.Name, Operator: operator.String()})
+	require.Nil(t, err)
+	stakeEntry, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider.address)
+	require.True(t, found)
+	require.Equal(t, operator.String(), stakeEntry.Operator)
+
+	// the operator can't unstake the provider
+	_, err = ts.servers.PairingServer.UnstakeProvider(ts.ctx, &types.MsgUnstakeProvider{Creator: operator.String(), ChainID: ts.spec.Name})
+	require.NotNil(t, err)
+
+	// removing the operator
+	_, err = ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: provider.address.String(), ChainID: ts.spec.Name})
+	require.Nil(t, err)
+	stakeEntry, _, _ = ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, provider.address)
+	require.Equal(t, "", stakeEntry.Operator)
+}
+
+func TestProviderOperatorUnique(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	err := ts.addProvider(1)
+	require.Nil(t, err)
+	provider, otherProvider := ts.providers[0], ts.providers[1]
+	_, operator := sigs.GenerateFloatingKey()
+	_, err = ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: provider.address.String(), ChainID: ts.spec.Name, Operator: operator.String()})
+	require.Nil(t, err)
+	// setting it again on the same provider is fine
+	_, err = ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: provider.address.String(), ChainID: ts.spec.Name, Operator: operator.String()})
+	require.Nil(t, err)
+
+	// an operator operates one provider of a chain
+	_, err = ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: otherProvider.address.String(), ChainID: ts.spec.Name, Operator: operator.String()})
+	require.NotNil(t, err)
+	stakeEntry, _, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, otherProvider.address)
+	require.Equal(t, "", stakeEntry.Operator)
+
+	// an operator can't stake as a provider of the chain
+	err = ts.keepers.BankKeeper.SetBalance(sdk.UnwrapSDKContext(ts.ctx), operator, sdk.NewCoins(sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(balance))))
+	require.Nil(t, err)
+	endpoints := []epochstoragetypes.Endpoint{{IPPORT: "123", UseType: ts.spec.GetApis()[0].ApiInterfaces[0].Interface, Geolocation: 1}}
+	stakeMsg := &types.MsgStakeProvider{Creator: operator.String(), ChainID: ts.spec.Name, Amount: sdk.NewCoin(epochstoragetypes.TokenDenom, sdk.NewInt(stake)), Geolocation: 1, Endpoints: endpoints}
+	_, err = ts.servers.PairingServer.StakeProvider(ts.ctx, stakeMsg)
+	require.NotNil(t, err)
+	_, found, _ := ts.keepers.Epochstorage.GetStakeEntryByAddressCurrent(sdk.UnwrapSDKContext(ts.ctx), epochstoragetypes.ProviderKey, ts.spec.Name, operator)
+	require.False(t, found)
+
+	// once the operator is removed it can operate another provider, or stake
+	_, err = ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: provider.address.String(), ChainID: ts.spec.Name})
+	require.Nil(t, err)
+	_, err = ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: otherProvider.address.String(), ChainID: ts.spec.Name, Operator: operator.String()})
+	require.Nil(t, err)
+	_, err = ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: otherProvider.address.String(), ChainID: ts.spec.Name})
+	require.Nil(t, err)
+	_, err = ts.servers.PairingServer.StakeProvider(ts.ctx, stakeMsg)
+	require.Nil(t, err)
+}
+
+func TestRelayPaymentByOperator(t *testing.T) {
+	ts := setupForPaymentTest(t)
+	provider := ts.providers[0]
+	_, operator := sigs.GenerateFloatingKey()
+	_, err := ts.servers.PairingServer.SetProviderOperator(ts.ctx, &types.MsgSetProviderOperator{Creator: provider.address.String(), ChainID: ts.spec.Name, Operator: operator.String()})
+	require.Nil(t, err)
+	ts.ctx = testkeeper.AdvanceEpoch(ts.ctx, ts.keepers)
+	block := uint64(sdk.UnwrapSDKContext(ts.ctx).BlockHeight())
+
+	// the pairing is verified for the operator as well
+	for _, address := range []sdk.AccAddress{provider.address, operator} {
+		res, err := ts.keepers.Pairing.VerifyPairing(ts.ctx, &types.QueryVerifyPairingRequest{ChainID: ts.spec.Name, Client: ts.clients[0].address.String(), Provider: address.String(), Block: block})
+		require.Nil(t, err)
+		require.True(t, res.Valid)
+	}
+
+	newRelay := func(sessionID uint64) *types.RelayRequest {
+		relayRequest := &types.RelayRequest{
+			Provider:    provider.address.String(),
+			Data:        []byte(ts.spec.Apis[0].Name),
+			SessionId:   sessionID,
+			ChainID:     ts.spec.Name,
+			CuSum:       ts.spec.Apis[0].ComputeUnits * 10,
+			BlockHeight: int64(block),
+		}
+		relayRequest.Sig, err = sigs.SignRelay(ts.clients[0].secretKey, *relayRequest)
+		require.Nil(t, err)
+		return relayRequest
+	}
+
+	// an address that isn't the operator can't claim the relays
+	_, stranger := sigs.GenerateFloatingKey()
+	payAndVerifyBalance(t, ts, types.MsgRelayPayment{Creator: stranger.String(), Relays: []*types.RelayRequest{newRelay(1)}}, false, ts.clients[0].address, provider.address)
+
+	// the operator claims them, the provider is paid
+	operatorBalance := ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), operator, epochstoragetypes.TokenDenom)
+	payAndVerifyBalance(t, ts, types.MsgRelayPayment{Creator: operator.String(), Relays: []*types.RelayRequest{newRelay(2)}}, true, ts.clients[0].address, provider.address)
+	require.Equal(t, operatorBalance, ts.keepers.BankKeeper.GetBalance(sdk.UnwrapSDKContext(ts.ctx), operator, epochstoragetypes.TokenDenom))
+}
@@ -124,6 +124,19 @@ func (k Keeper) ValidatePairingForClient(ctx sdk.Context, chainID string, client
 	return false, userStake, INVALID_INDEX, nil
 }
 
+// relaySignerProvider returns the provider whose relays signer signs on the epoch of block, the provider itself or its operator
+func (k Keeper) relaySignerProvider(ctx sdk.Context, chainID string, signer sdk.AccAddress, block uint64) (sdk.AccAddress, error) {
+	epochStart, _, err := k.epochStorageKeeper.GetEpochStartForBlock(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	stakeEntry, err := k.epochStorageKeeper.GetStakeEntryForRelaySignerEpoch(ctx, chainID, signer, epochStart)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.AccAddressFromBech32(stakeEntry.Address)
+}
+
 func (k Keeper) calculatePairingForClient(ctx sdk.Context, providers []epochstoragetypes.StakeEntry, clientAddress sdk.AccAddress, epochStartBlock uint64, chainID string, geolocation uint64) (validProviders []epochstoragetypes.StakeEntry, addrList []sdk.AccAddress, err error) {
 	if epochStartBlock > uint64(ctx.BlockHeight()) {
 		k.Logger(ctx).Error("\ninvalid session start\n")
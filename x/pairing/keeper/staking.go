@@ -10,6 +10,8 @@ import (
 	"github.com/lavanet/lava/x/pairing/types"
 )
 
+const maxMonikerLength = 50
+
 func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, chainID string, amount sdk.Coin, endpoints []epochstoragetypes.Endpoint, geolocation uint64, vrfpk string, moniker string) error {
 	logger := k.Logger(ctx)
 	var stake_type string
@@ -54,8 +56,7 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 		}
 		return nil
 	}
-	geolocations := k.specKeeper.GeolocationCount(ctx)
-	if geolocation == 0 || geolocation > (1<<geolocations) {
+	if !k.isValidGeolocation(ctx, geolocation) {
 		details := map[string]string{"geolocation": strconv.FormatUint(geolocation, 10)}
 		return utils.LavaError(ctx, logger, "stake_"+stake_type+"_geolocation", details, "can't register for no geolocation or geolocation outside zones")
 	}
@@ -65,6 +66,11 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 			details := map[string]string{stake_type: creator, "error": err.Error(), "endpoints": fmt.Sprintf("%v", endpoints), "Chain": specChainID, "geolocation": strconv.FormatUint(geolocation, 10)}
 			return utils.LavaError(ctx, logger, "stake_"+stake_type+"_endpoints", details, "invalid "+stake_type+" endpoints implementation for the given spec")
 		}
+		if operatedEntry, operating := k.epochStorageKeeper.GetStakeEntryByOperatorCurrent(ctx, stake_type, specChainID, senderAddr); operating {
+			// its relay signatures would be ambiguous
+			details := map[string]string{stake_type: creator, "operatedProvider": operatedEntry.Address, "Chain": specChainID}
+			return utils.LavaError(ctx, logger, "stake_"+stake_type+"_operator", details, "address is the operator of a staked provider on the chain")
+		}
 	} else {
 		// clients need to provide their VRF PK before running to limit brute forcing the random functions
 		err := utils.VerifyVRF(vrfpk)
@@ -77,9 +83,7 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 	// new staking takes effect from the next block
 	blockDeadline := uint64(ctx.BlockHeight()) + 1
 
-	if len(moniker) > 50 {
-		moniker = moniker[:50]
-	}
+	moniker = truncateMoniker(moniker)
 
 	existingEntry, entryExists, indexInStakeStorage := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, stake_type, chainID, senderAddr)
 	if entryExists {
@@ -143,6 +147,19 @@ func (k Keeper) StakeNewEntry(ctx sdk.Context, provider bool, creator string, ch
 	return err
 }
 
+// isValidGeolocation checks geolocation has at least one zone and no zones outside the spec geolocations
+func (k Keeper) isValidGeolocation(ctx sdk.Context, geolocation uint64) bool {
+	geolocations := k.specKeeper.GeolocationCount(ctx)
+	return geolocation != 0 && geolocation <= (1<<geolocations)
+}
+
+func truncateMoniker(moniker string) string {
+	if len(moniker) > maxMonikerLength {
+		return moniker[:maxMonikerLength]
+	}
+	return moniker
+}
+
 func (k Keeper) validateGeoLocationAndApiInterfaces(ctx sdk.Context, endpoints []epochstoragetypes.Endpoint, geolocation uint64, chainID string) (err error) {
 	expectedInterfaces := k.specKeeper.GetExpectedInterfacesForSpec(ctx, chainID)
 	geolocMap := map[string]bool{} // TODO: turn this into spectypes.ApiInterface
@@ -93,15 +93,9 @@ func (k Keeper) EncodeUniquePaymentKey(ctx sdk.Context, userAddress sdk.AccAddre
 	} else if clientLength > maxAdrLengthUser {
 		panic(fmt.Sprintf("invalid userAddress found! len(%s) != %d == %d", userAddress.String(), maxAdrLengthUser, len(userAddress.String())))
 	}
-	leadingChar := asciiNumberToChar(clientLength)
-	key := string(leadingChar) + userAddress.String() + providerAddress.String() + uniqueIdentifier + chainID
-	return key
+	return types.UniquePaymentIndex(userAddress.String(), providerAddress.String(), uniqueIdentifier, chainID)
 }
 
 func charToAsciiNumber(char rune) int {
 	return int(char)
 }
-
-func asciiNumberToChar(asciiNum int) rune {
-	return rune(asciiNum)
-}
@@ -44,6 +44,18 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgRelayPayment int = 100
 
+	opWeightMsgSetProviderOperator = "op_weight_msg_set_provider_operator"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetProviderOperator int = 100
+
+	opWeightMsgModifyProvider = "op_weight_msg_modify_provider"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgModifyProvider int = 100
+
+	opWeightMsgRotateVrfKey = "op_weight_msg_rotate_vrf_key"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRotateVrfKey int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -158,6 +170,39 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pairingsimulation.SimulateMsgRelayPayment(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgSetProviderOperator int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetProviderOperator, &weightMsgSetProviderOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetProviderOperator = defaultWeightMsgSetProviderOperator
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetProviderOperator,
+		pairingsimulation.SimulateMsgSetProviderOperator(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgModifyProvider int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgModifyProvider, &weightMsgModifyProvider, nil,
+		func(_ *rand.Rand) {
+			weightMsgModifyProvider = defaultWeightMsgModifyProvider
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgModifyProvider,
+		pairingsimulation.SimulateMsgModifyProvider(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRotateVrfKey int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRotateVrfKey, &weightMsgRotateVrfKey, nil,
+		func(_ *rand.Rand) {
+			weightMsgRotateVrfKey = defaultWeightMsgRotateVrfKey
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRotateVrfKey,
+		pairingsimulation.SimulateMsgRotateVrfKey(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -13,6 +13,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnstakeProvider{}, "pairing/UnstakeProvider", nil)
 	cdc.RegisterConcrete(&MsgUnstakeClient{}, "pairing/UnstakeClient", nil)
 	cdc.RegisterConcrete(&MsgRelayPayment{}, "pairing/RelayPayment", nil)
+	cdc.RegisterConcrete(&MsgSetProviderOperator{}, "pairing/SetProviderOperator", nil)
+	cdc.RegisterConcrete(&MsgModifyProvider{}, "pairing/ModifyProvider", nil)
+	cdc.RegisterConcrete(&MsgRotateVrfKey{}, "pairing/RotateVrfKey", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -32,6 +35,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRelayPayment{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetProviderOperator{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgModifyProvider{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRotateVrfKey{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
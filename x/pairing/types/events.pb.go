@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/lavanet/lava/x/epochstorage/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventModifyProvider is emitted when a staked provider changes its details, they take effect from the next epoch
+type EventModifyProvider struct {
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChainID     string           `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Endpoints   []types.Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints"`
+	Geolocation uint64           `protobuf:"varint,4,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	Moniker     string           `protobuf:"bytes,5,opt,name=moniker,proto3" json:"moniker,omitempty"`
+}
+
+func (m *EventModifyProvider) Reset()         { *m = EventModifyProvider{} }
+func (m *EventModifyProvider) String() string { return proto.CompactTextString(m) }
+func (*EventModifyProvider) ProtoMessage()    {}
+func (*EventModifyProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44055f8e5acc30a7, []int{0}
+}
+func (m *EventModifyProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventModifyProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventModifyProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventModifyProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventModifyProvider.Merge(m, src)
+}
+func (m *EventModifyProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventModifyProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventModifyProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventModifyProvider proto.InternalMessageInfo
+
+func (m *EventModifyProvider) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EventModifyProvider) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *EventModifyProvider) GetEndpoints() []types.Endpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *EventModifyProvider) GetGeolocation() uint64 {
+	if m != nil {
+		return m.Geolocation
+	}
+	return 0
+}
+
+func (m *EventModifyProvider) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventModifyProvider)(nil), "lavanet.lava.pairing.EventModifyProvider")
+}
+
+func init() { proto.RegisterFile("pairing/events.proto", fileDescriptor_44055f8e5acc30a7) }
+
+var fileDescriptor_44055f8e5acc30a7 = []byte{
+	// 278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
+	0x10, 0xc7, 0x63, 0x5a, 0x3e, 0xea, 0x6e, 0xa1, 0x83, 0x55, 0x24, 0x13, 0xc1, 0x40, 0x27, 0x5b,
+	0x82, 0x27, 0xa0, 0xa2, 0x42, 0x0c, 0x48, 0xa8, 0x23, 0x9b, 0x9b, 0x18, 0xc7, 0xa2, 0xf5, 0x59,
+	0x8e, 0x89, 0xe8, 0x5b, 0xf0, 0x58, 0x1d, 0x33, 0x32, 0x21, 0x94, 0xbc, 0x08, 0xca, 0x17, 0x94,
+	0xe9, 0xee, 0xef, 0xfb, 0xf9, 0xf4, 0xd3, 0xe1, 0x89, 0x15, 0xda, 0x69, 0xa3, 0xb8, 0xcc, 0xa5,
+	0xf1, 0x19, 0xb3, 0x0e, 0x3c, 0x84, 0x93, 0xb5, 0xc8, 0x85, 0x91, 0x9e, 0xd5, 0x95, 0x75, 0xc8,
+	0x74, 0xa2, 0x40, 0x41, 0x03, 0xf0, 0xba, 0x6b, 0xd9, 0xe9, 0x99, 0xb4, 0x10, 0xa7, 0x99, 0x07,
+	0x27, 0x94, 0xe4, 0xd2, 0x24, 0x16, 0xb4, 0xf1, 0xed, 0xf0, 0xa2, 0x40, 0xf8, 0x74, 0x51, 0x6f,
+	0x7e, 0x84, 0x44, 0xbf, 0x6c, 0x9f, 0x1c, 0xe4, 0x3a, 0x91, 0x2e, 0x9c, 0xe2, 0x13, 0xdb, 0xf5,
+	0x04, 0x45, 0x68, 0x36, 0x5a, 0xfe, 0xe6, 0x90, 0xe0, 0xe3, 0x38, 0x15, 0xda, 0x3c, 0xdc, 0x91,
+	0x83, 0x66, 0xd4, 0xc7, 0xf0, 0x1e, 0x8f, 0xfa, 0xfd, 0x19, 0x19, 0x44, 0x83, 0xd9, 0xf8, 0xfa,
+	0x92, 0xfd, 0x53, 0xdd, 0x77, 0x61, 0x8b, 0x8e, 0x9d, 0x0f, 0x77, 0x5f, 0xe7, 0xc1, 0xf2, 0xef,
+	0x6f, 0x18, 0xe1, 0xb1, 0x92, 0xb0, 0x86, 0x58, 0x78, 0x0d, 0x86, 0x0c, 0x23, 0x34, 0x1b, 0x2e,
+	0xf7, 0x9f, 0x6a, 0x89, 0x0d, 0x18, 0xfd, 0x2a, 0x1d, 0x39, 0x6c, 0x25, 0xba, 0x38, 0xbf, 0xdd,
+	0x95, 0x14, 0x15, 0x25, 0x45, 0xdf, 0x25, 0x45, 0x1f, 0x15, 0x0d, 0x8a, 0x8a, 0x06, 0x9f, 0x15,
+	0x0d, 0x9e, 0xaf, 0x94, 0xf6, 0xe9, 0xdb, 0x8a, 0xc5, 0xb0, 0xe1, 0x9d, 0x55, 0x53, 0xf9, 0x3b,
+	0xef, 0xaf, 0xec, 0xb7, 0x56, 0x66, 0xab, 0xa3, 0xe6, 0x38, 0x37, 0x3f, 0x03, 0x00, 0x52, 0x1f,
+	0x02, 0xe4, 0x7d, 0x01, 0x00, 0x00,
+}
+
+func (m *EventModifyProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventModifyProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventModifyProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventModifyProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovEvents(uint64(m.Geolocation))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventModifyProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventModifyProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventModifyProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, types.Endpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,9 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"strconv"
+)
 
 var _ binary.ByteOrder
 
@@ -21,3 +24,13 @@ func UniquePaymentStorageClientProviderKey(
 
 	return key
 }
+
+// UniquePaymentIdentifier returns the unique identifier a relay session is paid with
+func UniquePaymentIdentifier(sessionID uint64) string {
+	return strconv.FormatUint(sessionID, 16)
+}
+
+// UniquePaymentIndex returns the index of the UniquePaymentStorageClientProvider of a consumer session payment
+func UniquePaymentIndex(userAddress string, providerAddress string, uniqueIdentifier string, chainID string) string {
+	return string(rune(len(userAddress))) + userAddress + providerAddress + uniqueIdentifier + chainID
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgModifyProvider_ValidateBasic(t *testing.T) {
+	endpoints := []epochstoragetypes.Endpoint{{IPPORT: "123", UseType: "jsonrpc", Geolocation: 1}}
+	tests := []struct {
+		name string
+		msg  MsgModifyProvider
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgModifyProvider{
+				Creator:   "invalid_address",
+				Endpoints: endpoints,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no endpoints",
+			msg: MsgModifyProvider{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgModifyProvider{
+				Creator:   sample.AccAddress(),
+				Endpoints: endpoints,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRotateVrfKey = "rotate_vrf_key"
+
+var _ sdk.Msg = &MsgRotateVrfKey{}
+
+func NewMsgRotateVrfKey(creator string, chainID string, vrfpk string) *MsgRotateVrfKey {
+	return &MsgRotateVrfKey{
+		Creator: creator,
+		ChainID: chainID,
+		Vrfpk:   vrfpk,
+	}
+}
+
+func (msg *MsgRotateVrfKey) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRotateVrfKey) Type() string {
+	return TypeMsgRotateVrfKey
+}
+
+func (msg *MsgRotateVrfKey) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRotateVrfKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRotateVrfKey) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Vrfpk == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "empty vrf pk")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRotateVrfKey_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRotateVrfKey
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRotateVrfKey{
+				Creator: "invalid_address",
+				Vrfpk:   "vrf1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty vrf pk",
+			msg: MsgRotateVrfKey{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidPubKey,
+		}, {
+			name: "valid address",
+			msg: MsgRotateVrfKey{
+				Creator: sample.AccAddress(),
+				Vrfpk:   "vrf1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetProviderOperator = "set_provider_operator"
+
+var _ sdk.Msg = &MsgSetProviderOperator{}
+
+func NewMsgSetProviderOperator(creator string, chainID string, operator string) *MsgSetProviderOperator {
+	return &MsgSetProviderOperator{
+		Creator:  creator,
+		ChainID:  chainID,
+		Operator: operator,
+	}
+}
+
+func (msg *MsgSetProviderOperator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetProviderOperator) Type() string {
+	return TypeMsgSetProviderOperator
+}
+
+func (msg *MsgSetProviderOperator) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetProviderOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetProviderOperator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Operator == "" {
+		return nil
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.Operator == msg.Creator {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "operator address is the provider address (%s)", msg.Operator)
+	}
+	return nil
+}
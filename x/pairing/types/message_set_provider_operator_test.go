@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetProviderOperator_ValidateBasic(t *testing.T) {
+	provider := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSetProviderOperator
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetProviderOperator{
+				Creator:  "invalid_address",
+				Operator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid operator address",
+			msg: MsgSetProviderOperator{
+				Creator:  provider,
+				Operator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "operator is the provider",
+			msg: MsgSetProviderOperator{
+				Creator:  provider,
+				Operator: provider,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgSetProviderOperator{
+				Creator:  provider,
+				Operator: sample.AccAddress(),
+			},
+		}, {
+			name: "remove operator",
+			msg: MsgSetProviderOperator{
+				Creator: provider,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
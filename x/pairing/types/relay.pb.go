@@ -13,6 +13,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -44,6 +45,7 @@ type RelayRequest struct {
 	DataReliability       *VRFData                `protobuf:"bytes,12,opt,name=DataReliability,proto3" json:"DataReliability,omitempty"`
 	QoSReport             *QualityOfServiceReport `protobuf:"bytes,13,opt,name=QoSReport,proto3" json:"QoSReport,omitempty"`
 	UnresponsiveProviders []byte                  `protobuf:"bytes,14,opt,name=unresponsive_providers,json=unresponsiveProviders,proto3" json:"unresponsive_providers,omitempty"`
+	Metadata              []Metadata              `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *RelayRequest) Reset()         { *m = RelayRequest{} }
@@ -177,20 +179,80 @@ func (m *RelayRequest) GetUnresponsiveProviders() []byte {
 	return nil
 }
 
+func (m *RelayRequest) GetMetadata() []Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type Metadata struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Metadata) Reset()         { *m = Metadata{} }
+func (m *Metadata) String() string { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()    {}
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10cd1bfeb9978acf, []int{1}
+}
+func (m *Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metadata.Merge(m, src)
+}
+func (m *Metadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metadata proto.InternalMessageInfo
+
+func (m *Metadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Metadata) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type RelayReply struct {
-	Data                  []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Sig                   []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
-	Nonce                 uint32 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	LatestBlock           int64  `protobuf:"varint,4,opt,name=latest_block,json=latestBlock,proto3" json:"latest_block,omitempty"`
-	FinalizedBlocksHashes []byte `protobuf:"bytes,5,opt,name=finalized_blocks_hashes,json=finalizedBlocksHashes,proto3" json:"finalized_blocks_hashes,omitempty"`
-	SigBlocks             []byte `protobuf:"bytes,6,opt,name=sig_blocks,json=sigBlocks,proto3" json:"sig_blocks,omitempty"`
+	Data                  []byte     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Sig                   []byte     `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+	Nonce                 uint32     `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	LatestBlock           int64      `protobuf:"varint,4,opt,name=latest_block,json=latestBlock,proto3" json:"latest_block,omitempty"`
+	FinalizedBlocksHashes []byte     `protobuf:"bytes,5,opt,name=finalized_blocks_hashes,json=finalizedBlocksHashes,proto3" json:"finalized_blocks_hashes,omitempty"`
+	SigBlocks             []byte     `protobuf:"bytes,6,opt,name=sig_blocks,json=sigBlocks,proto3" json:"sig_blocks,omitempty"`
+	Metadata              []Metadata `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *RelayReply) Reset()         { *m = RelayReply{} }
 func (m *RelayReply) String() string { return proto.CompactTextString(m) }
 func (*RelayReply) ProtoMessage()    {}
 func (*RelayReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{1}
+	return fileDescriptor_10cd1bfeb9978acf, []int{2}
 }
 func (m *RelayReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,6 +323,13 @@ func (m *RelayReply) GetSigBlocks() []byte {
 	return nil
 }
 
+func (m *RelayReply) GetMetadata() []Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type VRFData struct {
 	Differentiator bool   `protobuf:"varint,1,opt,name=differentiator,proto3" json:"differentiator,omitempty"`
 	VrfValue       []byte `protobuf:"bytes,2,opt,name=vrf_value,json=vrfValue,proto3" json:"vrf_value,omitempty"`
@@ -275,7 +344,7 @@ func (m *VRFData) Reset()         { *m = VRFData{} }
 func (m *VRFData) String() string { return proto.CompactTextString(m) }
 func (*VRFData) ProtoMessage()    {}
 func (*VRFData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{2}
+	return fileDescriptor_10cd1bfeb9978acf, []int{3}
 }
 func (m *VRFData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,7 +432,7 @@ func (m *QualityOfServiceReport) Reset()         { *m = QualityOfServiceReport{}
 func (m *QualityOfServiceReport) String() string { return proto.CompactTextString(m) }
 func (*QualityOfServiceReport) ProtoMessage()    {}
 func (*QualityOfServiceReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10cd1bfeb9978acf, []int{3}
+	return fileDescriptor_10cd1bfeb9978acf, []int{4}
 }
 func (m *QualityOfServiceReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,6 +463,7 @@ var xxx_messageInfo_QualityOfServiceReport proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*RelayRequest)(nil), "lavanet.lava.pairing.RelayRequest")
+	proto.RegisterType((*Metadata)(nil), "lavanet.lava.pairing.Metadata")
 	proto.RegisterType((*RelayReply)(nil), "lavanet.lava.pairing.RelayReply")
 	proto.RegisterType((*VRFData)(nil), "lavanet.lava.pairing.VRFData")
 	proto.RegisterType((*QualityOfServiceReport)(nil), "lavanet.lava.pairing.QualityOfServiceReport")
@@ -402,58 +472,64 @@ func init() {
 func init() { proto.RegisterFile("pairing/relay.proto", fileDescriptor_10cd1bfeb9978acf) }
 
 var fileDescriptor_10cd1bfeb9978acf = []byte{
-	// 802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x8e, 0x1b, 0x45,
-	0x10, 0xf6, 0xec, 0x7a, 0xfd, 0x53, 0xf6, 0x3a, 0xa8, 0xb3, 0x9b, 0x8c, 0x16, 0xe2, 0x35, 0x83,
-	0x94, 0xf8, 0x00, 0x36, 0x0a, 0x82, 0x03, 0x12, 0x12, 0x58, 0x0b, 0x24, 0x08, 0x91, 0x6c, 0x1b,
-	0x72, 0xd8, 0xcb, 0xa8, 0x3d, 0x6e, 0x8f, 0x5b, 0x69, 0x4f, 0x4f, 0xba, 0x67, 0x46, 0x0c, 0x4f,
-	0x81, 0xc4, 0x9b, 0x70, 0xe0, 0x01, 0x38, 0xe5, 0x98, 0x23, 0xe2, 0xb0, 0x8a, 0x76, 0xdf, 0x80,
-	0x27, 0x40, 0x5d, 0x33, 0x63, 0x9b, 0xc4, 0x42, 0x8a, 0x94, 0xd3, 0x74, 0x7d, 0x55, 0xf5, 0x95,
-	0xeb, 0xab, 0xea, 0x36, 0xdc, 0x8c, 0x99, 0xd0, 0x22, 0x0a, 0xc7, 0x9a, 0x4b, 0x96, 0x8f, 0x62,
-	0xad, 0x12, 0x45, 0x8e, 0x24, 0xcb, 0x58, 0xc4, 0x93, 0x91, 0xfd, 0x8e, 0xca, 0x88, 0x93, 0xa3,
-	0x50, 0x85, 0x0a, 0x03, 0xc6, 0xf6, 0x54, 0xc4, 0x7a, 0xbf, 0xd5, 0xa1, 0x4b, 0x6d, 0x2e, 0xe5,
-	0xcf, 0x52, 0x6e, 0x12, 0xe2, 0x42, 0x33, 0x58, 0x32, 0x11, 0x3d, 0x3c, 0x73, 0x9d, 0x81, 0x33,
-	0x6c, 0xd3, 0xca, 0x24, 0xf7, 0xe0, 0x46, 0xa0, 0xa2, 0x88, 0x07, 0x89, 0x50, 0x91, 0x9f, 0xe4,
-	0x31, 0x77, 0xf7, 0x30, 0xa2, 0xb7, 0x81, 0x7f, 0xcc, 0x63, 0x4e, 0x6e, 0x43, 0x93, 0xc5, 0xc2,
-	0x4f, 0xb5, 0x74, 0xf7, 0x31, 0xa0, 0xc1, 0x62, 0xf1, 0x93, 0x96, 0xe4, 0x0e, 0x80, 0xe1, 0xc6,
-	0xd8, 0x74, 0x31, 0x77, 0xeb, 0x03, 0x67, 0x58, 0xa7, 0xed, 0x12, 0x79, 0x38, 0x27, 0xc7, 0xd0,
-	0x08, 0x52, 0xdf, 0xa4, 0x2b, 0xf7, 0x00, 0x5d, 0x07, 0x41, 0x3a, 0x4d, 0x57, 0x84, 0x40, 0x7d,
-	0xce, 0x12, 0xe6, 0x36, 0x06, 0xce, 0xb0, 0x4b, 0xf1, 0x4c, 0xde, 0x81, 0x7d, 0x23, 0x42, 0xb7,
-	0x89, 0x90, 0x3d, 0x92, 0x13, 0x68, 0xc5, 0x5a, 0x65, 0x62, 0xce, 0xb5, 0xdb, 0xc2, 0xaa, 0x6b,
-	0x9b, 0xbc, 0x0f, 0xdd, 0x99, 0x54, 0xc1, 0x53, 0x7f, 0xc9, 0x45, 0xb8, 0x4c, 0xdc, 0xf6, 0xc0,
-	0x19, 0xee, 0xd3, 0x0e, 0x62, 0x0f, 0x10, 0x22, 0xef, 0x42, 0x1b, 0x25, 0xf4, 0xa3, 0x74, 0xe5,
-	0x02, 0x96, 0x6f, 0x21, 0xf0, 0x43, 0xba, 0x22, 0x1f, 0xc0, 0xa1, 0x2e, 0xe4, 0xf1, 0x31, 0xc7,
-	0xed, 0x20, 0x41, 0xb7, 0x04, 0x27, 0x16, 0x23, 0xdf, 0xc2, 0x8d, 0x33, 0x96, 0x30, 0xca, 0xa5,
-	0x60, 0x33, 0x21, 0x45, 0x92, 0xbb, 0xdd, 0x81, 0x33, 0xec, 0xdc, 0xbf, 0x33, 0xda, 0x35, 0x8f,
-	0xd1, 0x13, 0xfa, 0x0d, 0xc6, 0xbf, 0x9a, 0x45, 0xbe, 0x83, 0xf6, 0xb9, 0x9a, 0x52, 0x1e, 0x2b,
-	0x9d, 0xb8, 0x87, 0x48, 0xf1, 0xe1, 0x6e, 0x8a, 0xf3, 0x94, 0xd9, 0x8c, 0x47, 0x8b, 0x29, 0xd7,
-	0x99, 0x08, 0x78, 0x91, 0x43, 0x37, 0xe9, 0xe4, 0x53, 0xb8, 0x95, 0x46, 0x9a, 0x9b, 0x58, 0x45,
-	0x46, 0x64, 0xdc, 0xaf, 0x24, 0x31, 0x6e, 0x0f, 0xa5, 0x3b, 0xde, 0xf6, 0x3e, 0xae, 0x9c, 0xde,
-	0x9f, 0x0e, 0x40, 0xb9, 0x15, 0xb1, 0xcc, 0xd7, 0x13, 0x70, 0x5e, 0x9f, 0xc0, 0xde, 0x66, 0x02,
-	0x47, 0x70, 0x10, 0xa9, 0x28, 0xe0, 0x38, 0xf4, 0x43, 0x5a, 0x18, 0x56, 0x7b, 0xc9, 0x92, 0x8d,
-	0x74, 0xf5, 0x42, 0xfb, 0x02, 0x2b, 0x94, 0xfb, 0x0c, 0x6e, 0x2f, 0x44, 0xc4, 0xa4, 0xf8, 0x85,
-	0xcf, 0x8b, 0x28, 0xe3, 0x2f, 0x99, 0x59, 0x72, 0x83, 0x8b, 0xd0, 0xa5, 0xc7, 0x6b, 0x37, 0x26,
-	0x98, 0x07, 0xe8, 0xc4, 0x75, 0x12, 0x61, 0x99, 0x51, 0xae, 0x47, 0xdb, 0x88, 0xb0, 0x08, 0xf2,
-	0x5e, 0x3a, 0xd0, 0x2c, 0x45, 0x26, 0x77, 0xa1, 0x37, 0x17, 0x8b, 0x05, 0xd7, 0x3c, 0x4a, 0x04,
-	0x4b, 0x94, 0xc6, 0x5e, 0x5a, 0xf4, 0x15, 0xd4, 0xae, 0x41, 0xa6, 0x17, 0x7e, 0xc6, 0x64, 0xca,
-	0xcb, 0xde, 0x5a, 0x99, 0x5e, 0x3c, 0xb1, 0x76, 0xe5, 0x8c, 0xb5, 0x52, 0x0b, 0x6c, 0xb2, 0x70,
-	0x3e, 0xb6, 0xb6, 0xed, 0xb3, 0x12, 0xd7, 0xb7, 0xc2, 0xd4, 0xd1, 0xdf, 0xa9, 0xb0, 0xa9, 0x08,
-	0xc9, 0x00, 0x3a, 0x4c, 0x4a, 0xfb, 0x7b, 0x6c, 0x03, 0x65, 0x6f, 0xdb, 0x10, 0x79, 0x0f, 0xda,
-	0xcf, 0x52, 0xae, 0x73, 0xf4, 0x97, 0x0d, 0xad, 0x81, 0xd7, 0x97, 0xde, 0xfb, 0x7d, 0x0f, 0x6e,
-	0xed, 0x5e, 0x02, 0x72, 0x01, 0x4d, 0xab, 0x71, 0x14, 0xe4, 0xc5, 0x3d, 0x9e, 0x7c, 0xf9, 0xfc,
-	0xf2, 0xb4, 0xf6, 0xf7, 0xe5, 0xe9, 0xdd, 0x50, 0x24, 0xcb, 0x74, 0x36, 0x0a, 0xd4, 0x6a, 0x1c,
-	0x28, 0xb3, 0x52, 0xa6, 0xfc, 0x7c, 0x64, 0xe6, 0x4f, 0xc7, 0xf6, 0x5a, 0x9b, 0xd1, 0x19, 0x0f,
-	0xfe, 0xb9, 0x3c, 0xed, 0xe5, 0x6c, 0x25, 0x3f, 0xf7, 0xbe, 0x2f, 0x68, 0x3c, 0x5a, 0x11, 0x12,
-	0x01, 0x5d, 0x96, 0x31, 0x21, 0xab, 0x3d, 0xc7, 0x67, 0x60, 0xf2, 0xf5, 0x1b, 0x17, 0xb8, 0x59,
-	0x14, 0xd8, 0xe6, 0xf2, 0xe8, 0x7f, 0xa8, 0xc9, 0x39, 0xd4, 0x4d, 0x1e, 0x05, 0xc5, 0x43, 0x32,
-	0xf9, 0xe2, 0x8d, 0x4b, 0x74, 0x8a, 0x12, 0x96, 0xc3, 0xa3, 0x48, 0x75, 0xff, 0x0f, 0x07, 0x9a,
-	0xb8, 0xdc, 0x5c, 0x93, 0x47, 0x70, 0x80, 0x47, 0xe2, 0xed, 0xbe, 0x61, 0xdb, 0x4f, 0xe3, 0xc9,
-	0xe0, 0x7f, 0x63, 0x62, 0x99, 0x7b, 0x35, 0x72, 0x01, 0x3d, 0xb4, 0xa7, 0xe9, 0xcc, 0x04, 0x5a,
-	0xcc, 0xf8, 0xdb, 0x62, 0xfe, 0xd8, 0x99, 0x7c, 0xf5, 0xfc, 0xaa, 0xef, 0xbc, 0xb8, 0xea, 0x3b,
-	0x2f, 0xaf, 0xfa, 0xce, 0xaf, 0xd7, 0xfd, 0xda, 0x8b, 0xeb, 0x7e, 0xed, 0xaf, 0xeb, 0x7e, 0xed,
-	0xe2, 0xde, 0x96, 0x1e, 0x25, 0x13, 0x7e, 0xc7, 0x3f, 0x8f, 0xab, 0x3f, 0x08, 0x14, 0x65, 0xd6,
-	0xc0, 0x57, 0xff, 0x93, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x30, 0x0a, 0xad, 0xe0, 0x38, 0x06,
-	0x00, 0x00,
+	// 899 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x8f, 0xdb, 0x54,
+	0x17, 0x8e, 0xf3, 0x31, 0x49, 0x4e, 0x32, 0x99, 0x57, 0x77, 0x3e, 0x6a, 0x4d, 0xdf, 0x66, 0x82,
+	0x91, 0xda, 0x2c, 0xc0, 0x41, 0xc3, 0xc7, 0x02, 0x09, 0xa9, 0x44, 0x53, 0x68, 0x11, 0xd0, 0x19,
+	0x07, 0xba, 0x98, 0x8d, 0x75, 0xe3, 0xdc, 0x38, 0x57, 0xb5, 0x7d, 0xdd, 0x7b, 0xed, 0x08, 0xb3,
+	0x66, 0xc7, 0x86, 0xdf, 0xc2, 0xaf, 0xe8, 0xb2, 0x1b, 0x24, 0xc4, 0x62, 0x54, 0xcd, 0xfc, 0x03,
+	0x7e, 0x01, 0xba, 0xc7, 0x76, 0x92, 0xb6, 0x11, 0xa2, 0x88, 0x95, 0xef, 0x79, 0xce, 0x39, 0xcf,
+	0xb1, 0x9e, 0xf3, 0xd8, 0x17, 0xf6, 0x63, 0xca, 0x25, 0x8f, 0xfc, 0x91, 0x64, 0x01, 0xcd, 0xec,
+	0x58, 0x8a, 0x44, 0x90, 0x83, 0x80, 0x2e, 0x69, 0xc4, 0x12, 0x5b, 0x3f, 0xed, 0xa2, 0xe2, 0xf8,
+	0xc0, 0x17, 0xbe, 0xc0, 0x82, 0x91, 0x3e, 0xe5, 0xb5, 0xc7, 0xb7, 0x7d, 0x21, 0xfc, 0x80, 0x8d,
+	0x30, 0x9a, 0xa6, 0xf3, 0x11, 0x0b, 0xe3, 0xa4, 0x20, 0xb2, 0x7e, 0xab, 0x43, 0xd7, 0xd1, 0xc4,
+	0x0e, 0x7b, 0x96, 0x32, 0x95, 0x10, 0x13, 0x9a, 0xde, 0x82, 0xf2, 0xe8, 0xd1, 0x99, 0x69, 0x0c,
+	0x8c, 0x61, 0xdb, 0x29, 0x43, 0x72, 0x0f, 0xf6, 0x3c, 0x11, 0x45, 0xcc, 0x4b, 0xb8, 0x88, 0xdc,
+	0x24, 0x8b, 0x99, 0x59, 0xc5, 0x8a, 0xde, 0x1a, 0xfe, 0x2e, 0x8b, 0x19, 0xb9, 0x05, 0x4d, 0x1a,
+	0x73, 0x37, 0x95, 0x81, 0x59, 0xc3, 0x82, 0x1d, 0x1a, 0xf3, 0xef, 0x65, 0x40, 0xee, 0x00, 0x28,
+	0xa6, 0x94, 0x6e, 0xe7, 0x33, 0xb3, 0x3e, 0x30, 0x86, 0x75, 0xa7, 0x5d, 0x20, 0x8f, 0x66, 0xe4,
+	0x10, 0x76, 0xbc, 0xd4, 0x55, 0x69, 0x68, 0x36, 0x30, 0xd5, 0xf0, 0xd2, 0x49, 0x1a, 0x12, 0x02,
+	0xf5, 0x19, 0x4d, 0xa8, 0xb9, 0x33, 0x30, 0x86, 0x5d, 0x07, 0xcf, 0xe4, 0x7f, 0x50, 0x53, 0xdc,
+	0x37, 0x9b, 0x08, 0xe9, 0x23, 0x39, 0x86, 0x56, 0x2c, 0xc5, 0x92, 0xcf, 0x98, 0x34, 0x5b, 0x38,
+	0x75, 0x15, 0x93, 0x77, 0xa0, 0x3b, 0x0d, 0x84, 0xf7, 0xd4, 0x5d, 0x30, 0xee, 0x2f, 0x12, 0xb3,
+	0x3d, 0x30, 0x86, 0x35, 0xa7, 0x83, 0xd8, 0x43, 0x84, 0xc8, 0x6d, 0x68, 0xa3, 0xbe, 0x6e, 0x94,
+	0x86, 0x26, 0xe0, 0xf8, 0x16, 0x02, 0xdf, 0xa6, 0x21, 0x79, 0x17, 0x76, 0x65, 0x2e, 0x8f, 0x8b,
+	0x3d, 0x66, 0x07, 0x09, 0xba, 0x05, 0x38, 0xd6, 0x18, 0xf9, 0x12, 0xf6, 0xce, 0x68, 0x42, 0x1d,
+	0x16, 0x70, 0x3a, 0xe5, 0x01, 0x4f, 0x32, 0xb3, 0x3b, 0x30, 0x86, 0x9d, 0xd3, 0x3b, 0xf6, 0xb6,
+	0x65, 0xd9, 0x4f, 0x9c, 0x2f, 0xb0, 0xfe, 0xf5, 0x2e, 0xf2, 0x15, 0xb4, 0x2f, 0xc4, 0xc4, 0x61,
+	0xb1, 0x90, 0x89, 0xb9, 0x8b, 0x14, 0xef, 0x6d, 0xa7, 0xb8, 0x48, 0xa9, 0xee, 0x78, 0x3c, 0x9f,
+	0x30, 0xb9, 0xe4, 0x1e, 0xcb, 0x7b, 0x9c, 0x75, 0x3b, 0xf9, 0x18, 0x8e, 0xd2, 0x48, 0x32, 0x15,
+	0x8b, 0x48, 0xf1, 0x25, 0x73, 0x4b, 0x49, 0x94, 0xd9, 0x43, 0xe9, 0x0e, 0x37, 0xb3, 0xe7, 0x65,
+	0x92, 0xdc, 0x87, 0x56, 0xc8, 0x12, 0x8a, 0xb2, 0xef, 0x0d, 0x6a, 0xc3, 0xce, 0x69, 0x7f, 0xfb,
+	0x1b, 0x7c, 0x53, 0x54, 0x8d, 0xeb, 0xcf, 0xaf, 0x4e, 0x2a, 0xce, 0xaa, 0xcb, 0xfa, 0x08, 0x5a,
+	0x65, 0x4e, 0x2f, 0x30, 0xa2, 0x21, 0x2b, 0xfc, 0x84, 0x67, 0x72, 0x00, 0x8d, 0x25, 0x0d, 0xd2,
+	0xd2, 0x42, 0x79, 0x60, 0xfd, 0x54, 0x05, 0x28, 0xdc, 0x18, 0x07, 0xd9, 0x6a, 0xf3, 0xc6, 0x9b,
+	0x9b, 0xaf, 0xae, 0x37, 0x7f, 0x00, 0x8d, 0x48, 0x44, 0x1e, 0x43, 0xb3, 0xed, 0x3a, 0x79, 0xa0,
+	0x77, 0x1e, 0xd0, 0x64, 0xbd, 0xb2, 0x7a, 0xbe, 0xf3, 0x1c, 0xcb, 0x37, 0xf6, 0x09, 0xdc, 0x9a,
+	0xf3, 0x88, 0x06, 0xfc, 0x47, 0x36, 0xcb, 0xab, 0x94, 0xbb, 0xa0, 0x6a, 0xc1, 0x14, 0x1a, 0xb0,
+	0xeb, 0x1c, 0xae, 0xd2, 0xd8, 0xa0, 0x1e, 0x62, 0x12, 0x6d, 0xcc, 0xfd, 0xa2, 0xa3, 0xb0, 0x65,
+	0x5b, 0x71, 0x3f, 0x2f, 0x7a, 0x45, 0xbc, 0xe6, 0xbf, 0x12, 0xef, 0xa5, 0x01, 0xcd, 0xc2, 0x1e,
+	0xe4, 0x2e, 0xf4, 0x66, 0x7c, 0x3e, 0x67, 0x92, 0x45, 0x09, 0xa7, 0x89, 0x90, 0xa8, 0x46, 0xcb,
+	0x79, 0x0d, 0xd5, 0x06, 0x5e, 0xca, 0xb9, 0xbb, 0x16, 0xb5, 0xeb, 0xb4, 0x96, 0x72, 0xfe, 0x44,
+	0xc7, 0x65, 0x32, 0x96, 0x42, 0xcc, 0xcd, 0xda, 0x2a, 0x79, 0xae, 0x63, 0xad, 0x54, 0x69, 0x0b,
+	0x57, 0x4b, 0x5b, 0xc7, 0x7c, 0xa7, 0xc4, 0x26, 0xdc, 0x27, 0x03, 0xe8, 0xd0, 0x20, 0xd0, 0xef,
+	0xa3, 0x25, 0x28, 0xd4, 0xd9, 0x84, 0xc8, 0xff, 0xa1, 0xfd, 0x2c, 0x65, 0x32, 0xc3, 0x7c, 0x21,
+	0xc9, 0x0a, 0x78, 0xf3, 0x73, 0xb5, 0x7e, 0xad, 0xc2, 0xd1, 0x76, 0xfb, 0x92, 0x4b, 0x68, 0xea,
+	0x2d, 0x45, 0x5e, 0x96, 0x3b, 0x66, 0x7c, 0x5f, 0xcb, 0xf3, 0xc7, 0xd5, 0xc9, 0x5d, 0x9f, 0x27,
+	0x8b, 0x74, 0x6a, 0x7b, 0x22, 0x1c, 0x79, 0x42, 0x85, 0x42, 0x15, 0x8f, 0xf7, 0xd5, 0xec, 0xe9,
+	0x48, 0xff, 0x90, 0x94, 0x7d, 0xc6, 0xbc, 0x3f, 0xaf, 0x4e, 0x7a, 0x19, 0x0d, 0x83, 0x4f, 0xad,
+	0xaf, 0x73, 0x1a, 0xcb, 0x29, 0x09, 0x09, 0x87, 0x2e, 0x5d, 0x52, 0x1e, 0x94, 0x5f, 0x28, 0xba,
+	0x6f, 0xfc, 0xe0, 0xad, 0x07, 0xec, 0xe7, 0x03, 0x36, 0xb9, 0x2c, 0xe7, 0x15, 0x6a, 0x72, 0x01,
+	0x75, 0x95, 0x45, 0x5e, 0xfe, 0x0b, 0x1c, 0x7f, 0xf6, 0xd6, 0x23, 0x3a, 0xf9, 0x08, 0xcd, 0x61,
+	0x39, 0x48, 0x75, 0xfa, 0x73, 0x15, 0x9a, 0xf8, 0x79, 0x30, 0x49, 0x1e, 0x43, 0x03, 0x8f, 0xc4,
+	0xda, 0x6e, 0xae, 0xcd, 0x9f, 0xfa, 0xf1, 0xe0, 0x6f, 0x6b, 0xe2, 0x20, 0xb3, 0x2a, 0xe4, 0x12,
+	0x7a, 0x18, 0x4f, 0xd2, 0xa9, 0xf2, 0x24, 0x9f, 0xb2, 0xff, 0x8a, 0xf9, 0x03, 0x83, 0x4c, 0x60,
+	0xbf, 0xa0, 0x8d, 0xf5, 0x2d, 0x71, 0x4e, 0xb3, 0x90, 0x45, 0xc9, 0x3f, 0x1a, 0x70, 0x64, 0xe7,
+	0xd7, 0x97, 0x5d, 0x5e, 0x5f, 0xf6, 0x03, 0x7d, 0x7d, 0x59, 0x95, 0xf1, 0xe7, 0xcf, 0xaf, 0xfb,
+	0xc6, 0x8b, 0xeb, 0xbe, 0xf1, 0xf2, 0xba, 0x6f, 0xfc, 0x72, 0xd3, 0xaf, 0xbc, 0xb8, 0xe9, 0x57,
+	0x7e, 0xbf, 0xe9, 0x57, 0x2e, 0xef, 0x6d, 0x88, 0x5c, 0x4c, 0xc0, 0xe7, 0xe8, 0x87, 0x51, 0x79,
+	0x99, 0xa2, 0xd2, 0xd3, 0x1d, 0x24, 0xfd, 0xf0, 0xaf, 0x01, 0x00, 0x2d, 0x46, 0x8e, 0xb6, 0x64,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -470,6 +546,9 @@ const _ = grpc.SupportPackageIsVersion4
 type RelayerClient interface {
 	Relay(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (*RelayReply, error)
 	RelaySubscribe(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (Relayer_RelaySubscribeClient, error)
+	// SubscriptionPayment acknowledges the compute units of an open subscription. the request is signed by the consumer
+	// on the session of the subscription with its cumulative cu_sum, and is kept by the provider as the payment proof
+	SubscriptionPayment(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type relayerClient struct {
@@ -521,10 +600,22 @@ func (x *relayerRelaySubscribeClient) Recv() (*RelayReply, error) {
 	return m, nil
 }
 
+func (c *relayerClient) SubscriptionPayment(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Relayer/SubscriptionPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RelayerServer is the server API for Relayer service.
 type RelayerServer interface {
 	Relay(context.Context, *RelayRequest) (*RelayReply, error)
 	RelaySubscribe(*RelayRequest, Relayer_RelaySubscribeServer) error
+	// SubscriptionPayment acknowledges the compute units of an open subscription. the request is signed by the consumer
+	// on the session of the subscription with its cumulative cu_sum, and is kept by the provider as the payment proof
+	SubscriptionPayment(context.Context, *RelayRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedRelayerServer can be embedded to have forward compatible implementations.
@@ -537,6 +628,9 @@ func (*UnimplementedRelayerServer) Relay(ctx context.Context, req *RelayRequest)
 func (*UnimplementedRelayerServer) RelaySubscribe(req *RelayRequest, srv Relayer_RelaySubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method RelaySubscribe not implemented")
 }
+func (*UnimplementedRelayerServer) SubscriptionPayment(ctx context.Context, req *RelayRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscriptionPayment not implemented")
+}
 
 func RegisterRelayerServer(s grpc1.Server, srv RelayerServer) {
 	s.RegisterService(&_Relayer_serviceDesc, srv)
@@ -581,6 +675,24 @@ func (x *relayerRelaySubscribeServer) Send(m *RelayReply) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Relayer_SubscriptionPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).SubscriptionPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Relayer/SubscriptionPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).SubscriptionPayment(ctx, req.(*RelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Relayer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.pairing.Relayer",
 	HandlerType: (*RelayerServer)(nil),
@@ -589,6 +701,10 @@ var _Relayer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Relay",
 			Handler:    _Relayer_Relay_Handler,
 		},
+		{
+			MethodName: "SubscriptionPayment",
+			Handler:    _Relayer_SubscriptionPayment_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -620,6 +736,20 @@ func (m *RelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.UnresponsiveProviders) > 0 {
 		i -= len(m.UnresponsiveProviders)
 		copy(dAtA[i:], m.UnresponsiveProviders)
@@ -721,6 +851,43 @@ func (m *RelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRelay(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RelayReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -741,6 +908,20 @@ func (m *RelayReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.SigBlocks) > 0 {
 		i -= len(m.SigBlocks)
 		copy(dAtA[i:], m.SigBlocks)
@@ -978,6 +1159,29 @@ func (m *RelayRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRelay(uint64(l))
 	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovRelay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRelay(uint64(l))
+	}
 	return n
 }
 
@@ -1009,6 +1213,12 @@ func (m *RelayReply) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRelay(uint64(l))
 	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovRelay(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1495,6 +1705,154 @@ func (m *RelayRequest) Unmarshal(dAtA []byte) error {
 				m.UnresponsiveProviders = []byte{}
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelay(dAtA[iNdEx:])
@@ -1719,6 +2077,40 @@ func (m *RelayReply) Unmarshal(dAtA []byte) error {
 				m.SigBlocks = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRelay(dAtA[iNdEx:])
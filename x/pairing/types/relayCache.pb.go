@@ -249,44 +249,119 @@ func (m *RelayCacheSet) GetFinalized() bool {
 	return false
 }
 
+// InvalidateRange removes the entries of the blocks fromBlock to toBlock (inclusive) of a chain, and its entries
+// of the latest block. sent on chain reorgs, when the hashes of these blocks changed
+type RelayCacheInvalidateRange struct {
+	ChainID      string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	ApiInterface string `protobuf:"bytes,2,opt,name=apiInterface,proto3" json:"apiInterface,omitempty"`
+	FromBlock    int64  `protobuf:"varint,3,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	ToBlock      int64  `protobuf:"varint,4,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+}
+
+func (m *RelayCacheInvalidateRange) Reset()         { *m = RelayCacheInvalidateRange{} }
+func (m *RelayCacheInvalidateRange) String() string { return proto.CompactTextString(m) }
+func (*RelayCacheInvalidateRange) ProtoMessage()    {}
+func (*RelayCacheInvalidateRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cd8c815c0cb2c9f, []int{3}
+}
+func (m *RelayCacheInvalidateRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayCacheInvalidateRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayCacheInvalidateRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayCacheInvalidateRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayCacheInvalidateRange.Merge(m, src)
+}
+func (m *RelayCacheInvalidateRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayCacheInvalidateRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayCacheInvalidateRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayCacheInvalidateRange proto.InternalMessageInfo
+
+func (m *RelayCacheInvalidateRange) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *RelayCacheInvalidateRange) GetApiInterface() string {
+	if m != nil {
+		return m.ApiInterface
+	}
+	return ""
+}
+
+func (m *RelayCacheInvalidateRange) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *RelayCacheInvalidateRange) GetToBlock() int64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CacheUsage)(nil), "lavanet.lava.pairing.CacheUsage")
 	proto.RegisterType((*RelayCacheGet)(nil), "lavanet.lava.pairing.RelayCacheGet")
 	proto.RegisterType((*RelayCacheSet)(nil), "lavanet.lava.pairing.RelayCacheSet")
+	proto.RegisterType((*RelayCacheInvalidateRange)(nil), "lavanet.lava.pairing.RelayCacheInvalidateRange")
 }
 
 func init() { proto.RegisterFile("pairing/relayCache.proto", fileDescriptor_2cd8c815c0cb2c9f) }
 
 var fileDescriptor_2cd8c815c0cb2c9f = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0x8d, 0x97, 0xa5, 0x4d, 0xbd, 0xe5, 0x62, 0x56, 0x28, 0x0a, 0x28, 0x8a, 0xc2, 0x81, 0x9e,
-	0x1c, 0x69, 0xb9, 0xee, 0x05, 0x28, 0xda, 0x56, 0x82, 0x8b, 0x2b, 0x2e, 0xdc, 0x9c, 0x30, 0x4d,
-	0xac, 0xcd, 0xc6, 0x21, 0x76, 0x11, 0xe5, 0x2b, 0xf8, 0x05, 0xbe, 0x06, 0x8e, 0x7b, 0xe4, 0x88,
-	0xda, 0x5f, 0xe0, 0x03, 0x50, 0xa6, 0x4d, 0xb3, 0x45, 0x6c, 0xe1, 0xc8, 0xc9, 0x9e, 0xe7, 0x79,
-	0x33, 0xef, 0x8d, 0xc6, 0xd4, 0xab, 0xa4, 0xaa, 0x55, 0x99, 0xc5, 0x35, 0x14, 0x72, 0xf9, 0x42,
-	0xa6, 0x39, 0xf0, 0xaa, 0xd6, 0x56, 0xb3, 0xd3, 0x42, 0x7e, 0x90, 0x25, 0x58, 0xde, 0x9c, 0x7c,
-	0x9b, 0xe6, 0x9f, 0x66, 0x3a, 0xd3, 0x98, 0x10, 0x37, 0xb7, 0x4d, 0xae, 0x7f, 0x7f, 0xaf, 0xca,
-	0x16, 0x7c, 0x98, 0x69, 0x9d, 0x15, 0x10, 0x63, 0x94, 0x2c, 0xe6, 0x31, 0x5c, 0x55, 0x76, 0xfb,
-	0x18, 0xbd, 0xa2, 0x14, 0x9b, 0xbd, 0x31, 0x32, 0x03, 0xf6, 0x88, 0x0e, 0x30, 0x9a, 0x28, 0x6b,
-	0x3c, 0x12, 0x92, 0xd1, 0xb1, 0xe8, 0x00, 0x16, 0xd2, 0x13, 0x0c, 0x5e, 0x2b, 0x63, 0xc0, 0x78,
-	0x47, 0xf8, 0x7e, 0x13, 0x8a, 0xbe, 0x12, 0x7a, 0x4f, 0xec, 0x0c, 0x5c, 0x80, 0x65, 0xe7, 0xb4,
-	0x5f, 0xc3, 0xfb, 0x05, 0x18, 0x8b, 0xf5, 0x4e, 0xce, 0x22, 0xfe, 0x27, 0x3f, 0x1c, 0x59, 0x62,
-	0x93, 0x29, 0x5a, 0x0a, 0x8b, 0xe8, 0x50, 0x56, 0x6a, 0x5a, 0x5a, 0xa8, 0xe7, 0x32, 0x05, 0x6c,
-	0x39, 0x10, 0x7b, 0x58, 0xa3, 0x39, 0x29, 0x74, 0x7a, 0x39, 0x91, 0x26, 0xf7, 0xee, 0x84, 0x64,
-	0x34, 0x14, 0x1d, 0xc0, 0x3c, 0xda, 0x4f, 0x73, 0xa9, 0xca, 0xe9, 0xd8, 0x3b, 0x46, 0x72, 0x1b,
-	0x36, 0xbc, 0xb9, 0x2a, 0x65, 0xa1, 0x3e, 0xc1, 0x3b, 0xef, 0x6e, 0x48, 0x46, 0xae, 0xe8, 0x80,
-	0xe8, 0xcb, 0xd1, 0x4d, 0x27, 0xb3, 0xff, 0xda, 0x89, 0x4f, 0xdd, 0x64, 0x91, 0x5e, 0x82, 0x9d,
-	0x8e, 0xd1, 0xc8, 0x40, 0xec, 0x62, 0x76, 0x4e, 0xdd, 0x1a, 0x4c, 0xa5, 0x4b, 0x03, 0x5e, 0x0f,
-	0x65, 0x87, 0x07, 0x65, 0x57, 0xc5, 0x52, 0xec, 0x18, 0xfb, 0x33, 0xea, 0xff, 0x36, 0xa3, 0xb3,
-	0x9f, 0x84, 0x0e, 0x91, 0x06, 0x35, 0x4e, 0x89, 0xcd, 0xa8, 0x7b, 0x01, 0x16, 0x21, 0xf6, 0xf8,
-	0x40, 0x9b, 0x76, 0x3b, 0xfc, 0xbf, 0x6a, 0x89, 0x1c, 0x36, 0xa5, 0xee, 0xec, 0x9f, 0x8b, 0xce,
-	0xc0, 0xfa, 0x0f, 0xf8, 0x66, 0xe1, 0x79, 0xbb, 0xf0, 0xfc, 0x65, 0xb3, 0xf0, 0x91, 0xc3, 0xc6,
-	0xb4, 0x37, 0x01, 0x59, 0xd8, 0x9c, 0xdd, 0x92, 0x73, 0x9b, 0xa0, 0xee, 0x8b, 0x44, 0xce, 0xf3,
-	0x67, 0xdf, 0x56, 0x01, 0xb9, 0x5e, 0x05, 0xe4, 0xc7, 0x2a, 0x20, 0x9f, 0xd7, 0x81, 0x73, 0xbd,
-	0x0e, 0x9c, 0xef, 0xeb, 0xc0, 0x79, 0xfb, 0x24, 0x53, 0x36, 0x5f, 0x24, 0x3c, 0xd5, 0x57, 0xf1,
-	0xb6, 0x0e, 0x9e, 0xf1, 0xc7, 0xb8, 0xfd, 0x98, 0x76, 0x59, 0x81, 0x49, 0x7a, 0xd8, 0xf6, 0xe9,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0xb5, 0x17, 0xd4, 0xf6, 0x03, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xf5, 0xa4, 0xf9, 0x12, 0x67, 0x9a, 0x4f, 0x48, 0x43, 0x85, 0x8c, 0x41, 0x96, 0x65, 0x16,
+	0x64, 0x65, 0x4b, 0x65, 0xdb, 0x0d, 0x25, 0xa8, 0xb1, 0x04, 0x9b, 0x89, 0xd8, 0x20, 0x36, 0x63,
+	0xf7, 0xc6, 0x1e, 0xd5, 0xf1, 0x18, 0xcf, 0xa4, 0x22, 0x3c, 0x05, 0x3c, 0x02, 0x4f, 0x03, 0xcb,
+	0x8a, 0x15, 0x4b, 0x94, 0xbc, 0x08, 0xf2, 0x24, 0x8e, 0x93, 0x88, 0xb4, 0x5d, 0xb2, 0x9a, 0xdc,
+	0x73, 0xff, 0xce, 0x39, 0xca, 0x35, 0xb6, 0x0a, 0xc6, 0x4b, 0x9e, 0x27, 0x41, 0x09, 0x19, 0x9b,
+	0xbf, 0x62, 0x71, 0x0a, 0x7e, 0x51, 0x0a, 0x25, 0xc8, 0x49, 0xc6, 0xae, 0x59, 0x0e, 0xca, 0xaf,
+	0x5e, 0x7f, 0x5d, 0x66, 0x9f, 0x24, 0x22, 0x11, 0xba, 0x20, 0xa8, 0x7e, 0xad, 0x6a, 0xed, 0x87,
+	0x3b, 0x53, 0xd6, 0xe0, 0x93, 0x44, 0x88, 0x24, 0x83, 0x40, 0x47, 0xd1, 0x6c, 0x12, 0xc0, 0xb4,
+	0x50, 0xeb, 0xa4, 0xf7, 0x06, 0x63, 0xbd, 0xec, 0x9d, 0x64, 0x09, 0x90, 0xa7, 0xb8, 0xa7, 0xa3,
+	0x11, 0x57, 0xd2, 0x42, 0x2e, 0x1a, 0xb4, 0x69, 0x03, 0x10, 0x17, 0x1f, 0xeb, 0xe0, 0x2d, 0x97,
+	0x12, 0xa4, 0xd5, 0xd2, 0xf9, 0x6d, 0xc8, 0xfb, 0x8e, 0xf0, 0xff, 0x74, 0x23, 0xe0, 0x02, 0x14,
+	0x39, 0xc3, 0xdd, 0x12, 0x3e, 0xce, 0x40, 0x2a, 0x3d, 0xef, 0xf8, 0xd4, 0xf3, 0xff, 0xa6, 0xc7,
+	0xd7, 0x5d, 0x74, 0x55, 0x49, 0xeb, 0x16, 0xe2, 0xe1, 0x3e, 0x2b, 0x78, 0x98, 0x2b, 0x28, 0x27,
+	0x2c, 0x06, 0xbd, 0xb2, 0x47, 0x77, 0xb0, 0x8a, 0x73, 0x94, 0x89, 0xf8, 0x6a, 0xc4, 0x64, 0x6a,
+	0x1d, 0xb9, 0x68, 0xd0, 0xa7, 0x0d, 0x40, 0x2c, 0xdc, 0x8d, 0x53, 0xc6, 0xf3, 0x70, 0x68, 0xb5,
+	0x75, 0x73, 0x1d, 0x56, 0x7d, 0x13, 0x9e, 0xb3, 0x8c, 0x7f, 0x86, 0x4b, 0xeb, 0x3f, 0x17, 0x0d,
+	0x4c, 0xda, 0x00, 0xde, 0xb7, 0xd6, 0xb6, 0x92, 0xf1, 0x3f, 0xad, 0xc4, 0xc6, 0x66, 0x34, 0x8b,
+	0xaf, 0x40, 0x85, 0x43, 0x2d, 0xa4, 0x47, 0x37, 0x31, 0x39, 0xc3, 0x66, 0x09, 0xb2, 0x10, 0xb9,
+	0x04, 0xab, 0xa3, 0x69, 0xbb, 0xb7, 0xd2, 0x2e, 0xb2, 0x39, 0xdd, 0x74, 0xec, 0x7a, 0xd4, 0xdd,
+	0xf7, 0xe8, 0x2b, 0xc2, 0x8f, 0x1b, 0x8f, 0xc2, 0xfc, 0x9a, 0x65, 0xfc, 0x92, 0x29, 0xa0, 0x2c,
+	0x4f, 0x60, 0x9b, 0x2f, 0xda, 0xe5, 0x7b, 0x4f, 0x2f, 0x26, 0xa5, 0x98, 0x9e, 0x57, 0xf2, 0xb5,
+	0x17, 0x47, 0xb4, 0x01, 0xaa, 0xd9, 0x4a, 0xac, 0x72, 0x6d, 0x9d, 0xab, 0xc3, 0xd3, 0x9f, 0x2d,
+	0xdc, 0xd7, 0x9c, 0xa0, 0xd4, 0xac, 0xc8, 0x18, 0x9b, 0x17, 0xa0, 0x34, 0x44, 0x9e, 0xdd, 0x22,
+	0xbd, 0xfe, 0xc7, 0xda, 0x77, 0xfa, 0xe3, 0x19, 0x24, 0xc4, 0xe6, 0xf8, 0xde, 0x43, 0xc7, 0xa0,
+	0xec, 0x47, 0xfe, 0xea, 0x08, 0xfd, 0xfa, 0x08, 0xfd, 0xd7, 0xd5, 0x11, 0x7a, 0x06, 0x19, 0xe2,
+	0xce, 0x08, 0x58, 0xa6, 0x52, 0x72, 0xa0, 0xe6, 0x10, 0xa1, 0xe6, 0x6c, 0x3d, 0x83, 0x7c, 0xc0,
+	0x0f, 0xf6, 0xfd, 0x0f, 0xee, 0xe2, 0xb5, 0xd7, 0x70, 0x98, 0xe3, 0xf9, 0xcb, 0x1f, 0x0b, 0x07,
+	0xdd, 0x2c, 0x1c, 0xf4, 0x7b, 0xe1, 0xa0, 0x2f, 0x4b, 0xc7, 0xb8, 0x59, 0x3a, 0xc6, 0xaf, 0xa5,
+	0x63, 0xbc, 0x7f, 0x9e, 0x70, 0x95, 0xce, 0x22, 0x3f, 0x16, 0xd3, 0x60, 0xbd, 0x4e, 0xbf, 0xc1,
+	0xa7, 0xa0, 0xfe, 0x14, 0xa9, 0x79, 0x01, 0x32, 0xea, 0xe8, 0xa1, 0x2f, 0xfe, 0x0c, 0x00, 0x65,
+	0xf5, 0xed, 0x9b, 0xe8, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -304,6 +379,7 @@ type RelayerCacheClient interface {
 	GetRelay(ctx context.Context, in *RelayCacheGet, opts ...grpc.CallOption) (*RelayReply, error)
 	SetRelay(ctx context.Context, in *RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CacheUsage, error)
+	InvalidateRange(ctx context.Context, in *RelayCacheInvalidateRange, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type relayerCacheClient struct {
@@ -341,11 +417,21 @@ func (c *relayerCacheClient) Health(ctx context.Context, in *emptypb.Empty, opts
 	return out, nil
 }
 
+func (c *relayerCacheClient) InvalidateRange(ctx context.Context, in *RelayCacheInvalidateRange, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCache/InvalidateRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RelayerCacheServer is the server API for RelayerCache service.
 type RelayerCacheServer interface {
 	GetRelay(context.Context, *RelayCacheGet) (*RelayReply, error)
 	SetRelay(context.Context, *RelayCacheSet) (*emptypb.Empty, error)
 	Health(context.Context, *emptypb.Empty) (*CacheUsage, error)
+	InvalidateRange(context.Context, *RelayCacheInvalidateRange) (*emptypb.Empty, error)
 }
 
 // UnimplementedRelayerCacheServer can be embedded to have forward compatible implementations.
@@ -361,6 +447,9 @@ func (*UnimplementedRelayerCacheServer) SetRelay(ctx context.Context, req *Relay
 func (*UnimplementedRelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*CacheUsage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
 }
+func (*UnimplementedRelayerCacheServer) InvalidateRange(ctx context.Context, req *RelayCacheInvalidateRange) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateRange not implemented")
+}
 
 func RegisterRelayerCacheServer(s grpc1.Server, srv RelayerCacheServer) {
 	s.RegisterService(&_RelayerCache_serviceDesc, srv)
@@ -420,6 +509,24 @@ func _RelayerCache_Health_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RelayerCache_InvalidateRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayCacheInvalidateRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheServer).InvalidateRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCache/InvalidateRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheServer).InvalidateRange(ctx, req.(*RelayCacheInvalidateRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RelayerCache_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.pairing.RelayerCache",
 	HandlerType: (*RelayerCacheServer)(nil),
@@ -436,6 +543,10 @@ var _RelayerCache_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Health",
 			Handler:    _RelayerCache_Health_Handler,
 		},
+		{
+			MethodName: "InvalidateRange",
+			Handler:    _RelayerCache_InvalidateRange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pairing/relayCache.proto",
@@ -625,6 +736,53 @@ func (m *RelayCacheSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayCacheInvalidateRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayCacheInvalidateRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayCacheInvalidateRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ApiInterface) > 0 {
+		i -= len(m.ApiInterface)
+		copy(dAtA[i:], m.ApiInterface)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ApiInterface)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRelayCache(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRelayCache(v)
 	base := offset
@@ -715,6 +873,29 @@ func (m *RelayCacheSet) Size() (n int) {
 	return n
 }
 
+func (m *RelayCacheInvalidateRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	l = len(m.ApiInterface)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.ToBlock))
+	}
+	return n
+}
+
 func sovRelayCache(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1285,6 +1466,158 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RelayCacheInvalidateRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayCacheInvalidateRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayCacheInvalidateRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiInterface", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiInterface = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			}
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRelayCache(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,1237 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/signer.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SignRelayResponseRequest struct {
+	Reply         *RelayReply   `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Request       *RelayRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	ClientAddress []byte        `protobuf:"bytes,3,opt,name=client_address,json=clientAddress,proto3" json:"client_address,omitempty"`
+}
+
+func (m *SignRelayResponseRequest) Reset()         { *m = SignRelayResponseRequest{} }
+func (m *SignRelayResponseRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRelayResponseRequest) ProtoMessage()    {}
+func (*SignRelayResponseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27091b6e188eab73, []int{0}
+}
+func (m *SignRelayResponseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignRelayResponseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignRelayResponseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignRelayResponseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRelayResponseRequest.Merge(m, src)
+}
+func (m *SignRelayResponseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignRelayResponseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRelayResponseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRelayResponseRequest proto.InternalMessageInfo
+
+func (m *SignRelayResponseRequest) GetReply() *RelayReply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+func (m *SignRelayResponseRequest) GetRequest() *RelayRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *SignRelayResponseRequest) GetClientAddress() []byte {
+	if m != nil {
+		return m.ClientAddress
+	}
+	return nil
+}
+
+type SignTxRequest struct {
+	SignDoc []byte `protobuf:"bytes,1,opt,name=sign_doc,json=signDoc,proto3" json:"sign_doc,omitempty"`
+}
+
+func (m *SignTxRequest) Reset()         { *m = SignTxRequest{} }
+func (m *SignTxRequest) String() string { return proto.CompactTextString(m) }
+func (*SignTxRequest) ProtoMessage()    {}
+func (*SignTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27091b6e188eab73, []int{1}
+}
+func (m *SignTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTxRequest.Merge(m, src)
+}
+func (m *SignTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTxRequest proto.InternalMessageInfo
+
+func (m *SignTxRequest) GetSignDoc() []byte {
+	if m != nil {
+		return m.SignDoc
+	}
+	return nil
+}
+
+type Signature struct {
+	Sig []byte `protobuf:"bytes,1,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (m *Signature) Reset()         { *m = Signature{} }
+func (m *Signature) String() string { return proto.CompactTextString(m) }
+func (*Signature) ProtoMessage()    {}
+func (*Signature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27091b6e188eab73, []int{2}
+}
+func (m *Signature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Signature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Signature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Signature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signature.Merge(m, src)
+}
+func (m *Signature) XXX_Size() int {
+	return m.Size()
+}
+func (m *Signature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Signature proto.InternalMessageInfo
+
+func (m *Signature) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
+type SignerPubKey struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *SignerPubKey) Reset()         { *m = SignerPubKey{} }
+func (m *SignerPubKey) String() string { return proto.CompactTextString(m) }
+func (*SignerPubKey) ProtoMessage()    {}
+func (*SignerPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27091b6e188eab73, []int{3}
+}
+func (m *SignerPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerPubKey.Merge(m, src)
+}
+func (m *SignerPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerPubKey proto.InternalMessageInfo
+
+func (m *SignerPubKey) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignRelayResponseRequest)(nil), "lavanet.lava.pairing.SignRelayResponseRequest")
+	proto.RegisterType((*SignTxRequest)(nil), "lavanet.lava.pairing.SignTxRequest")
+	proto.RegisterType((*Signature)(nil), "lavanet.lava.pairing.Signature")
+	proto.RegisterType((*SignerPubKey)(nil), "lavanet.lava.pairing.SignerPubKey")
+}
+
+func init() { proto.RegisterFile("pairing/signer.proto", fileDescriptor_27091b6e188eab73) }
+
+var fileDescriptor_27091b6e188eab73 = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xb6, 0x29, 0x24, 0x30, 0x49, 0x11, 0x2c, 0x15, 0x84, 0x40, 0x4d, 0x64, 0x84, 0x5a, 0x71,
+	0x58, 0x4b, 0x45, 0xe2, 0xc4, 0xa5, 0xa8, 0xe4, 0xc2, 0x81, 0x6a, 0x5b, 0x71, 0xe0, 0x12, 0xad,
+	0x93, 0xa9, 0x59, 0xe1, 0x7a, 0xb7, 0xf6, 0x1a, 0xc5, 0x3c, 0x05, 0x6f, 0xc1, 0x23, 0xf0, 0x0a,
+	0x1c, 0x73, 0xe4, 0x88, 0x92, 0x17, 0x41, 0xfb, 0x63, 0x09, 0x89, 0x04, 0xe5, 0xc2, 0x69, 0xbd,
+	0xb3, 0xdf, 0xf7, 0xcd, 0x37, 0x9f, 0xc6, 0xb0, 0xa7, 0xb8, 0x28, 0x45, 0x91, 0x25, 0x95, 0xc8,
+	0x0a, 0x2c, 0xa9, 0x2a, 0xa5, 0x96, 0x64, 0x2f, 0xe7, 0x9f, 0x79, 0x81, 0x9a, 0x9a, 0x93, 0x7a,
+	0xc8, 0xf0, 0x51, 0x26, 0x65, 0x96, 0x63, 0x62, 0x31, 0x69, 0x7d, 0x91, 0xe0, 0xa5, 0xd2, 0x8d,
+	0xa3, 0x0c, 0xef, 0xb5, 0x42, 0x25, 0xe6, 0xdc, 0x17, 0xe3, 0xef, 0x21, 0x0c, 0xce, 0x44, 0x56,
+	0x30, 0x53, 0x63, 0x58, 0x29, 0x59, 0x54, 0xc8, 0xf0, 0xaa, 0xc6, 0x4a, 0x93, 0x97, 0x70, 0xa3,
+	0x44, 0x95, 0x37, 0x83, 0x70, 0x14, 0x1e, 0xf6, 0x8e, 0x46, 0x74, 0x5d, 0x53, 0xea, 0xa9, 0x2a,
+	0x6f, 0x98, 0x83, 0x93, 0x57, 0xd0, 0x2d, 0x9d, 0xc4, 0xe0, 0x9a, 0x65, 0xc6, 0xff, 0x64, 0x5a,
+	0x24, 0x6b, 0x29, 0xe4, 0x19, 0xdc, 0x9e, 0xe6, 0x02, 0x0b, 0x3d, 0xe1, 0xb3, 0x59, 0x89, 0x55,
+	0x35, 0xd8, 0x19, 0x85, 0x87, 0x7d, 0xb6, 0xeb, 0xaa, 0xc7, 0xae, 0x18, 0x3f, 0x87, 0x5d, 0x63,
+	0xfc, 0x7c, 0xde, 0xba, 0x7d, 0x08, 0x37, 0x4d, 0x44, 0x93, 0x99, 0x9c, 0x5a, 0xc3, 0x7d, 0xd6,
+	0x35, 0xf7, 0x13, 0x39, 0x8d, 0xf7, 0xe1, 0x96, 0xc1, 0x72, 0x5d, 0x97, 0x48, 0xee, 0xc0, 0x4e,
+	0x25, 0x32, 0x0f, 0x31, 0x9f, 0xf1, 0x01, 0xf4, 0xcf, 0x6c, 0xb8, 0xa7, 0x75, 0xfa, 0x16, 0x1b,
+	0xf2, 0x00, 0xba, 0xaa, 0x4e, 0x27, 0x9f, 0xb0, 0xf1, 0xa8, 0x8e, 0xb2, 0x0f, 0x47, 0xdf, 0xae,
+	0x43, 0xcf, 0x9a, 0x76, 0x70, 0x32, 0x86, 0x8e, 0xa7, 0xdc, 0xa7, 0x2e, 0x7a, 0xda, 0x46, 0x4f,
+	0xdf, 0x98, 0xe8, 0x87, 0x1b, 0x26, 0xff, 0xb3, 0x5d, 0x1c, 0x10, 0xe6, 0xfc, 0x59, 0x69, 0xb2,
+	0x45, 0x58, 0xc3, 0x27, 0x9b, 0x65, 0xed, 0x90, 0x71, 0x40, 0xde, 0x41, 0xcf, 0x5c, 0xdf, 0xb3,
+	0xf1, 0x09, 0xd7, 0x9c, 0xec, 0xaf, 0x67, 0xf8, 0xe7, 0x6d, 0x04, 0x2f, 0xe0, 0xee, 0x5f, 0x9b,
+	0x42, 0xe8, 0x66, 0xde, 0xba, 0x95, 0xda, 0xa6, 0xcf, 0x15, 0x3c, 0x76, 0x74, 0xc7, 0x1c, 0x8b,
+	0x82, 0xe7, 0xe2, 0x0b, 0xd7, 0x42, 0x16, 0x76, 0x92, 0xff, 0xd0, 0xf2, 0x14, 0x3a, 0x6e, 0x97,
+	0xc8, 0xd3, 0xcd, 0xe0, 0xf3, 0xf9, 0xf6, 0x8a, 0xaf, 0x8f, 0x7f, 0x2c, 0xa3, 0x70, 0xb1, 0x8c,
+	0xc2, 0x5f, 0xcb, 0x28, 0xfc, 0xba, 0x8a, 0x82, 0xc5, 0x2a, 0x0a, 0x7e, 0xae, 0xa2, 0xe0, 0xc3,
+	0x41, 0x26, 0xf4, 0xc7, 0x3a, 0xa5, 0x53, 0x79, 0x99, 0x78, 0x19, 0x7b, 0x26, 0xf3, 0xa4, 0xfd,
+	0x41, 0x75, 0xa3, 0xb0, 0x4a, 0x3b, 0x76, 0x95, 0x5e, 0xfc, 0x1e, 0x00, 0xea, 0x6d, 0x7c, 0x8b,
+	0x01, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RelaySignerClient is the client API for RelaySigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RelaySignerClient interface {
+	PubKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SignerPubKey, error)
+	SignRelay(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (*Signature, error)
+	SignVRFData(ctx context.Context, in *VRFData, opts ...grpc.CallOption) (*Signature, error)
+	SignRelayResponse(ctx context.Context, in *SignRelayResponseRequest, opts ...grpc.CallOption) (*Signature, error)
+	SignResponseFinalizationData(ctx context.Context, in *SignRelayResponseRequest, opts ...grpc.CallOption) (*Signature, error)
+	SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*Signature, error)
+}
+
+type relaySignerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRelaySignerClient(cc grpc1.ClientConn) RelaySignerClient {
+	return &relaySignerClient{cc}
+}
+
+func (c *relaySignerClient) PubKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SignerPubKey, error) {
+	out := new(SignerPubKey)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/PubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignRelay(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (*Signature, error) {
+	out := new(Signature)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignVRFData(ctx context.Context, in *VRFData, opts ...grpc.CallOption) (*Signature, error) {
+	out := new(Signature)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignVRFData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignRelayResponse(ctx context.Context, in *SignRelayResponseRequest, opts ...grpc.CallOption) (*Signature, error) {
+	out := new(Signature)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignRelayResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignResponseFinalizationData(ctx context.Context, in *SignRelayResponseRequest, opts ...grpc.CallOption) (*Signature, error) {
+	out := new(Signature)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignResponseFinalizationData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relaySignerClient) SignTx(ctx context.Context, in *SignTxRequest, opts ...grpc.CallOption) (*Signature, error) {
+	out := new(Signature)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelaySigner/SignTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelaySignerServer is the server API for RelaySigner service.
+type RelaySignerServer interface {
+	PubKey(context.Context, *emptypb.Empty) (*SignerPubKey, error)
+	SignRelay(context.Context, *RelayRequest) (*Signature, error)
+	SignVRFData(context.Context, *VRFData) (*Signature, error)
+	SignRelayResponse(context.Context, *SignRelayResponseRequest) (*Signature, error)
+	SignResponseFinalizationData(context.Context, *SignRelayResponseRequest) (*Signature, error)
+	SignTx(context.Context, *SignTxRequest) (*Signature, error)
+}
+
+// UnimplementedRelaySignerServer can be embedded to have forward compatible implementations.
+type UnimplementedRelaySignerServer struct {
+}
+
+func (*UnimplementedRelaySignerServer) PubKey(ctx context.Context, req *emptypb.Empty) (*SignerPubKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignRelay(ctx context.Context, req *RelayRequest) (*Signature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignRelay not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignVRFData(ctx context.Context, req *VRFData) (*Signature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignVRFData not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignRelayResponse(ctx context.Context, req *SignRelayResponseRequest) (*Signature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignRelayResponse not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignResponseFinalizationData(ctx context.Context, req *SignRelayResponseRequest) (*Signature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignResponseFinalizationData not implemented")
+}
+func (*UnimplementedRelaySignerServer) SignTx(ctx context.Context, req *SignTxRequest) (*Signature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTx not implemented")
+}
+
+func RegisterRelaySignerServer(s grpc1.Server, srv RelaySignerServer) {
+	s.RegisterService(&_RelaySigner_serviceDesc, srv)
+}
+
+func _RelaySigner_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).PubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/PubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).PubKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignRelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignRelay(ctx, req.(*RelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignVRFData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VRFData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignVRFData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignVRFData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignVRFData(ctx, req.(*VRFData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignRelayResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRelayResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignRelayResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignRelayResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignRelayResponse(ctx, req.(*SignRelayResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignResponseFinalizationData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRelayResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignResponseFinalizationData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignResponseFinalizationData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignResponseFinalizationData(ctx, req.(*SignRelayResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelaySigner_SignTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelaySignerServer).SignTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelaySigner/SignTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelaySignerServer).SignTx(ctx, req.(*SignTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RelaySigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.RelaySigner",
+	HandlerType: (*RelaySignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PubKey",
+			Handler:    _RelaySigner_PubKey_Handler,
+		},
+		{
+			MethodName: "SignRelay",
+			Handler:    _RelaySigner_SignRelay_Handler,
+		},
+		{
+			MethodName: "SignVRFData",
+			Handler:    _RelaySigner_SignVRFData_Handler,
+		},
+		{
+			MethodName: "SignRelayResponse",
+			Handler:    _RelaySigner_SignRelayResponse_Handler,
+		},
+		{
+			MethodName: "SignResponseFinalizationData",
+			Handler:    _RelaySigner_SignResponseFinalizationData_Handler,
+		},
+		{
+			MethodName: "SignTx",
+			Handler:    _RelaySigner_SignTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pairing/signer.proto",
+}
+
+func (m *SignRelayResponseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignRelayResponseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignRelayResponseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientAddress) > 0 {
+		i -= len(m.ClientAddress)
+		copy(dAtA[i:], m.ClientAddress)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.ClientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reply != nil {
+		{
+			size, err := m.Reply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignDoc) > 0 {
+		i -= len(m.SignDoc)
+		copy(dAtA[i:], m.SignDoc)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.SignDoc)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Signature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Signature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Signature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SignRelayResponseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reply != nil {
+		l = m.Reply.Size()
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	l = len(m.ClientAddress)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignDoc)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *Signature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	return n
+}
+
+func sovSigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSigner(x uint64) (n int) {
+	return sovSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SignRelayResponseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignRelayResponseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignRelayResponseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reply == nil {
+				m.Reply = &RelayReply{}
+			}
+			if err := m.Reply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &RelayRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientAddress = append(m.ClientAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientAddress == nil {
+				m.ClientAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignDoc", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignDoc = append(m.SignDoc[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignDoc == nil {
+				m.SignDoc = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Signature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSigner = fmt.Errorf("proto: unexpected end of group")
+)
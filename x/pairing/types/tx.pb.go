@@ -534,6 +534,318 @@ func (m *MsgRelayPaymentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRelayPaymentResponse proto.InternalMessageInfo
 
+type MsgSetProviderOperator struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainID  string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgSetProviderOperator) Reset()         { *m = MsgSetProviderOperator{} }
+func (m *MsgSetProviderOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderOperator) ProtoMessage()    {}
+func (*MsgSetProviderOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{10}
+}
+func (m *MsgSetProviderOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProviderOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProviderOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderOperator.Merge(m, src)
+}
+func (m *MsgSetProviderOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProviderOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProviderOperator proto.InternalMessageInfo
+
+func (m *MsgSetProviderOperator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetProviderOperator) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *MsgSetProviderOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+type MsgSetProviderOperatorResponse struct {
+}
+
+func (m *MsgSetProviderOperatorResponse) Reset()         { *m = MsgSetProviderOperatorResponse{} }
+func (m *MsgSetProviderOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderOperatorResponse) ProtoMessage()    {}
+func (*MsgSetProviderOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{11}
+}
+func (m *MsgSetProviderOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProviderOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProviderOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderOperatorResponse.Merge(m, src)
+}
+func (m *MsgSetProviderOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProviderOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProviderOperatorResponse proto.InternalMessageInfo
+
+type MsgModifyProvider struct {
+	Creator     string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainID     string            `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Endpoints   []types1.Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints"`
+	Geolocation uint64            `protobuf:"varint,4,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	Moniker     string            `protobuf:"bytes,5,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	SetMoniker  bool              `protobuf:"varint,6,opt,name=set_moniker,json=setMoniker,proto3" json:"set_moniker,omitempty"`
+}
+
+func (m *MsgModifyProvider) Reset()         { *m = MsgModifyProvider{} }
+func (m *MsgModifyProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyProvider) ProtoMessage()    {}
+func (*MsgModifyProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{12}
+}
+func (m *MsgModifyProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModifyProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyProvider.Merge(m, src)
+}
+func (m *MsgModifyProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModifyProvider proto.InternalMessageInfo
+
+func (m *MsgModifyProvider) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgModifyProvider) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *MsgModifyProvider) GetEndpoints() []types1.Endpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *MsgModifyProvider) GetGeolocation() uint64 {
+	if m != nil {
+		return m.Geolocation
+	}
+	return 0
+}
+
+func (m *MsgModifyProvider) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *MsgModifyProvider) GetSetMoniker() bool {
+	if m != nil {
+		return m.SetMoniker
+	}
+	return false
+}
+
+type MsgModifyProviderResponse struct {
+}
+
+func (m *MsgModifyProviderResponse) Reset()         { *m = MsgModifyProviderResponse{} }
+func (m *MsgModifyProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyProviderResponse) ProtoMessage()    {}
+func (*MsgModifyProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{13}
+}
+func (m *MsgModifyProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModifyProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyProviderResponse.Merge(m, src)
+}
+func (m *MsgModifyProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModifyProviderResponse proto.InternalMessageInfo
+
+type MsgRotateVrfKey struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainID string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Vrfpk   string `protobuf:"bytes,3,opt,name=vrfpk,proto3" json:"vrfpk,omitempty"`
+}
+
+func (m *MsgRotateVrfKey) Reset()         { *m = MsgRotateVrfKey{} }
+func (m *MsgRotateVrfKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateVrfKey) ProtoMessage()    {}
+func (*MsgRotateVrfKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{14}
+}
+func (m *MsgRotateVrfKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateVrfKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateVrfKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateVrfKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateVrfKey.Merge(m, src)
+}
+func (m *MsgRotateVrfKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateVrfKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateVrfKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateVrfKey proto.InternalMessageInfo
+
+func (m *MsgRotateVrfKey) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRotateVrfKey) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *MsgRotateVrfKey) GetVrfpk() string {
+	if m != nil {
+		return m.Vrfpk
+	}
+	return ""
+}
+
+type MsgRotateVrfKeyResponse struct {
+}
+
+func (m *MsgRotateVrfKeyResponse) Reset()         { *m = MsgRotateVrfKeyResponse{} }
+func (m *MsgRotateVrfKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateVrfKeyResponse) ProtoMessage()    {}
+func (*MsgRotateVrfKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2db224a5e52fa36, []int{15}
+}
+func (m *MsgRotateVrfKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateVrfKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateVrfKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateVrfKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateVrfKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateVrfKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateVrfKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateVrfKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateVrfKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStakeProvider)(nil), "lavanet.lava.pairing.MsgStakeProvider")
 	proto.RegisterType((*MsgStakeProviderResponse)(nil), "lavanet.lava.pairing.MsgStakeProviderResponse")
@@ -545,49 +857,65 @@ func init() {
 	proto.RegisterType((*MsgUnstakeClientResponse)(nil), "lavanet.lava.pairing.MsgUnstakeClientResponse")
 	proto.RegisterType((*MsgRelayPayment)(nil), "lavanet.lava.pairing.MsgRelayPayment")
 	proto.RegisterType((*MsgRelayPaymentResponse)(nil), "lavanet.lava.pairing.MsgRelayPaymentResponse")
+	proto.RegisterType((*MsgSetProviderOperator)(nil), "lavanet.lava.pairing.MsgSetProviderOperator")
+	proto.RegisterType((*MsgSetProviderOperatorResponse)(nil), "lavanet.lava.pairing.MsgSetProviderOperatorResponse")
+	proto.RegisterType((*MsgModifyProvider)(nil), "lavanet.lava.pairing.MsgModifyProvider")
+	proto.RegisterType((*MsgModifyProviderResponse)(nil), "lavanet.lava.pairing.MsgModifyProviderResponse")
+	proto.RegisterType((*MsgRotateVrfKey)(nil), "lavanet.lava.pairing.MsgRotateVrfKey")
+	proto.RegisterType((*MsgRotateVrfKeyResponse)(nil), "lavanet.lava.pairing.MsgRotateVrfKeyResponse")
 }
 
 func init() { proto.RegisterFile("pairing/tx.proto", fileDescriptor_b2db224a5e52fa36) }
 
 var fileDescriptor_b2db224a5e52fa36 = []byte{
-	// 591 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xb6, 0xae, 0xa8, 0x2e, 0x63, 0x23, 0x54, 0x90, 0x05, 0x14, 0xaa, 0x00, 0xa3, 0x87,
-	0xe1, 0xb0, 0x72, 0x40, 0xe2, 0xc6, 0xc6, 0xcf, 0x43, 0xa5, 0x29, 0x13, 0x17, 0x6e, 0x6e, 0x6a,
-	0x5c, 0xab, 0x8d, 0x1d, 0x6c, 0xb7, 0x5a, 0xef, 0xdc, 0xe1, 0xc2, 0x9f, 0xc2, 0xff, 0xb0, 0xe3,
-	0x8e, 0x9c, 0x10, 0x6a, 0xff, 0x11, 0x94, 0xc4, 0x09, 0x4d, 0xba, 0x8d, 0x0a, 0x24, 0x4e, 0xc9,
-	0xf3, 0xfb, 0xde, 0xfb, 0xde, 0xf7, 0xfc, 0xa5, 0x05, 0xdb, 0x11, 0xa2, 0x82, 0x32, 0xe2, 0xa9,
-	0x13, 0x18, 0x09, 0xae, 0xb8, 0xd9, 0x1c, 0xa1, 0x09, 0x62, 0x58, 0xc1, 0xf8, 0x09, 0x75, 0xda,
-	0x76, 0x02, 0x2e, 0x43, 0x2e, 0xbd, 0x1e, 0x92, 0xd8, 0x9b, 0xec, 0xf7, 0xb0, 0x42, 0xfb, 0x5e,
-	0xc0, 0x29, 0x4b, 0xab, 0xec, 0x26, 0xe1, 0x84, 0x27, 0xaf, 0x5e, 0xfc, 0xa6, 0x4f, 0x6f, 0xe3,
-	0x88, 0x07, 0x03, 0xa9, 0xb8, 0x40, 0x04, 0x7b, 0x98, 0xf5, 0x23, 0x4e, 0x99, 0xd2, 0xc9, 0x1b,
-	0x19, 0xb5, 0xc0, 0x23, 0x34, 0x4d, 0x0f, 0xdd, 0x4f, 0x6b, 0x60, 0xbb, 0x2b, 0xc9, 0xb1, 0x42,
-	0x43, 0x7c, 0x24, 0xf8, 0x84, 0xf6, 0xb1, 0x30, 0x2d, 0x70, 0x25, 0x10, 0x18, 0x29, 0x2e, 0x2c,
-	0xa3, 0x65, 0xb4, 0xeb, 0x7e, 0x16, 0x26, 0x99, 0x01, 0xa2, 0xec, 0xed, 0x0b, 0x6b, 0x4d, 0x67,
-	0xd2, 0xd0, 0x7c, 0x0a, 0x6a, 0x28, 0xe4, 0x63, 0xa6, 0xac, 0xf5, 0x96, 0xd1, 0x6e, 0x74, 0x76,
-	0x60, 0xaa, 0x00, 0xc6, 0x0a, 0xa0, 0x56, 0x00, 0x0f, 0x39, 0x65, 0x07, 0xd5, 0xd3, 0x1f, 0x77,
-	0x2b, 0xbe, 0x86, 0x9b, 0xaf, 0x41, 0x3d, 0x1b, 0x54, 0x5a, 0xd5, 0xd6, 0x7a, 0xbb, 0xd1, 0xb9,
-	0x07, 0x0b, 0x3b, 0x59, 0x14, 0x05, 0x5f, 0x6a, 0xac, 0xee, 0xf2, 0xbb, 0xd6, 0x6c, 0x81, 0x06,
-	0xc1, 0x7c, 0xc4, 0x03, 0xa4, 0x28, 0x67, 0xd6, 0x46, 0xcb, 0x68, 0x57, 0xfd, 0xc5, 0xa3, 0x78,
-	0xfa, 0x90, 0x33, 0x3a, 0xc4, 0xc2, 0xaa, 0xa5, 0xd3, 0xeb, 0xd0, 0xb5, 0x81, 0x55, 0xde, 0x82,
-	0x8f, 0x65, 0xc4, 0x99, 0xc4, 0xee, 0x37, 0x03, 0x5c, 0xcb, 0x92, 0x87, 0x23, 0x8a, 0x99, 0xfa,
-	0xbf, 0x0b, 0x2a, 0xe9, 0xaa, 0x2e, 0xeb, 0x6a, 0x82, 0x8d, 0x89, 0xf8, 0x10, 0x0d, 0x13, 0xcd,
-	0x75, 0x3f, 0x0d, 0x5c, 0x0b, 0xdc, 0x2c, 0x8e, 0x9d, 0x2b, 0x7a, 0x03, 0xcc, 0xae, 0x24, 0xef,
-	0x98, 0xfc, 0xd7, 0x5b, 0x77, 0xef, 0x00, 0x7b, 0xb9, 0x53, 0xce, 0xf3, 0x2a, 0xf1, 0x96, 0xce,
-	0xfe, 0xfd, 0xea, 0xf4, 0xed, 0x14, 0xfa, 0xe4, 0x1c, 0x5f, 0x0d, 0xb0, 0xd5, 0x95, 0xc4, 0x8f,
-	0x3d, 0x7d, 0x84, 0xa6, 0xe1, 0xe5, 0x1c, 0xcf, 0x40, 0x2d, 0x71, 0xbf, 0xb4, 0xd6, 0x12, 0xa7,
-	0xb9, 0xf0, 0xbc, 0xaf, 0x0f, 0x26, 0xdd, 0x7c, 0xfc, 0x71, 0x8c, 0xa5, 0xf2, 0x75, 0x85, 0xb9,
-	0x07, 0xae, 0xf7, 0xb1, 0x0c, 0x04, 0x8d, 0xe2, 0xa5, 0x1f, 0xab, 0x18, 0x99, 0xdc, 0x65, 0xdd,
-	0x5f, 0x4e, 0xb8, 0x3b, 0xe0, 0x56, 0x69, 0xac, 0x6c, 0xe4, 0xce, 0xe7, 0x2a, 0x58, 0xef, 0x4a,
-	0x62, 0x12, 0xb0, 0x59, 0xfc, 0xee, 0x76, 0xcf, 0x9f, 0xa6, 0xec, 0x4c, 0x1b, 0xae, 0x86, 0xcb,
-	0x08, 0x4d, 0x04, 0x1a, 0x8b, 0xee, 0xbd, 0x7f, 0x79, 0x79, 0x8a, 0xb2, 0xf7, 0x56, 0x41, 0xe5,
-	0x14, 0x21, 0xd8, 0x2a, 0xfb, 0xa9, 0x7d, 0x61, 0x83, 0x12, 0xd2, 0x7e, 0xbc, 0x2a, 0x32, 0xa7,
-	0x23, 0x60, 0xb3, 0x68, 0xab, 0xdd, 0x3f, 0xb5, 0xd0, 0xaa, 0xe0, 0x6a, 0xb8, 0x9c, 0xa8, 0x0f,
-	0xae, 0x16, 0xac, 0xf5, 0xe0, 0xc2, 0xfa, 0x45, 0x98, 0xfd, 0x68, 0x25, 0x58, 0xc6, 0x72, 0xf0,
-	0xfc, 0x74, 0xe6, 0x18, 0x67, 0x33, 0xc7, 0xf8, 0x39, 0x73, 0x8c, 0x2f, 0x73, 0xa7, 0x72, 0x36,
-	0x77, 0x2a, 0xdf, 0xe7, 0x4e, 0xe5, 0xfd, 0x43, 0x42, 0xd5, 0x60, 0xdc, 0x83, 0x01, 0x0f, 0x3d,
-	0xdd, 0x32, 0x79, 0x7a, 0x27, 0x5e, 0xfe, 0x4f, 0x32, 0x8d, 0xb0, 0xec, 0xd5, 0x92, 0xdf, 0xf3,
-	0x27, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x92, 0x41, 0xe8, 0x61, 0x06, 0x00, 0x00,
+	// 749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0x8e, 0x9b, 0x34, 0x6f, 0x33, 0x79, 0xfb, 0xe5, 0x56, 0xef, 0xeb, 0xba, 0xc8, 0x8d, 0x0c,
+	0xb4, 0x39, 0xb4, 0x6b, 0x5a, 0x90, 0x90, 0xb8, 0xd1, 0xf2, 0x29, 0x14, 0x51, 0xb9, 0x82, 0x03,
+	0x1c, 0xd0, 0x26, 0xd9, 0x3a, 0xa6, 0x89, 0xd7, 0x78, 0xb7, 0x51, 0x73, 0xe7, 0x07, 0x70, 0xe1,
+	0xa7, 0xf0, 0x1f, 0x7a, 0xec, 0x91, 0x03, 0x42, 0xa8, 0x3d, 0xf3, 0x1f, 0x50, 0xec, 0xf5, 0xd6,
+	0x4e, 0xd2, 0xe0, 0xb6, 0x88, 0x93, 0xbd, 0x9e, 0x67, 0xe6, 0x99, 0x79, 0x76, 0x66, 0x64, 0x98,
+	0xf3, 0xb1, 0x1b, 0xb8, 0x9e, 0x63, 0xf1, 0x23, 0xe4, 0x07, 0x94, 0x53, 0x75, 0xb1, 0x8d, 0xbb,
+	0xd8, 0x23, 0x1c, 0xf5, 0x9f, 0x48, 0x98, 0x75, 0xa3, 0x41, 0x59, 0x87, 0x32, 0xab, 0x8e, 0x19,
+	0xb1, 0xba, 0x9b, 0x75, 0xc2, 0xf1, 0xa6, 0xd5, 0xa0, 0xae, 0x17, 0x79, 0xe9, 0x8b, 0x0e, 0x75,
+	0x68, 0xf8, 0x6a, 0xf5, 0xdf, 0xc4, 0xd7, 0x65, 0xe2, 0xd3, 0x46, 0x8b, 0x71, 0x1a, 0x60, 0x87,
+	0x58, 0xc4, 0x6b, 0xfa, 0xd4, 0xf5, 0xb8, 0x30, 0x2e, 0xc4, 0xd4, 0x01, 0x69, 0xe3, 0x5e, 0xf4,
+	0xd1, 0xfc, 0x38, 0x01, 0x73, 0x35, 0xe6, 0xec, 0x71, 0x7c, 0x40, 0x76, 0x03, 0xda, 0x75, 0x9b,
+	0x24, 0x50, 0x35, 0xf8, 0xa7, 0x11, 0x10, 0xcc, 0x69, 0xa0, 0x29, 0x15, 0xa5, 0x5a, 0xb2, 0xe3,
+	0x63, 0x68, 0x69, 0x61, 0xd7, 0x7b, 0xfe, 0x48, 0x9b, 0x10, 0x96, 0xe8, 0xa8, 0xde, 0x87, 0x22,
+	0xee, 0xd0, 0x43, 0x8f, 0x6b, 0xf9, 0x8a, 0x52, 0x2d, 0x6f, 0x2d, 0xa1, 0xa8, 0x02, 0xd4, 0xaf,
+	0x00, 0x89, 0x0a, 0xd0, 0x0e, 0x75, 0xbd, 0xed, 0xc2, 0xf1, 0xf7, 0x95, 0x9c, 0x2d, 0xe0, 0xea,
+	0x53, 0x28, 0xc5, 0x89, 0x32, 0xad, 0x50, 0xc9, 0x57, 0xcb, 0x5b, 0x37, 0x51, 0x4a, 0x93, 0x64,
+	0x51, 0xe8, 0xb1, 0xc0, 0x8a, 0x28, 0xe7, 0xbe, 0x6a, 0x05, 0xca, 0x0e, 0xa1, 0x6d, 0xda, 0xc0,
+	0xdc, 0xa5, 0x9e, 0x36, 0x59, 0x51, 0xaa, 0x05, 0x3b, 0xf9, 0xa9, 0x9f, 0x7d, 0x87, 0x7a, 0xee,
+	0x01, 0x09, 0xb4, 0x62, 0x94, 0xbd, 0x38, 0x9a, 0x3a, 0x68, 0x83, 0x2a, 0xd8, 0x84, 0xf9, 0xd4,
+	0x63, 0xc4, 0xfc, 0xa2, 0xc0, 0x4c, 0x6c, 0xdc, 0x69, 0xbb, 0xc4, 0xe3, 0x7f, 0x57, 0xa0, 0x81,
+	0xba, 0x0a, 0xc3, 0x75, 0x2d, 0xc2, 0x64, 0x37, 0xd8, 0xf7, 0x0f, 0xc2, 0x9a, 0x4b, 0x76, 0x74,
+	0x30, 0x35, 0xf8, 0x2f, 0x9d, 0xb6, 0xac, 0xe8, 0x19, 0xa8, 0x35, 0xe6, 0xbc, 0xf2, 0xd8, 0x75,
+	0x6f, 0xdd, 0xbc, 0x01, 0xfa, 0x70, 0x24, 0xc9, 0xf3, 0x04, 0xe6, 0xce, 0xad, 0x57, 0x97, 0x4e,
+	0xdc, 0x4e, 0x2a, 0x8e, 0xe4, 0xf8, 0xac, 0xc0, 0x6c, 0x8d, 0x39, 0x76, 0xbf, 0xa7, 0x77, 0x71,
+	0xaf, 0x33, 0x9e, 0xe3, 0x01, 0x14, 0xc3, 0xee, 0x67, 0xda, 0x44, 0xd8, 0x69, 0x26, 0x1a, 0x35,
+	0x7d, 0x28, 0x8c, 0x66, 0x93, 0x0f, 0x87, 0x84, 0x71, 0x5b, 0x78, 0xa8, 0xeb, 0x30, 0xdf, 0x24,
+	0xac, 0x11, 0xb8, 0x7e, 0x5f, 0xf4, 0x3d, 0xde, 0x47, 0x86, 0x77, 0x59, 0xb2, 0x87, 0x0d, 0xe6,
+	0x12, 0xfc, 0x3f, 0x90, 0x96, 0x4c, 0xb9, 0x15, 0x5d, 0x0c, 0xe1, 0xb1, 0x60, 0x2f, 0x7d, 0x12,
+	0x48, 0x09, 0x2e, 0xdb, 0x57, 0x3a, 0x4c, 0x51, 0xe1, 0x2f, 0xb2, 0x91, 0x67, 0xb3, 0x02, 0xc6,
+	0x68, 0x26, 0x99, 0xcb, 0x4f, 0x05, 0xe6, 0x6b, 0xcc, 0xa9, 0xd1, 0xa6, 0xbb, 0xdf, 0xbb, 0xd6,
+	0x02, 0x48, 0xcd, 0x71, 0xfe, 0xcf, 0xcd, 0x71, 0x61, 0xec, 0x1c, 0x4f, 0xa6, 0xe6, 0x58, 0x5d,
+	0x81, 0x32, 0x23, 0xfc, 0x5d, 0x72, 0xca, 0xa7, 0x6c, 0x60, 0x84, 0xd7, 0xc4, 0xa0, 0x2f, 0xc3,
+	0xd2, 0x50, 0xb9, 0x52, 0x8c, 0xb7, 0x51, 0x2b, 0x51, 0x8e, 0x39, 0x79, 0x1d, 0xec, 0xbf, 0x20,
+	0xbd, 0x2b, 0x29, 0x21, 0xc7, 0x31, 0x9f, 0x1c, 0x47, 0xd1, 0x10, 0x89, 0xe0, 0x31, 0xef, 0xd6,
+	0xb7, 0x22, 0xe4, 0x6b, 0xcc, 0x51, 0x1d, 0x98, 0x4e, 0x2f, 0xe2, 0xd5, 0xd1, 0xed, 0x39, 0xb8,
+	0xaa, 0x74, 0x94, 0x0d, 0x17, 0x13, 0xaa, 0x18, 0xca, 0xc9, 0x75, 0x76, 0x6b, 0xbc, 0x7b, 0x84,
+	0xd2, 0xd7, 0xb3, 0xa0, 0x24, 0x45, 0x07, 0x66, 0x07, 0x17, 0x4c, 0xf5, 0xc2, 0x00, 0x03, 0x48,
+	0xfd, 0x4e, 0x56, 0xa4, 0xa4, 0x73, 0x60, 0x3a, 0xbd, 0x67, 0x56, 0x7f, 0x17, 0x42, 0x54, 0x85,
+	0xb2, 0xe1, 0x24, 0x51, 0x13, 0xfe, 0x4d, 0xed, 0x9a, 0xdb, 0x17, 0xfa, 0x27, 0x61, 0xfa, 0x46,
+	0x26, 0x98, 0x64, 0xe9, 0xc1, 0xc2, 0xa8, 0xfd, 0x30, 0xe6, 0x0a, 0x86, 0xd1, 0xfa, 0xbd, 0xcb,
+	0xa0, 0x25, 0xf5, 0x7b, 0x98, 0x19, 0xd8, 0x06, 0x6b, 0x17, 0xc6, 0x49, 0x03, 0x75, 0x2b, 0x23,
+	0x30, 0x25, 0x66, 0x72, 0xda, 0xc6, 0x88, 0x99, 0x80, 0xe9, 0x1b, 0x99, 0x60, 0x31, 0xcb, 0xf6,
+	0xc3, 0xe3, 0x53, 0x43, 0x39, 0x39, 0x35, 0x94, 0x1f, 0xa7, 0x86, 0xf2, 0xe9, 0xcc, 0xc8, 0x9d,
+	0x9c, 0x19, 0xb9, 0xaf, 0x67, 0x46, 0xee, 0xcd, 0x9a, 0xe3, 0xf2, 0xd6, 0x61, 0x1d, 0x35, 0x68,
+	0xc7, 0x12, 0x21, 0xc3, 0xa7, 0x75, 0x64, 0xc9, 0xff, 0xb4, 0x9e, 0x4f, 0x58, 0xbd, 0x18, 0xfe,
+	0x2d, 0xdd, 0xfd, 0x35, 0x00, 0xfd, 0x7d, 0x3b, 0xac, 0xbf, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -607,6 +935,9 @@ type MsgClient interface {
 	UnstakeProvider(ctx context.Context, in *MsgUnstakeProvider, opts ...grpc.CallOption) (*MsgUnstakeProviderResponse, error)
 	UnstakeClient(ctx context.Context, in *MsgUnstakeClient, opts ...grpc.CallOption) (*MsgUnstakeClientResponse, error)
 	RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error)
+	SetProviderOperator(ctx context.Context, in *MsgSetProviderOperator, opts ...grpc.CallOption) (*MsgSetProviderOperatorResponse, error)
+	ModifyProvider(ctx context.Context, in *MsgModifyProvider, opts ...grpc.CallOption) (*MsgModifyProviderResponse, error)
+	RotateVrfKey(ctx context.Context, in *MsgRotateVrfKey, opts ...grpc.CallOption) (*MsgRotateVrfKeyResponse, error)
 }
 
 type msgClient struct {
@@ -662,6 +993,33 @@ func (c *msgClient) RelayPayment(ctx context.Context, in *MsgRelayPayment, opts
 	return out, nil
 }
 
+func (c *msgClient) SetProviderOperator(ctx context.Context, in *MsgSetProviderOperator, opts ...grpc.CallOption) (*MsgSetProviderOperatorResponse, error) {
+	out := new(MsgSetProviderOperatorResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/SetProviderOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ModifyProvider(ctx context.Context, in *MsgModifyProvider, opts ...grpc.CallOption) (*MsgModifyProviderResponse, error) {
+	out := new(MsgModifyProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/ModifyProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateVrfKey(ctx context.Context, in *MsgRotateVrfKey, opts ...grpc.CallOption) (*MsgRotateVrfKeyResponse, error) {
+	out := new(MsgRotateVrfKeyResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/RotateVrfKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	StakeProvider(context.Context, *MsgStakeProvider) (*MsgStakeProviderResponse, error)
@@ -669,6 +1027,9 @@ type MsgServer interface {
 	UnstakeProvider(context.Context, *MsgUnstakeProvider) (*MsgUnstakeProviderResponse, error)
 	UnstakeClient(context.Context, *MsgUnstakeClient) (*MsgUnstakeClientResponse, error)
 	RelayPayment(context.Context, *MsgRelayPayment) (*MsgRelayPaymentResponse, error)
+	SetProviderOperator(context.Context, *MsgSetProviderOperator) (*MsgSetProviderOperatorResponse, error)
+	ModifyProvider(context.Context, *MsgModifyProvider) (*MsgModifyProviderResponse, error)
+	RotateVrfKey(context.Context, *MsgRotateVrfKey) (*MsgRotateVrfKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -690,6 +1051,15 @@ func (*UnimplementedMsgServer) UnstakeClient(ctx context.Context, req *MsgUnstak
 func (*UnimplementedMsgServer) RelayPayment(ctx context.Context, req *MsgRelayPayment) (*MsgRelayPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RelayPayment not implemented")
 }
+func (*UnimplementedMsgServer) SetProviderOperator(ctx context.Context, req *MsgSetProviderOperator) (*MsgSetProviderOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderOperator not implemented")
+}
+func (*UnimplementedMsgServer) ModifyProvider(ctx context.Context, req *MsgModifyProvider) (*MsgModifyProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyProvider not implemented")
+}
+func (*UnimplementedMsgServer) RotateVrfKey(ctx context.Context, req *MsgRotateVrfKey) (*MsgRotateVrfKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateVrfKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -785,43 +1155,109 @@ func _Msg_RelayPayment_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lavanet.lava.pairing.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StakeProvider",
-			Handler:    _Msg_StakeProvider_Handler,
-		},
-		{
-			MethodName: "StakeClient",
-			Handler:    _Msg_StakeClient_Handler,
-		},
-		{
-			MethodName: "UnstakeProvider",
-			Handler:    _Msg_UnstakeProvider_Handler,
-		},
-		{
-			MethodName: "UnstakeClient",
-			Handler:    _Msg_UnstakeClient_Handler,
-		},
-		{
-			MethodName: "RelayPayment",
-			Handler:    _Msg_RelayPayment_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pairing/tx.proto",
-}
-
-func (m *MsgStakeProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetProviderOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProviderOperator)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetProviderOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/SetProviderOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProviderOperator(ctx, req.(*MsgSetProviderOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModifyProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/ModifyProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyProvider(ctx, req.(*MsgModifyProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateVrfKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateVrfKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateVrfKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/RotateVrfKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateVrfKey(ctx, req.(*MsgRotateVrfKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StakeProvider",
+			Handler:    _Msg_StakeProvider_Handler,
+		},
+		{
+			MethodName: "StakeClient",
+			Handler:    _Msg_StakeClient_Handler,
+		},
+		{
+			MethodName: "UnstakeProvider",
+			Handler:    _Msg_UnstakeProvider_Handler,
+		},
+		{
+			MethodName: "UnstakeClient",
+			Handler:    _Msg_UnstakeClient_Handler,
+		},
+		{
+			MethodName: "RelayPayment",
+			Handler:    _Msg_RelayPayment_Handler,
+		},
+		{
+			MethodName: "SetProviderOperator",
+			Handler:    _Msg_SetProviderOperator_Handler,
+		},
+		{
+			MethodName: "ModifyProvider",
+			Handler:    _Msg_ModifyProvider_Handler,
+		},
+		{
+			MethodName: "RotateVrfKey",
+			Handler:    _Msg_RotateVrfKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pairing/tx.proto",
+}
+
+func (m *MsgStakeProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *MsgStakeProvider) MarshalTo(dAtA []byte) (int, error) {
@@ -1186,184 +1622,1046 @@ func (m *MsgRelayPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgStakeProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Endpoints) > 0 {
-		for _, e := range m.Endpoints {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.Geolocation != 0 {
-		n += 1 + sovTx(uint64(m.Geolocation))
-	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetProviderOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgStakeProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetProviderOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStakeClient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetProviderOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Geolocation != 0 {
-		n += 1 + sovTx(uint64(m.Geolocation))
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Vrfpk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetProviderOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUnstakeProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetProviderOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnstakeProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetProviderOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakeClient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgModifyProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUnstakeClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgModifyProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRelayPayment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgModifyProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Relays) > 0 {
-		for _, e := range m.Relays {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.SetMoniker {
+		i--
+		if m.SetMoniker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Geolocation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Geolocation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateVrfKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateVrfKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateVrfKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vrfpk) > 0 {
+		i -= len(m.Vrfpk)
+		copy(dAtA[i:], m.Vrfpk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vrfpk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateVrfKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateVrfKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateVrfKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgStakeProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStakeProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStakeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStakeClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstakeProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.DescriptionString)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	return n
 }
 
-func (m *MsgRelayPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnstakeProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstakeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnstakeClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRelayPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Relays) > 0 {
+		for _, e := range m.Relays {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.DescriptionString)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRelayPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetProviderOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetProviderOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModifyProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Geolocation != 0 {
+		n += 1 + sovTx(uint64(m.Geolocation))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SetMoniker {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgModifyProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateVrfKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Vrfpk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateVrfKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, types1.Endpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1386,10 +2684,10 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1456,44 +2754,111 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1503,48 +2868,27 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoints = append(m.Endpoints, types1.Endpoint{})
-			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
-			}
-			m.Geolocation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Geolocation |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1572,7 +2916,7 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1595,7 +2939,7 @@ func (m *MsgStakeProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1618,10 +2962,10 @@ func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1645,7 +2989,7 @@ func (m *MsgStakeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
+func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1668,10 +3012,10 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRelayPayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRelayPayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1708,39 +3052,7 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relays", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1767,32 +3079,14 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Relays = append(m.Relays, &RelayRequest{})
+			if err := m.Relays[len(m.Relays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
-			}
-			m.Geolocation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Geolocation |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionString", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1820,7 +3114,7 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vrfpk = string(dAtA[iNdEx:postIndex])
+			m.DescriptionString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1843,7 +3137,7 @@ func (m *MsgStakeClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1866,10 +3160,10 @@ func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRelayPaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRelayPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1893,7 +3187,7 @@ func (m *MsgStakeClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1916,10 +3210,10 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1986,6 +3280,38 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2007,7 +3333,7 @@ func (m *MsgUnstakeProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2030,10 +3356,10 @@ func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2057,7 +3383,7 @@ func (m *MsgUnstakeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2080,10 +3406,10 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2150,6 +3476,111 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, types1.Endpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Geolocation", wireType)
+			}
+			m.Geolocation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Geolocation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetMoniker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetMoniker = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2171,7 +3602,7 @@ func (m *MsgUnstakeClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2194,10 +3625,10 @@ func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2221,7 +3652,7 @@ func (m *MsgUnstakeClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateVrfKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2244,10 +3675,10 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayPayment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateVrfKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateVrfKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2284,9 +3715,9 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2296,29 +3727,27 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relays = append(m.Relays, &RelayRequest{})
-			if err := m.Relays[len(m.Relays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vrfpk", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2346,7 +3775,7 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DescriptionString = string(dAtA[iNdEx:postIndex])
+			m.Vrfpk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2369,7 +3798,7 @@ func (m *MsgRelayPayment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateVrfKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2392,10 +3821,10 @@ func (m *MsgRelayPaymentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayPaymentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateVrfKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateVrfKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,1635 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pairing/txsender.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types2 "github.com/tendermint/tendermint/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TxSendError_Kind int32
+
+const (
+	TxSendError_FAILED     TxSendError_Kind = 0
+	TxSendError_SIMULATION TxSendError_Kind = 1
+	TxSendError_TX         TxSendError_Kind = 2
+	TxSendError_GAS_LIMIT  TxSendError_Kind = 3
+	TxSendError_CHECK      TxSendError_Kind = 4
+)
+
+var TxSendError_Kind_name = map[int32]string{
+	0: "FAILED",
+	1: "SIMULATION",
+	2: "TX",
+	3: "GAS_LIMIT",
+	4: "CHECK",
+}
+
+var TxSendError_Kind_value = map[string]int32{
+	"FAILED":     0,
+	"SIMULATION": 1,
+	"TX":         2,
+	"GAS_LIMIT":  3,
+	"CHECK":      4,
+}
+
+func (x TxSendError_Kind) String() string {
+	return proto.EnumName(TxSendError_Kind_name, int32(x))
+}
+
+func (TxSendError_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d17fd4a5f11d80d8, []int{4, 0}
+}
+
+type TxSenderAccount struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *TxSenderAccount) Reset()         { *m = TxSenderAccount{} }
+func (m *TxSenderAccount) String() string { return proto.CompactTextString(m) }
+func (*TxSenderAccount) ProtoMessage()    {}
+func (*TxSenderAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d17fd4a5f11d80d8, []int{0}
+}
+func (m *TxSenderAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSenderAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSenderAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxSenderAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSenderAccount.Merge(m, src)
+}
+func (m *TxSenderAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSenderAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSenderAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSenderAccount proto.InternalMessageInfo
+
+func (m *TxSenderAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type TxSendRequest struct {
+	Msgs       []*types.Any `protobuf:"bytes,1,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Check      bool         `protobuf:"varint,2,opt,name=check,proto3" json:"check,omitempty"`
+	CheckError string       `protobuf:"bytes,3,opt,name=check_error,json=checkError,proto3" json:"check_error,omitempty"`
+}
+
+func (m *TxSendRequest) Reset()         { *m = TxSendRequest{} }
+func (m *TxSendRequest) String() string { return proto.CompactTextString(m) }
+func (*TxSendRequest) ProtoMessage()    {}
+func (*TxSendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d17fd4a5f11d80d8, []int{1}
+}
+func (m *TxSendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxSendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSendRequest.Merge(m, src)
+}
+func (m *TxSendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSendRequest proto.InternalMessageInfo
+
+func (m *TxSendRequest) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *TxSendRequest) GetCheck() bool {
+	if m != nil {
+		return m.Check
+	}
+	return false
+}
+
+func (m *TxSendRequest) GetCheckError() string {
+	if m != nil {
+		return m.CheckError
+	}
+	return ""
+}
+
+type TxSendReply struct {
+	Simulation *TxSimulation      `protobuf:"bytes,1,opt,name=simulation,proto3" json:"simulation,omitempty"`
+	Response   *types1.TxResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Error      *TxSendError       `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *TxSendReply) Reset()         { *m = TxSendReply{} }
+func (m *TxSendReply) String() string { return proto.CompactTextString(m) }
+func (*TxSendReply) ProtoMessage()    {}
+func (*TxSendReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d17fd4a5f11d80d8, []int{2}
+}
+func (m *TxSendReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSendReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSendReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxSendReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSendReply.Merge(m, src)
+}
+func (m *TxSendReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSendReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSendReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSendReply proto.InternalMessageInfo
+
+func (m *TxSendReply) GetSimulation() *TxSimulation {
+	if m != nil {
+		return m.Simulation
+	}
+	return nil
+}
+
+func (m *TxSendReply) GetResponse() *types1.TxResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *TxSendReply) GetError() *TxSendError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type TxSimulation struct {
+	Gas    uint64                                      `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	Fees   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fees"`
+	Events []types2.Event                              `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
+}
+
+func (m *TxSimulation) Reset()         { *m = TxSimulation{} }
+func (m *TxSimulation) String() string { return proto.CompactTextString(m) }
+func (*TxSimulation) ProtoMessage()    {}
+func (*TxSimulation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d17fd4a5f11d80d8, []int{3}
+}
+func (m *TxSimulation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSimulation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSimulation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxSimulation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSimulation.Merge(m, src)
+}
+func (m *TxSimulation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSimulation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSimulation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSimulation proto.InternalMessageInfo
+
+func (m *TxSimulation) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *TxSimulation) GetFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *TxSimulation) GetEvents() []types2.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type TxSendError struct {
+	Kind    TxSendError_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=lavanet.lava.pairing.TxSendError_Kind" json:"kind,omitempty"`
+	Message string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Gas     uint64           `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *TxSendError) Reset()         { *m = TxSendError{} }
+func (m *TxSendError) String() string { return proto.CompactTextString(m) }
+func (*TxSendError) ProtoMessage()    {}
+func (*TxSendError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d17fd4a5f11d80d8, []int{4}
+}
+func (m *TxSendError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSendError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSendError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxSendError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSendError.Merge(m, src)
+}
+func (m *TxSendError) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSendError) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSendError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSendError proto.InternalMessageInfo
+
+func (m *TxSendError) GetKind() TxSendError_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return TxSendError_FAILED
+}
+
+func (m *TxSendError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *TxSendError) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("lavanet.lava.pairing.TxSendError_Kind", TxSendError_Kind_name, TxSendError_Kind_value)
+	proto.RegisterType((*TxSenderAccount)(nil), "lavanet.lava.pairing.TxSenderAccount")
+	proto.RegisterType((*TxSendRequest)(nil), "lavanet.lava.pairing.TxSendRequest")
+	proto.RegisterType((*TxSendReply)(nil), "lavanet.lava.pairing.TxSendReply")
+	proto.RegisterType((*TxSimulation)(nil), "lavanet.lava.pairing.TxSimulation")
+	proto.RegisterType((*TxSendError)(nil), "lavanet.lava.pairing.TxSendError")
+}
+
+func init() { proto.RegisterFile("pairing/txsender.proto", fileDescriptor_d17fd4a5f11d80d8) }
+
+var fileDescriptor_d17fd4a5f11d80d8 = []byte{
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xb6, 0x13, 0x37, 0x6d, 0x26, 0xb4, 0x44, 0xab, 0xa8, 0x0a, 0x01, 0xb9, 0xad, 0xcb, 0x4f,
+	0xa4, 0x8a, 0x35, 0x4d, 0x91, 0x90, 0x38, 0x91, 0xb4, 0x01, 0x42, 0x5b, 0x90, 0x1c, 0x23, 0x21,
+	0x2e, 0x95, 0x63, 0x6f, 0x5d, 0xab, 0xf1, 0xae, 0xf1, 0x3a, 0x55, 0xf2, 0x16, 0x3c, 0x07, 0xe2,
+	0x0d, 0x10, 0xf7, 0x1e, 0x38, 0xf4, 0xc8, 0x09, 0x50, 0xfb, 0x22, 0x68, 0xd7, 0x76, 0x1a, 0xfe,
+	0xca, 0x69, 0x77, 0x76, 0xbe, 0xd9, 0xf9, 0xe6, 0x9b, 0xd9, 0x85, 0xe5, 0xc8, 0x09, 0xe2, 0x80,
+	0xfa, 0x66, 0x32, 0xe6, 0x84, 0x7a, 0x24, 0xc6, 0x51, 0xcc, 0x12, 0x86, 0x6a, 0x43, 0xe7, 0xc4,
+	0xa1, 0x24, 0xc1, 0x62, 0xc5, 0x19, 0xa8, 0x51, 0xf3, 0x99, 0xcf, 0x24, 0xc0, 0x14, 0xbb, 0x14,
+	0xdb, 0xb8, 0xe1, 0x33, 0xe6, 0x0f, 0x89, 0x29, 0xad, 0xc1, 0xe8, 0xd0, 0x74, 0xe8, 0x24, 0x73,
+	0xdd, 0xfc, 0xdd, 0x45, 0xc2, 0x28, 0xc9, 0x9d, 0xeb, 0x2e, 0xe3, 0x21, 0xe3, 0xe6, 0xc0, 0xe1,
+	0xc4, 0x74, 0x06, 0x6e, 0x60, 0x9e, 0x6c, 0x0e, 0x48, 0xe2, 0x6c, 0x4a, 0x23, 0x03, 0xe9, 0xb3,
+	0xa0, 0xdc, 0xef, 0xb2, 0x80, 0xe6, 0x19, 0x12, 0x49, 0x3b, 0x0c, 0x68, 0x92, 0xde, 0x91, 0x4c,
+	0x22, 0xc2, 0x53, 0xa7, 0xb1, 0x01, 0xd7, 0xed, 0x71, 0x5f, 0x02, 0xda, 0xae, 0xcb, 0x46, 0x34,
+	0x41, 0x75, 0x98, 0x77, 0x3c, 0x2f, 0x26, 0x9c, 0xd7, 0xd5, 0x55, 0xb5, 0x59, 0xb6, 0x72, 0xd3,
+	0x88, 0x60, 0x31, 0x05, 0x5b, 0xe4, 0xdd, 0x88, 0xf0, 0x04, 0x35, 0x41, 0x0b, 0xb9, 0x2f, 0x70,
+	0xc5, 0x66, 0xa5, 0x55, 0xc3, 0x69, 0x2d, 0x38, 0xaf, 0x05, 0xb7, 0xe9, 0xc4, 0x92, 0x08, 0x54,
+	0x83, 0x39, 0xf7, 0x88, 0xb8, 0xc7, 0xf5, 0xc2, 0xaa, 0xda, 0x5c, 0xb0, 0x52, 0x03, 0xad, 0x40,
+	0x45, 0x6e, 0x0e, 0x48, 0x1c, 0xb3, 0xb8, 0x5e, 0x94, 0xe9, 0x40, 0x1e, 0x75, 0xc5, 0x89, 0xf1,
+	0x45, 0x85, 0x4a, 0x9e, 0x32, 0x1a, 0x4e, 0x50, 0x07, 0x80, 0x07, 0xe1, 0x68, 0xe8, 0x24, 0x01,
+	0xa3, 0x92, 0x5e, 0xa5, 0x65, 0xe0, 0xbf, 0x75, 0x02, 0xdb, 0xe3, 0xfe, 0x14, 0x69, 0xcd, 0x44,
+	0xa1, 0x27, 0xb0, 0x10, 0x13, 0x1e, 0x31, 0xca, 0x89, 0x64, 0x53, 0x69, 0xdd, 0xc6, 0xa9, 0x84,
+	0x58, 0x48, 0x88, 0xa5, 0xb4, 0x99, 0x8e, 0xd8, 0x1e, 0x5b, 0x19, 0xd6, 0x9a, 0x46, 0xa1, 0x47,
+	0x30, 0x77, 0x49, 0xb8, 0xd2, 0x5a, 0xfb, 0x27, 0x01, 0x42, 0x3d, 0x59, 0x87, 0x95, 0xe2, 0x8d,
+	0xcf, 0x2a, 0x5c, 0x9b, 0xe5, 0x85, 0xaa, 0x50, 0xf4, 0x9d, 0x54, 0x67, 0xcd, 0x12, 0x5b, 0x44,
+	0x40, 0x3b, 0x24, 0x84, 0xd7, 0x0b, 0x52, 0xd2, 0x5b, 0xbf, 0x30, 0xcb, 0x49, 0xed, 0x10, 0x77,
+	0x9b, 0x05, 0xb4, 0xb3, 0x75, 0xfa, 0x6d, 0x45, 0xf9, 0xf0, 0x7d, 0x65, 0xc3, 0x0f, 0x92, 0xa3,
+	0xd1, 0x00, 0xbb, 0x2c, 0x34, 0xb3, 0x61, 0x48, 0x97, 0xfb, 0xdc, 0x3b, 0xce, 0xda, 0x9d, 0xc5,
+	0x70, 0x4b, 0x5e, 0x8f, 0x1e, 0x42, 0x89, 0x9c, 0x10, 0x9a, 0xf0, 0x7a, 0x51, 0x26, 0x5a, 0xc6,
+	0x97, 0x53, 0x92, 0x2a, 0xd0, 0x15, 0xee, 0x8e, 0x26, 0x52, 0x58, 0x19, 0xd6, 0xf8, 0x34, 0x6d,
+	0x87, 0x2c, 0x0b, 0x3d, 0x06, 0xed, 0x38, 0xa0, 0x9e, 0xe4, 0xbf, 0xd4, 0xba, 0xfb, 0x5f, 0x1d,
+	0xf0, 0x6e, 0x40, 0x3d, 0x4b, 0xc6, 0x88, 0x31, 0x0b, 0x09, 0xe7, 0x8e, 0x9f, 0x76, 0xa1, 0x6c,
+	0xe5, 0x66, 0x2e, 0x4a, 0x71, 0x2a, 0x8a, 0xb1, 0x03, 0x9a, 0x88, 0x44, 0x00, 0xa5, 0xa7, 0xed,
+	0xde, 0x5e, 0x77, 0xa7, 0xaa, 0xa0, 0x25, 0x80, 0x7e, 0x6f, 0xff, 0xf5, 0x5e, 0xdb, 0xee, 0xbd,
+	0x7a, 0x59, 0x55, 0x51, 0x09, 0x0a, 0xf6, 0x9b, 0x6a, 0x01, 0x2d, 0x42, 0xf9, 0x59, 0xbb, 0x7f,
+	0xb0, 0xd7, 0xdb, 0xef, 0xd9, 0xd5, 0x22, 0x2a, 0xc3, 0xdc, 0xf6, 0xf3, 0xee, 0xf6, 0x6e, 0x55,
+	0x6b, 0x7d, 0x54, 0x61, 0x21, 0x1f, 0x76, 0xf4, 0x02, 0xe6, 0xf3, 0x81, 0x5f, 0xfe, 0x63, 0x6e,
+	0xbb, 0xe2, 0x0d, 0x36, 0xee, 0x5c, 0x55, 0xcf, 0xf4, 0xbd, 0x18, 0x0a, 0xb2, 0x41, 0x13, 0x47,
+	0x68, 0xfd, 0xaa, 0x80, 0xec, 0xcd, 0x34, 0xd6, 0xae, 0x06, 0x45, 0xc3, 0x89, 0xa1, 0x34, 0xd5,
+	0x07, 0x6a, 0xa7, 0x7d, 0x7a, 0xae, 0xab, 0x67, 0xe7, 0xba, 0xfa, 0xe3, 0x5c, 0x57, 0xdf, 0x5f,
+	0xe8, 0xca, 0xd9, 0x85, 0xae, 0x7c, 0xbd, 0xd0, 0x95, 0xb7, 0xf7, 0x66, 0xfa, 0x9d, 0x5d, 0x26,
+	0x57, 0x73, 0x6c, 0x4e, 0x3f, 0x2b, 0xd1, 0xf4, 0x41, 0x49, 0x56, 0xb4, 0xf5, 0x73, 0x00, 0x64,
+	0x0b, 0x98, 0xf4, 0xc4, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TxSenderClient is the client API for TxSender service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TxSenderClient interface {
+	Account(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TxSenderAccount, error)
+	// Send sends the messages of the first request in one transaction and replies with its result. when the request
+	// asks to check the transaction, its simulations are sent before the result, each answered with a request
+	Send(ctx context.Context, opts ...grpc.CallOption) (TxSender_SendClient, error)
+}
+
+type txSenderClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewTxSenderClient(cc grpc1.ClientConn) TxSenderClient {
+	return &txSenderClient{cc}
+}
+
+func (c *txSenderClient) Account(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TxSenderAccount, error) {
+	out := new(TxSenderAccount)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.TxSender/Account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txSenderClient) Send(ctx context.Context, opts ...grpc.CallOption) (TxSender_SendClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TxSender_serviceDesc.Streams[0], "/lavanet.lava.pairing.TxSender/Send", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &txSenderSendClient{stream}
+	return x, nil
+}
+
+type TxSender_SendClient interface {
+	Send(*TxSendRequest) error
+	Recv() (*TxSendReply, error)
+	grpc.ClientStream
+}
+
+type txSenderSendClient struct {
+	grpc.ClientStream
+}
+
+func (x *txSenderSendClient) Send(m *TxSendRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *txSenderSendClient) Recv() (*TxSendReply, error) {
+	m := new(TxSendReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TxSenderServer is the server API for TxSender service.
+type TxSenderServer interface {
+	Account(context.Context, *emptypb.Empty) (*TxSenderAccount, error)
+	// Send sends the messages of the first request in one transaction and replies with its result. when the request
+	// asks to check the transaction, its simulations are sent before the result, each answered with a request
+	Send(TxSender_SendServer) error
+}
+
+// UnimplementedTxSenderServer can be embedded to have forward compatible implementations.
+type UnimplementedTxSenderServer struct {
+}
+
+func (*UnimplementedTxSenderServer) Account(ctx context.Context, req *emptypb.Empty) (*TxSenderAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
+func (*UnimplementedTxSenderServer) Send(srv TxSender_SendServer) error {
+	return status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+
+func RegisterTxSenderServer(s grpc1.Server, srv TxSenderServer) {
+	s.RegisterService(&_TxSender_serviceDesc, srv)
+}
+
+func _TxSender_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxSenderServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.TxSender/Account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxSenderServer).Account(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxSender_Send_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TxSenderServer).Send(&txSenderSendServer{stream})
+}
+
+type TxSender_SendServer interface {
+	Send(*TxSendReply) error
+	Recv() (*TxSendRequest, error)
+	grpc.ServerStream
+}
+
+type txSenderSendServer struct {
+	grpc.ServerStream
+}
+
+func (x *txSenderSendServer) Send(m *TxSendReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *txSenderSendServer) Recv() (*TxSendRequest, error) {
+	m := new(TxSendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _TxSender_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.TxSender",
+	HandlerType: (*TxSenderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Account",
+			Handler:    _TxSender_Account_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Send",
+			Handler:       _TxSender_Send_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pairing/txsender.proto",
+}
+
+func (m *TxSenderAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSenderAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSenderAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTxsender(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxSendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CheckError) > 0 {
+		i -= len(m.CheckError)
+		copy(dAtA[i:], m.CheckError)
+		i = encodeVarintTxsender(dAtA, i, uint64(len(m.CheckError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Check {
+		i--
+		if m.Check {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxsender(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxSendReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSendReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSendReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxsender(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxsender(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Simulation != nil {
+		{
+			size, err := m.Simulation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTxsender(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxSimulation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSimulation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSimulation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxsender(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxsender(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Gas != 0 {
+		i = encodeVarintTxsender(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxSendError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSendError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSendError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintTxsender(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTxsender(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTxsender(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxsender(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxsender(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxSenderAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTxsender(uint64(l))
+	}
+	return n
+}
+
+func (m *TxSendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTxsender(uint64(l))
+		}
+	}
+	if m.Check {
+		n += 2
+	}
+	l = len(m.CheckError)
+	if l > 0 {
+		n += 1 + l + sovTxsender(uint64(l))
+	}
+	return n
+}
+
+func (m *TxSendReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Simulation != nil {
+		l = m.Simulation.Size()
+		n += 1 + l + sovTxsender(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovTxsender(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTxsender(uint64(l))
+	}
+	return n
+}
+
+func (m *TxSimulation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovTxsender(uint64(m.Gas))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovTxsender(uint64(l))
+		}
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovTxsender(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxSendError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovTxsender(uint64(m.Kind))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTxsender(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovTxsender(uint64(m.Gas))
+	}
+	return n
+}
+
+func sovTxsender(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxsender(x uint64) (n int) {
+	return sovTxsender(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TxSenderAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxsender
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxSenderAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxSenderAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxsender(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxSendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxsender
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxSendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxSendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Check = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxsender(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxSendReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxsender
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxSendReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxSendReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Simulation == nil {
+				m.Simulation = &TxSimulation{}
+			}
+			if err := m.Simulation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types1.TxResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &TxSendError{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxsender(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxSimulation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxsender
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxSimulation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxSimulation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types1.DecCoin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types2.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxsender(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxSendError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxsender
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxSendError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxSendError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= TxSendError_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxsender(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxsender
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxsender(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxsender
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxsender
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxsender
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxsender
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxsender
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxsender        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxsender          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxsender = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,6 +11,8 @@ const (
 	RelayPaymentEventName                      = "relay_payment"
 	UnresponsiveProviderUnstakeFailedEventName = "unresponsive_provider"
 	ProviderJailedEventName                    = "provider_jailed"
+	ProviderOperatorEventName                  = "provider_operator"
+	VrfKeyRotateEventName                      = "vrf_key_rotate"
 )
 
 func StakeNewEventName(isProvider bool) string {
@@ -0,0 +1,19 @@
+package types
+
+import "time"
+
+// IsBilled returns whether subscriptions are charged for as long as they stay open
+func (billing SubscriptionBilling) IsBilled() bool {
+	return billing.ComputeUnitsPerMessage > 0 || billing.ComputeUnitsPerPeriod > 0
+}
+
+// ComputeUnits returns the compute units a subscription accrued for the messages it streamed and the time it is open,
+// on top of the compute units of the subscribe relay
+func (billing SubscriptionBilling) ComputeUnits(messages uint64, openFor time.Duration) uint64 {
+	computeUnits := messages * billing.ComputeUnitsPerMessage
+	if billing.ComputeUnitsPerPeriod > 0 && billing.PeriodSeconds > 0 {
+		periods := uint64(openFor / (time.Duration(billing.PeriodSeconds) * time.Second))
+		computeUnits += periods * billing.ComputeUnitsPerPeriod
+	}
+	return computeUnits
+}
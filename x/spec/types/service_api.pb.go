@@ -23,6 +23,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type HEADER_TYPE int32
+
+const (
+	HEADER_TYPE_PASS_SEND  HEADER_TYPE = 0
+	HEADER_TYPE_PASS_REPLY HEADER_TYPE = 1
+	HEADER_TYPE_PASS_BOTH  HEADER_TYPE = 2
+	HEADER_TYPE_OVERWRITE  HEADER_TYPE = 3
+	HEADER_TYPE_STRIP      HEADER_TYPE = 4
+)
+
+var HEADER_TYPE_name = map[int32]string{
+	0: "PASS_SEND",
+	1: "PASS_REPLY",
+	2: "PASS_BOTH",
+	3: "OVERWRITE",
+	4: "STRIP",
+}
+
+var HEADER_TYPE_value = map[string]int32{
+	"PASS_SEND":  0,
+	"PASS_REPLY": 1,
+	"PASS_BOTH":  2,
+	"OVERWRITE":  3,
+	"STRIP":      4,
+}
+
+func (x HEADER_TYPE) String() string {
+	return proto.EnumName(HEADER_TYPE_name, int32(x))
+}
+
+func (HEADER_TYPE) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3323a3ad252c5ed4, []int{0}
+}
+
 type PARSER_FUNC int32
 
 const (
@@ -57,17 +91,19 @@ func (x PARSER_FUNC) String() string {
 }
 
 func (PARSER_FUNC) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3323a3ad252c5ed4, []int{0}
+	return fileDescriptor_3323a3ad252c5ed4, []int{1}
 }
 
 type ServiceApi struct {
-	Name          string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	BlockParsing  BlockParser    `protobuf:"bytes,2,opt,name=block_parsing,json=blockParsing,proto3" json:"block_parsing"`
-	ComputeUnits  uint64         `protobuf:"varint,3,opt,name=compute_units,json=computeUnits,proto3" json:"compute_units,omitempty"`
-	Enabled       bool           `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	ApiInterfaces []ApiInterface `protobuf:"bytes,5,rep,name=api_interfaces,json=apiInterfaces,proto3" json:"api_interfaces"`
-	Reserved      *SpecCategory  `protobuf:"bytes,6,opt,name=reserved,proto3" json:"reserved,omitempty"`
-	Parsing       Parsing        `protobuf:"bytes,7,opt,name=parsing,proto3" json:"parsing"`
+	Name                string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BlockParsing        BlockParser         `protobuf:"bytes,2,opt,name=block_parsing,json=blockParsing,proto3" json:"block_parsing"`
+	ComputeUnits        uint64              `protobuf:"varint,3,opt,name=compute_units,json=computeUnits,proto3" json:"compute_units,omitempty"`
+	Enabled             bool                `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	ApiInterfaces       []ApiInterface      `protobuf:"bytes,5,rep,name=api_interfaces,json=apiInterfaces,proto3" json:"api_interfaces"`
+	Reserved            *SpecCategory       `protobuf:"bytes,6,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Parsing             Parsing             `protobuf:"bytes,7,opt,name=parsing,proto3" json:"parsing"`
+	Headers             []Header            `protobuf:"bytes,8,rep,name=headers,proto3" json:"headers"`
+	SubscriptionBilling SubscriptionBilling `protobuf:"bytes,9,opt,name=subscription_billing,json=subscriptionBilling,proto3" json:"subscription_billing"`
 }
 
 func (m *ServiceApi) Reset()         { *m = ServiceApi{} }
@@ -152,6 +188,141 @@ func (m *ServiceApi) GetParsing() Parsing {
 	return Parsing{}
 }
 
+func (m *ServiceApi) GetHeaders() []Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *ServiceApi) GetSubscriptionBilling() SubscriptionBilling {
+	if m != nil {
+		return m.SubscriptionBilling
+	}
+	return SubscriptionBilling{}
+}
+
+// SubscriptionBilling charges subscriptions for as long as they stay open, zero values charge only compute_units at start
+type SubscriptionBilling struct {
+	ComputeUnitsPerMessage uint64 `protobuf:"varint,1,opt,name=compute_units_per_message,json=computeUnitsPerMessage,proto3" json:"compute_units_per_message,omitempty"`
+	ComputeUnitsPerPeriod  uint64 `protobuf:"varint,2,opt,name=compute_units_per_period,json=computeUnitsPerPeriod,proto3" json:"compute_units_per_period,omitempty"`
+	PeriodSeconds          uint64 `protobuf:"varint,3,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+}
+
+func (m *SubscriptionBilling) Reset()         { *m = SubscriptionBilling{} }
+func (m *SubscriptionBilling) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionBilling) ProtoMessage()    {}
+func (*SubscriptionBilling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3323a3ad252c5ed4, []int{1}
+}
+func (m *SubscriptionBilling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionBilling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionBilling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionBilling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionBilling.Merge(m, src)
+}
+func (m *SubscriptionBilling) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionBilling) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionBilling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionBilling proto.InternalMessageInfo
+
+func (m *SubscriptionBilling) GetComputeUnitsPerMessage() uint64 {
+	if m != nil {
+		return m.ComputeUnitsPerMessage
+	}
+	return 0
+}
+
+func (m *SubscriptionBilling) GetComputeUnitsPerPeriod() uint64 {
+	if m != nil {
+		return m.ComputeUnitsPerPeriod
+	}
+	return 0
+}
+
+func (m *SubscriptionBilling) GetPeriodSeconds() uint64 {
+	if m != nil {
+		return m.PeriodSeconds
+	}
+	return 0
+}
+
+type Header struct {
+	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind  HEADER_TYPE `protobuf:"varint,2,opt,name=kind,proto3,enum=lavanet.lava.spec.HEADER_TYPE" json:"kind,omitempty"`
+	Value string      `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Header) Reset()         { *m = Header{} }
+func (m *Header) String() string { return proto.CompactTextString(m) }
+func (*Header) ProtoMessage()    {}
+func (*Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3323a3ad252c5ed4, []int{2}
+}
+func (m *Header) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Header.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Header.Merge(m, src)
+}
+func (m *Header) XXX_Size() int {
+	return m.Size()
+}
+func (m *Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Header proto.InternalMessageInfo
+
+func (m *Header) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Header) GetKind() HEADER_TYPE {
+	if m != nil {
+		return m.Kind
+	}
+	return HEADER_TYPE_PASS_SEND
+}
+
+func (m *Header) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type Parsing struct {
 	FunctionTag      string      `protobuf:"bytes,1,opt,name=function_tag,json=functionTag,proto3" json:"function_tag,omitempty"`
 	FunctionTemplate string      `protobuf:"bytes,2,opt,name=function_template,json=functionTemplate,proto3" json:"function_template,omitempty"`
@@ -162,7 +333,7 @@ func (m *Parsing) Reset()         { *m = Parsing{} }
 func (m *Parsing) String() string { return proto.CompactTextString(m) }
 func (*Parsing) ProtoMessage()    {}
 func (*Parsing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3323a3ad252c5ed4, []int{1}
+	return fileDescriptor_3323a3ad252c5ed4, []int{3}
 }
 func (m *Parsing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -223,7 +394,7 @@ func (m *ApiInterface) Reset()         { *m = ApiInterface{} }
 func (m *ApiInterface) String() string { return proto.CompactTextString(m) }
 func (*ApiInterface) ProtoMessage()    {}
 func (*ApiInterface) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3323a3ad252c5ed4, []int{2}
+	return fileDescriptor_3323a3ad252c5ed4, []int{4}
 }
 func (m *ApiInterface) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +460,7 @@ func (m *BlockParser) Reset()         { *m = BlockParser{} }
 func (m *BlockParser) String() string { return proto.CompactTextString(m) }
 func (*BlockParser) ProtoMessage()    {}
 func (*BlockParser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3323a3ad252c5ed4, []int{3}
+	return fileDescriptor_3323a3ad252c5ed4, []int{5}
 }
 func (m *BlockParser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +514,7 @@ func (m *SpecCategory) Reset()         { *m = SpecCategory{} }
 func (m *SpecCategory) String() string { return proto.CompactTextString(m) }
 func (*SpecCategory) ProtoMessage()    {}
 func (*SpecCategory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3323a3ad252c5ed4, []int{4}
+	return fileDescriptor_3323a3ad252c5ed4, []int{6}
 }
 func (m *SpecCategory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,8 +572,11 @@ func (m *SpecCategory) GetStateful() uint32 {
 }
 
 func init() {
+	proto.RegisterEnum("lavanet.lava.spec.HEADER_TYPE", HEADER_TYPE_name, HEADER_TYPE_value)
 	proto.RegisterEnum("lavanet.lava.spec.PARSER_FUNC", PARSER_FUNC_name, PARSER_FUNC_value)
 	proto.RegisterType((*ServiceApi)(nil), "lavanet.lava.spec.ServiceApi")
+	proto.RegisterType((*SubscriptionBilling)(nil), "lavanet.lava.spec.SubscriptionBilling")
+	proto.RegisterType((*Header)(nil), "lavanet.lava.spec.Header")
 	proto.RegisterType((*Parsing)(nil), "lavanet.lava.spec.Parsing")
 	proto.RegisterType((*ApiInterface)(nil), "lavanet.lava.spec.ApiInterface")
 	proto.RegisterType((*BlockParser)(nil), "lavanet.lava.spec.BlockParser")
@@ -412,50 +586,63 @@ func init() {
 func init() { proto.RegisterFile("spec/service_api.proto", fileDescriptor_3323a3ad252c5ed4) }
 
 var fileDescriptor_3323a3ad252c5ed4 = []byte{
-	// 684 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6e, 0xdb, 0x3a,
-	0x18, 0x35, 0x63, 0x3b, 0xb6, 0x3f, 0xdb, 0xb9, 0x0a, 0x13, 0x5c, 0x18, 0xb9, 0xf7, 0x2a, 0xbe,
-	0x6e, 0x06, 0xa3, 0x05, 0x64, 0x20, 0xdd, 0x9a, 0xa1, 0x90, 0x7f, 0x52, 0x18, 0x4d, 0xed, 0x80,
-	0x71, 0x86, 0x74, 0x11, 0x68, 0x85, 0x51, 0x89, 0xca, 0x92, 0x40, 0x51, 0x41, 0x32, 0x77, 0xeb,
-	0xd4, 0xa7, 0x28, 0xba, 0xf4, 0x3d, 0x32, 0x66, 0xec, 0x54, 0x14, 0xce, 0x13, 0xf4, 0x0d, 0x0a,
-	0x51, 0x92, 0xe3, 0xb4, 0x1e, 0xda, 0x49, 0x1f, 0xcf, 0xf7, 0x1d, 0xf1, 0xf0, 0x1c, 0x82, 0xf0,
-	0x77, 0x18, 0x30, 0xbb, 0x13, 0x32, 0x71, 0xc9, 0x6d, 0x66, 0xd1, 0x80, 0x1b, 0x81, 0xf0, 0xa5,
-	0x8f, 0x37, 0x5d, 0x7a, 0x49, 0x3d, 0x26, 0x8d, 0xf8, 0x6b, 0xc4, 0x43, 0x3b, 0xdb, 0x8e, 0xef,
-	0xf8, 0xaa, 0xdb, 0x89, 0xab, 0x64, 0xb0, 0xf5, 0x7d, 0x0d, 0xe0, 0x24, 0xa1, 0x9b, 0x01, 0xc7,
-	0x18, 0x0a, 0x1e, 0x9d, 0xb1, 0x06, 0x6a, 0xa2, 0x76, 0x85, 0xa8, 0x1a, 0x0f, 0xa1, 0x3e, 0x75,
-	0x7d, 0xfb, 0xad, 0x15, 0x50, 0x11, 0x72, 0xcf, 0x69, 0xac, 0x35, 0x51, 0xbb, 0xba, 0xaf, 0x1b,
-	0xbf, 0xec, 0x61, 0x74, 0xe3, 0xb9, 0x63, 0x2a, 0x42, 0x26, 0xba, 0x85, 0x9b, 0xaf, 0xbb, 0x39,
-	0x52, 0x9b, 0x66, 0x10, 0xf7, 0x1c, 0xfc, 0x08, 0xea, 0xb6, 0x3f, 0x0b, 0x22, 0xc9, 0xac, 0xc8,
-	0xe3, 0x32, 0x6c, 0xe4, 0x9b, 0xa8, 0x5d, 0x20, 0xb5, 0x14, 0x3c, 0x8d, 0x31, 0xdc, 0x80, 0x12,
-	0xf3, 0xe8, 0xd4, 0x65, 0xe7, 0x8d, 0x42, 0x13, 0xb5, 0xcb, 0x24, 0x5b, 0xe2, 0x23, 0xd8, 0xa0,
-	0x01, 0xb7, 0xb8, 0x27, 0x99, 0xb8, 0xa0, 0x36, 0x0b, 0x1b, 0xc5, 0x66, 0xbe, 0x5d, 0xdd, 0xdf,
-	0x5d, 0x21, 0xc5, 0x0c, 0xf8, 0x30, 0x9b, 0x4b, 0xb5, 0xd4, 0xe9, 0x12, 0x16, 0xe2, 0x03, 0x28,
-	0x0b, 0x16, 0x5b, 0xc7, 0xce, 0x1b, 0xeb, 0xea, 0x48, 0xab, 0xfe, 0x73, 0x12, 0x30, 0xbb, 0x47,
-	0x25, 0x73, 0x7c, 0x71, 0x4d, 0x16, 0x04, 0xfc, 0x0c, 0x4a, 0x99, 0x1d, 0x25, 0xc5, 0xdd, 0x59,
-	0xc1, 0x4d, 0x8f, 0x9d, 0x6e, 0x9f, 0x11, 0x5a, 0x1f, 0x11, 0x94, 0x32, 0x47, 0xfe, 0x87, 0xda,
-	0x45, 0xe4, 0xd9, 0x92, 0xfb, 0x9e, 0x25, 0xa9, 0x93, 0x1a, 0x5f, 0xcd, 0xb0, 0x09, 0x75, 0xf0,
-	0x13, 0xd8, 0xbc, 0x1f, 0x61, 0xb3, 0xc0, 0xa5, 0x92, 0xa9, 0x0c, 0x2a, 0x44, 0x5b, 0xcc, 0xa5,
-	0x38, 0x7e, 0x09, 0x1b, 0x82, 0x85, 0x91, 0x2b, 0x17, 0x69, 0xe5, 0xff, 0x20, 0xad, 0x7a, 0xc2,
-	0x4d, 0xc5, 0xb5, 0x3e, 0x23, 0xa8, 0x2d, 0xfb, 0x88, 0xff, 0x85, 0xca, 0xc2, 0xfc, 0x54, 0xea,
-	0x3d, 0x10, 0x5f, 0x1e, 0x79, 0x1d, 0x64, 0xda, 0x54, 0x8d, 0x0d, 0xd8, 0x62, 0x57, 0x52, 0x50,
-	0x6b, 0x55, 0xee, 0x9b, 0xaa, 0xd5, 0x5b, 0x0e, 0xff, 0x00, 0xca, 0x76, 0xea, 0xb6, 0x4a, 0xff,
-	0x77, 0x42, 0xc9, 0x08, 0xad, 0x19, 0x54, 0x97, 0xce, 0x84, 0xff, 0x03, 0x08, 0x54, 0x65, 0x51,
-	0x11, 0x3b, 0x9b, 0x8f, 0xe5, 0x26, 0x88, 0x29, 0x1c, 0xfc, 0x1c, 0xaa, 0x69, 0x3b, 0x76, 0x51,
-	0xa9, 0xde, 0x58, 0xe9, 0xd3, 0xb1, 0x49, 0x4e, 0x06, 0xc4, 0x3a, 0x3c, 0x1d, 0xf5, 0x48, 0xfa,
-	0xc7, 0xc3, 0xc8, 0xb3, 0x5b, 0xef, 0x11, 0xd4, 0x96, 0x95, 0xe0, 0x3d, 0xa8, 0x9f, 0x33, 0xc9,
-	0xc4, 0x8c, 0x7b, 0x3c, 0x94, 0xdc, 0x56, 0x16, 0x95, 0xc9, 0x43, 0x10, 0x6f, 0x43, 0xd1, 0xf5,
-	0x6d, 0xea, 0xaa, 0x1d, 0xcb, 0x24, 0x59, 0xe0, 0x16, 0xd4, 0xc2, 0x68, 0x1a, 0xda, 0x82, 0x07,
-	0x71, 0xa0, 0xca, 0xa1, 0x32, 0x79, 0x80, 0xe1, 0x1d, 0x28, 0x87, 0x92, 0x4a, 0x76, 0x11, 0xb9,
-	0xca, 0x9c, 0x3a, 0x59, 0xac, 0x1f, 0xbf, 0x43, 0x50, 0x5d, 0x12, 0x8a, 0x2b, 0x50, 0x1c, 0xbc,
-	0x3a, 0x9e, 0x9c, 0x69, 0x39, 0xac, 0x41, 0x4d, 0x75, 0xac, 0xee, 0x99, 0x65, 0x92, 0x17, 0x1a,
-	0xc2, 0x5b, 0xf0, 0x57, 0x82, 0xf4, 0xcc, 0xd1, 0x78, 0x34, 0xec, 0x99, 0x47, 0xda, 0x1a, 0xde,
-	0x06, 0x2d, 0x01, 0xfb, 0xc3, 0xde, 0x64, 0x38, 0x1e, 0x99, 0xe4, 0x4c, 0xcb, 0xe3, 0x5d, 0xf8,
-	0xe7, 0x67, 0xd4, 0x1a, 0x13, 0x6b, 0x4c, 0xfa, 0x03, 0x32, 0xe8, 0x6b, 0x05, 0x5c, 0x85, 0x52,
-	0x7f, 0x70, 0x68, 0x9e, 0x1e, 0x4d, 0xb4, 0x62, 0xb7, 0xfb, 0x69, 0xae, 0xa3, 0x9b, 0xb9, 0x8e,
-	0x6e, 0xe7, 0x3a, 0xfa, 0x36, 0xd7, 0xd1, 0x87, 0x3b, 0x3d, 0x77, 0x7b, 0xa7, 0xe7, 0xbe, 0xdc,
-	0xe9, 0xb9, 0xd7, 0x7b, 0x0e, 0x97, 0x6f, 0xa2, 0xa9, 0x61, 0xfb, 0xb3, 0x4e, 0x6a, 0xb3, 0xfa,
-	0x76, 0xae, 0x3a, 0xea, 0x1d, 0x8b, 0x6f, 0x4c, 0x38, 0x5d, 0x57, 0x2f, 0xd3, 0xd3, 0x1f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xad, 0xd4, 0xfc, 0x10, 0xdc, 0x04, 0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x1b, 0xb7, 0x49, 0x5e, 0x7e, 0xe0, 0x4e, 0xcb, 0xca, 0x5b, 0x20, 0x0d, 0x61, 0x41,
+	0xd1, 0x22, 0x25, 0x52, 0x39, 0xa0, 0x65, 0x0f, 0xc8, 0x49, 0x5c, 0x1a, 0xd1, 0x4d, 0xa2, 0x49,
+	0x0a, 0x0a, 0x17, 0x6b, 0xe2, 0x4c, 0xbd, 0xa3, 0x75, 0x6c, 0x6b, 0xc6, 0xae, 0x76, 0xcf, 0xdc,
+	0x90, 0x90, 0xf8, 0x2b, 0x10, 0x17, 0x24, 0xfe, 0x8c, 0x3d, 0xee, 0x91, 0x13, 0x42, 0xed, 0x3f,
+	0x82, 0x3c, 0xb6, 0xb3, 0x6e, 0x1b, 0x10, 0x7b, 0xf2, 0xbc, 0xef, 0x7d, 0x9f, 0xdf, 0xe7, 0x37,
+	0xef, 0xc9, 0xf0, 0x40, 0x04, 0xd4, 0xee, 0x09, 0xca, 0xaf, 0x98, 0x4d, 0x2d, 0x12, 0xb0, 0x6e,
+	0xc0, 0xfd, 0xd0, 0x47, 0xfb, 0x2e, 0xb9, 0x22, 0x1e, 0x0d, 0xbb, 0xf1, 0xb3, 0x1b, 0x93, 0x8e,
+	0x0e, 0x1d, 0xdf, 0xf1, 0x65, 0xb6, 0x17, 0x9f, 0x12, 0x62, 0xfb, 0x67, 0x15, 0x60, 0x96, 0xc8,
+	0x8d, 0x80, 0x21, 0x04, 0xaa, 0x47, 0xd6, 0x54, 0x57, 0x5a, 0x4a, 0xa7, 0x82, 0xe5, 0x19, 0x8d,
+	0xa0, 0xbe, 0x74, 0x7d, 0xfb, 0x85, 0x15, 0x10, 0x2e, 0x98, 0xe7, 0xe8, 0x3b, 0x2d, 0xa5, 0x53,
+	0x3d, 0x69, 0x76, 0xef, 0xd5, 0xe8, 0xf6, 0x63, 0xde, 0x94, 0x70, 0x41, 0x79, 0x5f, 0x7d, 0xfd,
+	0xd7, 0x71, 0x01, 0xd7, 0x96, 0x19, 0xc4, 0x3c, 0x07, 0x7d, 0x02, 0x75, 0xdb, 0x5f, 0x07, 0x51,
+	0x48, 0xad, 0xc8, 0x63, 0xa1, 0xd0, 0x8b, 0x2d, 0xa5, 0xa3, 0xe2, 0x5a, 0x0a, 0x5e, 0xc4, 0x18,
+	0xd2, 0xa1, 0x44, 0x3d, 0xb2, 0x74, 0xe9, 0x4a, 0x57, 0x5b, 0x4a, 0xa7, 0x8c, 0xb3, 0x10, 0x9d,
+	0x43, 0x83, 0x04, 0xcc, 0x62, 0x5e, 0x48, 0xf9, 0x25, 0xb1, 0xa9, 0xd0, 0x77, 0x5b, 0xc5, 0x4e,
+	0xf5, 0xe4, 0x78, 0x8b, 0x15, 0x23, 0x60, 0xa3, 0x8c, 0x97, 0x7a, 0xa9, 0x93, 0x1c, 0x26, 0xd0,
+	0x53, 0x28, 0x73, 0x1a, 0xb7, 0x8e, 0xae, 0xf4, 0xbd, 0x96, 0xf2, 0x2f, 0xef, 0x99, 0x05, 0xd4,
+	0x1e, 0x90, 0x90, 0x3a, 0x3e, 0x7f, 0x85, 0x37, 0x02, 0xf4, 0x15, 0x94, 0xb2, 0x76, 0x94, 0xa4,
+	0xf6, 0x68, 0x8b, 0x36, 0xfd, 0xec, 0xb4, 0x7c, 0x26, 0x40, 0x4f, 0xa0, 0xf4, 0x9c, 0x92, 0x15,
+	0xe5, 0x42, 0x2f, 0x4b, 0xff, 0x0f, 0xb7, 0x68, 0xcf, 0x24, 0x23, 0x93, 0xa6, 0x7c, 0x64, 0xc1,
+	0xa1, 0x88, 0x96, 0xc2, 0xe6, 0x2c, 0x08, 0x99, 0xef, 0x59, 0x4b, 0xe6, 0xba, 0xb1, 0x87, 0x8a,
+	0xf4, 0xf0, 0xd9, 0x36, 0xff, 0x39, 0x7a, 0x3f, 0x61, 0xa7, 0x2f, 0x3d, 0x10, 0xf7, 0x53, 0xed,
+	0x3f, 0x14, 0x38, 0xd8, 0x22, 0x41, 0x4f, 0xe0, 0xe1, 0xad, 0x9b, 0xb3, 0x02, 0xca, 0xad, 0x35,
+	0x15, 0x82, 0x38, 0xc9, 0xb4, 0xa8, 0xf8, 0x41, 0xfe, 0x16, 0xa7, 0x94, 0x3f, 0x4b, 0xb2, 0xe8,
+	0x4b, 0xd0, 0xef, 0x4b, 0x03, 0xca, 0x99, 0xbf, 0x92, 0xa3, 0xa4, 0xe2, 0xf7, 0xef, 0x28, 0xa7,
+	0x32, 0x89, 0x3e, 0x85, 0x46, 0x42, 0xb3, 0x04, 0xb5, 0x7d, 0x6f, 0x95, 0x8d, 0x4b, 0x3d, 0x41,
+	0x67, 0x09, 0xd8, 0xbe, 0x84, 0xbd, 0xa4, 0x59, 0x5b, 0xa7, 0xf7, 0x04, 0xd4, 0x17, 0xcc, 0x4b,
+	0x2a, 0x35, 0xb6, 0x0e, 0xed, 0x99, 0x69, 0x0c, 0x4d, 0x6c, 0xcd, 0x17, 0x53, 0x13, 0x4b, 0x2e,
+	0x3a, 0x84, 0xdd, 0x2b, 0xe2, 0x46, 0x54, 0xd6, 0xab, 0xe0, 0x24, 0x68, 0xff, 0xaa, 0x40, 0x29,
+	0x1b, 0xe4, 0x8f, 0xa1, 0x76, 0x19, 0x79, 0xb6, 0xbc, 0x83, 0x90, 0x38, 0x69, 0xc5, 0x6a, 0x86,
+	0xcd, 0x89, 0x83, 0x3e, 0x87, 0xfd, 0xb7, 0x14, 0xba, 0x0e, 0x5c, 0x12, 0x52, 0xe9, 0xa2, 0x82,
+	0xb5, 0x0d, 0x2f, 0xc5, 0xd1, 0xb7, 0xd0, 0xe0, 0x54, 0x44, 0x6e, 0xb8, 0x59, 0xb2, 0xe2, 0x3b,
+	0x2c, 0x59, 0x3d, 0xd1, 0xa6, 0xe6, 0xda, 0xbf, 0x2b, 0x50, 0xcb, 0x8f, 0x3f, 0xfa, 0x10, 0x2a,
+	0x9b, 0x9d, 0x49, 0xad, 0xbe, 0x05, 0xe2, 0xae, 0x85, 0xaf, 0x82, 0xcc, 0x9b, 0x3c, 0xa3, 0x2e,
+	0x1c, 0xd0, 0x97, 0x21, 0x27, 0xd6, 0xb6, 0x75, 0xdd, 0x97, 0xa9, 0x41, 0x7e, 0x67, 0x9f, 0x42,
+	0xd9, 0x4e, 0x97, 0x44, 0x57, 0xff, 0xe7, 0x2e, 0x65, 0x82, 0xf6, 0x1a, 0xaa, 0xb9, 0x6f, 0x42,
+	0x1f, 0x01, 0x04, 0xf2, 0x64, 0x11, 0x1e, 0x77, 0xb6, 0x18, 0xdb, 0x4d, 0x10, 0x83, 0x3b, 0xe8,
+	0x6b, 0xa8, 0xa6, 0xe9, 0xb8, 0x8b, 0xff, 0x71, 0xaf, 0x53, 0x03, 0xcf, 0x4c, 0x6c, 0x9d, 0x5e,
+	0x8c, 0x07, 0x38, 0x7d, 0xe3, 0x69, 0xe4, 0xd9, 0xed, 0x9f, 0x14, 0xa8, 0xe5, 0x9d, 0xa0, 0x47,
+	0x50, 0x5f, 0xd1, 0x90, 0xf2, 0x35, 0xf3, 0x98, 0x08, 0x99, 0x2d, 0x5b, 0x54, 0xc6, 0xb7, 0xc1,
+	0x78, 0x28, 0x5c, 0xdf, 0x26, 0xae, 0xac, 0x58, 0xc6, 0x49, 0x80, 0xda, 0x50, 0xcb, 0xaf, 0x91,
+	0xec, 0x50, 0x19, 0xdf, 0xc2, 0xd0, 0x11, 0x94, 0x45, 0x48, 0x42, 0x7a, 0x19, 0xb9, 0xb2, 0x39,
+	0x75, 0xbc, 0x89, 0x1f, 0x5f, 0x40, 0x35, 0x37, 0x7f, 0xa8, 0x0e, 0x95, 0xa9, 0x31, 0x9b, 0x59,
+	0x33, 0x73, 0x3c, 0xd4, 0x0a, 0xa8, 0x01, 0x20, 0x43, 0x6c, 0x4e, 0xcf, 0x17, 0x9a, 0xb2, 0x49,
+	0xf7, 0x27, 0xf3, 0x33, 0x6d, 0x27, 0x0e, 0x27, 0xdf, 0x99, 0xf8, 0x7b, 0x3c, 0x9a, 0x9b, 0x5a,
+	0x11, 0x55, 0x60, 0x77, 0x36, 0xc7, 0xa3, 0xa9, 0xa6, 0x3e, 0xfe, 0x51, 0x81, 0x6a, 0xee, 0xfb,
+	0xe3, 0x94, 0xf9, 0x6c, 0x3a, 0x5f, 0x68, 0x05, 0xa4, 0x41, 0x4d, 0x66, 0xac, 0xfe, 0xc2, 0x32,
+	0xf0, 0x37, 0x9a, 0x82, 0x0e, 0xe0, 0xbd, 0x04, 0x19, 0x18, 0xe3, 0xc9, 0x78, 0x34, 0x30, 0xce,
+	0xb5, 0x1d, 0x74, 0x08, 0x5a, 0x02, 0x0e, 0x47, 0x83, 0xf9, 0x68, 0x32, 0x36, 0xf0, 0x42, 0x2b,
+	0xa2, 0x63, 0xf8, 0xe0, 0x2e, 0x6a, 0x4d, 0xb0, 0x35, 0xc1, 0x43, 0x13, 0x9b, 0x43, 0x4d, 0x45,
+	0x55, 0x28, 0x0d, 0xcd, 0x53, 0xe3, 0xe2, 0x7c, 0xae, 0xed, 0xf6, 0xfb, 0xbf, 0x5d, 0x37, 0x95,
+	0xd7, 0xd7, 0x4d, 0xe5, 0xcd, 0x75, 0x53, 0xf9, 0xfb, 0xba, 0xa9, 0xfc, 0x72, 0xd3, 0x2c, 0xbc,
+	0xb9, 0x69, 0x16, 0xfe, 0xbc, 0x69, 0x16, 0x7e, 0x78, 0xe4, 0xb0, 0xf0, 0x79, 0xb4, 0xec, 0xda,
+	0xfe, 0xba, 0x97, 0xde, 0x9e, 0x7c, 0xf6, 0x5e, 0xf6, 0xe4, 0x5f, 0x2d, 0x1e, 0x44, 0xb1, 0xdc,
+	0x93, 0xff, 0xa9, 0x2f, 0xfe, 0x19, 0x00, 0x20, 0x30, 0x20, 0xae, 0xea, 0x06, 0x00, 0x00,
 }
 
 func (this *ServiceApi) Equal(that interface{}) bool {
@@ -503,6 +690,77 @@ func (this *ServiceApi) Equal(that interface{}) bool {
 	if !this.Parsing.Equal(&that1.Parsing) {
 		return false
 	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(&that1.Headers[i]) {
+			return false
+		}
+	}
+	if !this.SubscriptionBilling.Equal(&that1.SubscriptionBilling) {
+		return false
+	}
+	return true
+}
+func (this *SubscriptionBilling) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubscriptionBilling)
+	if !ok {
+		that2, ok := that.(SubscriptionBilling)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ComputeUnitsPerMessage != that1.ComputeUnitsPerMessage {
+		return false
+	}
+	if this.ComputeUnitsPerPeriod != that1.ComputeUnitsPerPeriod {
+		return false
+	}
+	if this.PeriodSeconds != that1.PeriodSeconds {
+		return false
+	}
+	return true
+}
+func (this *Header) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Header)
+	if !ok {
+		that2, ok := that.(Header)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
 	return true
 }
 func (this *Parsing) Equal(that interface{}) bool {
@@ -653,6 +911,30 @@ func (m *ServiceApi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SubscriptionBilling.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintServiceApi(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServiceApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.Parsing.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -724,6 +1006,86 @@ func (m *ServiceApi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubscriptionBilling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionBilling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionBilling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodSeconds != 0 {
+		i = encodeVarintServiceApi(dAtA, i, uint64(m.PeriodSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ComputeUnitsPerPeriod != 0 {
+		i = encodeVarintServiceApi(dAtA, i, uint64(m.ComputeUnitsPerPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ComputeUnitsPerMessage != 0 {
+		i = encodeVarintServiceApi(dAtA, i, uint64(m.ComputeUnitsPerMessage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Header) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintServiceApi(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintServiceApi(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintServiceApi(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Parsing) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -961,35 +1323,81 @@ func (m *ServiceApi) Size() (n int) {
 	}
 	l = m.Parsing.Size()
 	n += 1 + l + sovServiceApi(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovServiceApi(uint64(l))
+		}
+	}
+	l = m.SubscriptionBilling.Size()
+	n += 1 + l + sovServiceApi(uint64(l))
 	return n
 }
 
-func (m *Parsing) Size() (n int) {
+func (m *SubscriptionBilling) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.FunctionTag)
-	if l > 0 {
-		n += 1 + l + sovServiceApi(uint64(l))
+	if m.ComputeUnitsPerMessage != 0 {
+		n += 1 + sovServiceApi(uint64(m.ComputeUnitsPerMessage))
 	}
-	l = len(m.FunctionTemplate)
-	if l > 0 {
-		n += 1 + l + sovServiceApi(uint64(l))
+	if m.ComputeUnitsPerPeriod != 0 {
+		n += 1 + sovServiceApi(uint64(m.ComputeUnitsPerPeriod))
+	}
+	if m.PeriodSeconds != 0 {
+		n += 1 + sovServiceApi(uint64(m.PeriodSeconds))
 	}
-	l = m.ResultParsing.Size()
-	n += 1 + l + sovServiceApi(uint64(l))
 	return n
 }
 
-func (m *ApiInterface) Size() (n int) {
+func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Interface)
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovServiceApi(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovServiceApi(uint64(m.Kind))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovServiceApi(uint64(l))
+	}
+	return n
+}
+
+func (m *Parsing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunctionTag)
+	if l > 0 {
+		n += 1 + l + sovServiceApi(uint64(l))
+	}
+	l = len(m.FunctionTemplate)
+	if l > 0 {
+		n += 1 + l + sovServiceApi(uint64(l))
+	}
+	l = m.ResultParsing.Size()
+	n += 1 + l + sovServiceApi(uint64(l))
+	return n
+}
+
+func (m *ApiInterface) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Interface)
 	if l > 0 {
 		n += 1 + l + sovServiceApi(uint64(l))
 	}
@@ -1288,6 +1696,313 @@ func (m *ServiceApi) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionBilling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubscriptionBilling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionBilling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionBilling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionBilling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsPerMessage", wireType)
+			}
+			m.ComputeUnitsPerMessage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputeUnitsPerMessage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsPerPeriod", wireType)
+			}
+			m.ComputeUnitsPerPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputeUnitsPerPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+			}
+			m.PeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServiceApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServiceApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= HEADER_TYPE(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServiceApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServiceApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServiceApi(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	fmt "fmt"
 	"strconv"
+	"strings"
 )
 
 const minCU = 1
@@ -30,6 +31,16 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 			}
 		}
 
+		if err := validateHeaders(api.Headers); err != nil {
+			details["api"] = api.Name
+			return details, err
+		}
+
+		if err := validateSubscriptionBilling(api.SubscriptionBilling, maxCU); err != nil {
+			details["api"] = api.Name
+			return details, err
+		}
+
 		if api.Parsing.FunctionTag != "" {
 			// Validate tag name
 			result := false
@@ -57,3 +68,34 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 
 	return details, nil
 }
+
+func validateHeaders(headers []Header) error {
+	headerNames := map[string]struct{}{}
+	for _, header := range headers {
+		name := strings.ToLower(header.Name)
+		if name == "" {
+			return fmt.Errorf("empty header name")
+		}
+		if _, ok := headerNames[name]; ok {
+			return fmt.Errorf("duplicate header %v", header.Name)
+		}
+		headerNames[name] = struct{}{}
+		if _, ok := HEADER_TYPE_name[int32(header.Kind)]; !ok {
+			return fmt.Errorf("unsupported header kind %v for %v", header.Kind, header.Name)
+		}
+		if header.Kind == HEADER_TYPE_OVERWRITE && header.Value == "" {
+			return fmt.Errorf("overwritten header %v has no value", header.Name)
+		}
+	}
+	return nil
+}
+
+func validateSubscriptionBilling(billing SubscriptionBilling, maxCU uint64) error {
+	if billing.ComputeUnitsPerMessage > maxCU || billing.ComputeUnitsPerPeriod > maxCU {
+		return fmt.Errorf("subscription compute units out of range")
+	}
+	if billing.ComputeUnitsPerPeriod > 0 && billing.PeriodSeconds == 0 {
+		return fmt.Errorf("subscription compute units per period without a period")
+	}
+	return nil
+}
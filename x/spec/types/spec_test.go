@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSpecHeaders(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		headers []types.Header
+		valid   bool
+	}{
+		{
+			desc:    "no headers",
+			headers: nil,
+			valid:   true,
+		},
+		{
+			desc: "valid headers",
+			headers: []types.Header{
+				{Name: "Accept", Kind: types.HEADER_TYPE_PASS_SEND},
+				{Name: "Content-Type", Kind: types.HEADER_TYPE_PASS_BOTH},
+				{Name: "X-Api-Version", Kind: types.HEADER_TYPE_OVERWRITE, Value: "2"},
+			},
+			valid: true,
+		},
+		{
+			desc:    "empty name",
+			headers: []types.Header{{Name: "", Kind: types.HEADER_TYPE_PASS_SEND}},
+			valid:   false,
+		},
+		{
+			desc: "duplicate name",
+			headers: []types.Header{
+				{Name: "Accept", Kind: types.HEADER_TYPE_PASS_SEND},
+				{Name: "accept", Kind: types.HEADER_TYPE_STRIP},
+			},
+			valid: false,
+		},
+		{
+			desc:    "unknown kind",
+			headers: []types.Header{{Name: "Accept", Kind: types.HEADER_TYPE(10)}},
+			valid:   false,
+		},
+		{
+			desc:    "overwrite without value",
+			headers: []types.Header{{Name: "X-Api-Version", Kind: types.HEADER_TYPE_OVERWRITE}},
+			valid:   false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			spec := types.Spec{
+				Index: "LAV1",
+				Apis:  []types.ServiceApi{{Name: "api", ComputeUnits: 10, Headers: tc.headers}},
+			}
+			_, err := spec.ValidateSpec(100)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSpecSubscriptionBilling(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		billing types.SubscriptionBilling
+		valid   bool
+	}{
+		{desc: "no billing", billing: types.SubscriptionBilling{}, valid: true},
+		{desc: "per message", billing: types.SubscriptionBilling{ComputeUnitsPerMessage: 1}, valid: true},
+		{desc: "per period", billing: types.SubscriptionBilling{ComputeUnitsPerPeriod: 10, PeriodSeconds: 60}, valid: true},
+		{desc: "period without seconds", billing: types.SubscriptionBilling{ComputeUnitsPerPeriod: 10}, valid: false},
+		{desc: "too many compute units", billing: types.SubscriptionBilling{ComputeUnitsPerMessage: 101}, valid: false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			spec := types.Spec{
+				Index: "LAV1",
+				Apis:  []types.ServiceApi{{Name: "eth_subscribe", ComputeUnits: 10, SubscriptionBilling: tc.billing}},
+			}
+			_, err := spec.ValidateSpec(100)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSubscriptionBillingComputeUnits(t *testing.T) {
+	billing := types.SubscriptionBilling{ComputeUnitsPerMessage: 2, ComputeUnitsPerPeriod: 10, PeriodSeconds: 60}
+	require.True(t, billing.IsBilled())
+	require.Equal(t, uint64(0), billing.ComputeUnits(0, 59*time.Second))
+	require.Equal(t, uint64(6), billing.ComputeUnits(3, 59*time.Second))
+	require.Equal(t, uint64(26), billing.ComputeUnits(3, 2*time.Minute))
+	require.False(t, types.SubscriptionBilling{}.IsBilled())
+	require.Equal(t, uint64(0), types.SubscriptionBilling{}.ComputeUnits(100, time.Hour))
+}
+
+func TestHeaderJSON(t *testing.T) {
+	header := types.Header{}
+	err := json.Unmarshal([]byte(`{"name":"X-Api-Version","kind":"OVERWRITE","value":"2"}`), &header)
+	require.NoError(t, err)
+	require.Equal(t, types.Header{Name: "X-Api-Version", Kind: types.HEADER_TYPE_OVERWRITE, Value: "2"}, header)
+	data, err := json.Marshal(header)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"kind":"OVERWRITE"`)
+
+	// a typo doesn't silently become another kind
+	err = json.Unmarshal([]byte(`{"name":"X-Api-Version","kind":"OVERWITE","value":"2"}`), &header)
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 )
 
 const (
@@ -35,6 +36,11 @@ const (
 
 var SupportedTags = [...]string{GET_BLOCKNUM, GET_BLOCK_BY_NUM}
 
+// SpecCategory.Stateful values
+const (
+	CONTEXT_STATE uint32 = 1 // the api changes the chain state (i.e sends a transaction)
+)
+
 // allows unmarshaling parser func
 func (s PARSER_FUNC) MarshalJSON() ([]byte, error) {
 	buffer := bytes.NewBufferString(`"`)
@@ -70,3 +76,26 @@ func IsFinalizedBlock(requestedBlock int64, latestBlock int64, finalizationCrite
 	}
 	return false
 }
+
+// allows unmarshaling header kinds
+func (s HEADER_TYPE) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(HEADER_TYPE_name[int32(s)])
+	buffer.WriteString(`"`)
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON unmashals a quoted json string to the enum value
+func (s *HEADER_TYPE) UnmarshalJSON(b []byte) error {
+	var j string
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	value, ok := HEADER_TYPE_value[j]
+	if !ok {
+		return fmt.Errorf("unknown header kind %q", j)
+	}
+	*s = HEADER_TYPE(value)
+	return nil
+}